@@ -15,6 +15,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	transfer "github.com/cosmos/ibc-go/modules/apps/transfer"
@@ -97,6 +98,7 @@ import (
 
 	customauth "github.com/bitwebs/iq-core/custom/auth"
 	customante "github.com/bitwebs/iq-core/custom/auth/ante"
+	anteconfig "github.com/bitwebs/iq-core/custom/auth/ante/config"
 	customauthrest "github.com/bitwebs/iq-core/custom/auth/client/rest"
 	customauthsim "github.com/bitwebs/iq-core/custom/auth/simulation"
 	customauthtx "github.com/bitwebs/iq-core/custom/auth/tx"
@@ -119,11 +121,15 @@ import (
 	"github.com/bitwebs/iq-core/x/oracle"
 	oraclekeeper "github.com/bitwebs/iq-core/x/oracle/keeper"
 	oracletypes "github.com/bitwebs/iq-core/x/oracle/types"
+	"github.com/bitwebs/iq-core/x/tokenfactory"
+	tokenfactorykeeper "github.com/bitwebs/iq-core/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/bitwebs/iq-core/x/tokenfactory/types"
 	"github.com/bitwebs/iq-core/x/treasury"
 	treasurykeeper "github.com/bitwebs/iq-core/x/treasury/keeper"
 	treasurytypes "github.com/bitwebs/iq-core/x/treasury/types"
 	"github.com/bitwebs/iq-core/x/vesting"
 	"github.com/bitwebs/iq-core/x/wasm"
+	wasmclient "github.com/bitwebs/iq-core/x/wasm/client"
 	wasmconfig "github.com/bitwebs/iq-core/x/wasm/config"
 	wasmkeeper "github.com/bitwebs/iq-core/x/wasm/keeper"
 	wasmtypes "github.com/bitwebs/iq-core/x/wasm/types"
@@ -134,6 +140,7 @@ import (
 	stakingwasm "github.com/bitwebs/iq-core/custom/staking/wasm"
 	marketwasm "github.com/bitwebs/iq-core/x/market/wasm"
 	oraclewasm "github.com/bitwebs/iq-core/x/oracle/wasm"
+	tokenfactorywasm "github.com/bitwebs/iq-core/x/tokenfactory/wasm"
 	treasurywasm "github.com/bitwebs/iq-core/x/treasury/wasm"
 
 	// unnamed import of statik for swagger UI support
@@ -165,6 +172,16 @@ var (
 			upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
+			wasmclient.StoreCodeProposalHandler,
+			wasmclient.InstantiateContractProposalHandler,
+			wasmclient.MigrateContractProposalHandler,
+			wasmclient.UpdateAdminProposalHandler,
+			wasmclient.ClearAdminProposalHandler,
+			wasmclient.PinCodesProposalHandler,
+			wasmclient.UnpinCodesProposalHandler,
+			wasmclient.SudoContractProposalHandler,
+			wasmclient.FreezeContractProposalHandler,
+			wasmclient.UnfreezeContractProposalHandler,
 		),
 		customparams.AppModuleBasic{},
 		customcrisis.AppModuleBasic{},
@@ -178,6 +195,7 @@ var (
 		oracle.AppModuleBasic{},
 		market.AppModuleBasic{},
 		treasury.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 	)
 
@@ -194,6 +212,8 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		wasmtypes.ModuleName:           nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -226,30 +246,32 @@ type IqApp struct { // nolint: golint
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	AuthzKeeper      authzkeeper.Keeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	EvidenceKeeper   evidencekeeper.Keeper
-	FeeGrantKeeper   feegrantkeeper.Keeper
-	TransferKeeper   ibctransferkeeper.Keeper
-	OracleKeeper     oraclekeeper.Keeper
-	MarketKeeper     marketkeeper.Keeper
-	TreasuryKeeper   treasurykeeper.Keeper
-	WasmKeeper       wasmkeeper.Keeper
+	AccountKeeper      authkeeper.AccountKeeper
+	AuthzKeeper        authzkeeper.Keeper
+	BankKeeper         bankkeeper.Keeper
+	CapabilityKeeper   *capabilitykeeper.Keeper
+	StakingKeeper      stakingkeeper.Keeper
+	SlashingKeeper     slashingkeeper.Keeper
+	MintKeeper         mintkeeper.Keeper
+	DistrKeeper        distrkeeper.Keeper
+	GovKeeper          govkeeper.Keeper
+	CrisisKeeper       crisiskeeper.Keeper
+	UpgradeKeeper      upgradekeeper.Keeper
+	ParamsKeeper       paramskeeper.Keeper
+	IBCKeeper          *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	EvidenceKeeper     evidencekeeper.Keeper
+	FeeGrantKeeper     feegrantkeeper.Keeper
+	TransferKeeper     ibctransferkeeper.Keeper
+	OracleKeeper       oraclekeeper.Keeper
+	MarketKeeper       marketkeeper.Keeper
+	TreasuryKeeper     treasurykeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	WasmKeeper         wasmkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedWasmKeeper     capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -292,6 +314,7 @@ func NewIqApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		oracletypes.StoreKey, markettypes.StoreKey, treasurytypes.StoreKey,
 		wasmtypes.StoreKey, authzkeeper.StoreKey, feegrant.StoreKey,
+		tokenfactorytypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -316,6 +339,7 @@ func NewIqApp(
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -370,11 +394,6 @@ func NewIqApp(
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
-	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
-	app.IBCKeeper.SetRouter(ibcRouter)
-
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -399,12 +418,19 @@ func NewIqApp(
 		app.MarketKeeper, app.OracleKeeper,
 		app.StakingKeeper, app.DistrKeeper,
 		distrtypes.ModuleName)
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec, keys[tokenfactorytypes.StoreKey],
+		app.GetSubspace(tokenfactorytypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+	)
 
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec, keys[wasmtypes.StoreKey],
 		app.GetSubspace(wasmtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper,
-		app.TreasuryKeeper, bApp.MsgServiceRouter(),
+		app.TreasuryKeeper, app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper, scopedWasmKeeper,
+		app.TransferKeeper, bApp.MsgServiceRouter(),
 		app.GRPCQueryRouter(), wasmtypes.DefaultFeatures,
 		homePath, wasmConfig,
 	)
@@ -414,18 +440,36 @@ func NewIqApp(
 		wasmtypes.WasmMsgParserRouteBank:         bankwasm.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteStaking:      stakingwasm.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteMarket:       marketwasm.NewWasmMsgParser(),
+		wasmtypes.WasmMsgParserRouteOracle:       oraclewasm.NewWasmMsgParser(),
+		wasmtypes.WasmMsgParserRouteTokenFactory: tokenfactorywasm.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteWasm:         wasmkeeper.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteDistribution: distrwasm.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteGov:          govwasm.NewWasmMsgParser(),
 	}, wasmkeeper.NewStargateWasmMsgParser(appCodec))
+
+	// the oracle msgs of the contracts skip the ante handler, so they are checked on dispatch
+	app.WasmKeeper.RegisterMsgFilters(
+		customante.NewSpammingPreventionDecorator(app.OracleKeeper, app.StakingKeeper, customante.SpammingPreventionOptions{}).CheckContractOracleMsg,
+	)
+
+	// the stargate queries allowed from the contracts; extend the allowlist with
+	// deterministic queries only, e.g. stargateQueryAllowlist.Add(path, wasmtypes.NewStargateQuery(...))
+	stargateQueryAllowlist := wasmkeeper.DefaultStargateQueryAllowlist()
 	app.WasmKeeper.RegisterQueriers(map[string]wasmtypes.WasmQuerierInterface{
-		wasmtypes.WasmQueryRouteBank:     bankwasm.NewWasmQuerier(app.BankKeeper),
-		wasmtypes.WasmQueryRouteStaking:  stakingwasm.NewWasmQuerier(app.StakingKeeper, app.DistrKeeper),
-		wasmtypes.WasmQueryRouteMarket:   marketwasm.NewWasmQuerier(app.MarketKeeper),
-		wasmtypes.WasmQueryRouteOracle:   oraclewasm.NewWasmQuerier(app.OracleKeeper),
-		wasmtypes.WasmQueryRouteTreasury: treasurywasm.NewWasmQuerier(app.TreasuryKeeper),
-		wasmtypes.WasmQueryRouteWasm:     wasmkeeper.NewWasmQuerier(app.WasmKeeper),
-	}, wasmkeeper.NewStargateWasmQuerier(app.WasmKeeper))
+		wasmtypes.WasmQueryRouteBank:         bankwasm.NewWasmQuerier(app.BankKeeper),
+		wasmtypes.WasmQueryRouteStaking:      stakingwasm.NewWasmQuerier(app.StakingKeeper, app.DistrKeeper),
+		wasmtypes.WasmQueryRouteMarket:       marketwasm.NewWasmQuerier(app.MarketKeeper),
+		wasmtypes.WasmQueryRouteOracle:       oraclewasm.NewWasmQuerier(app.OracleKeeper),
+		wasmtypes.WasmQueryRouteTreasury:     treasurywasm.NewWasmQuerier(app.TreasuryKeeper),
+		wasmtypes.WasmQueryRouteTokenFactory: tokenfactorywasm.NewWasmQuerier(app.TokenFactoryKeeper),
+		wasmtypes.WasmQueryRouteWasm:         wasmkeeper.NewWasmQuerier(app.WasmKeeper),
+	}, wasmkeeper.NewStargateWasmQuerier(app.WasmKeeper, appCodec, stargateQueryAllowlist))
+
+	// Create static IBC router, add transfer and wasm routes, then set and seal it
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule).
+		AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
@@ -433,12 +477,18 @@ func NewIqApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(wasmtypes.RouterKey, wasmkeeper.NewWasmProposalHandler(app.WasmKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
 	)
 
+	// the gov keeper depends on the wasm proposal handler, so its querier is registered after it
+	app.WasmKeeper.RegisterQueriers(map[string]wasmtypes.WasmQuerierInterface{
+		wasmtypes.WasmQueryRouteGov: govwasm.NewWasmQuerier(app.GovKeeper),
+	}, nil)
+
 	/****  Module Options ****/
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
@@ -468,6 +518,7 @@ func NewIqApp(
 		market.NewAppModule(appCodec, app.MarketKeeper, app.AccountKeeper, app.BankKeeper, app.OracleKeeper),
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		treasury.NewAppModule(appCodec, app.TreasuryKeeper),
+		tokenfactory.NewAppModule(appCodec, app.TokenFactoryKeeper),
 		wasm.NewAppModule(appCodec, app.WasmKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 	)
 
@@ -481,11 +532,13 @@ func NewIqApp(
 		slashingtypes.ModuleName, evidencetypes.ModuleName,
 		stakingtypes.ModuleName, ibchost.ModuleName,
 	)
+	// NOTE: wasm must occur after oracle so that the contract cron jobs see the new exchange rates
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName,
 		oracletypes.ModuleName, markettypes.ModuleName,
-		treasurytypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName, stakingtypes.ModuleName,
+		treasurytypes.ModuleName, wasmtypes.ModuleName,
+		authz.ModuleName, feegrant.ModuleName,
+		stakingtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -500,6 +553,7 @@ func NewIqApp(
 		stakingtypes.ModuleName, slashingtypes.ModuleName,
 		govtypes.ModuleName, markettypes.ModuleName,
 		oracletypes.ModuleName, treasurytypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 		wasmtypes.ModuleName, authz.ModuleName,
 		minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName,
@@ -549,16 +603,27 @@ func NewIqApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
+	anteConfig := anteconfig.GetConfig(appOpts)
 	anteHandler, err := customante.NewAnteHandler(
 		customante.HandlerOptions{
 			AccountKeeper:    app.AccountKeeper,
 			BankKeeper:       app.BankKeeper,
 			FeegrantKeeper:   app.FeeGrantKeeper,
+			MarketKeeper:     app.MarketKeeper,
 			OracleKeeper:     app.OracleKeeper,
+			StakingKeeper:    app.StakingKeeper,
 			TreasuryKeeper:   app.TreasuryKeeper,
+			WasmKeeper:       app.WasmKeeper,
 			SigGasConsumer:   ante.DefaultSigVerificationGasConsumer,
 			SignModeHandler:  encodingConfig.TxConfig.SignModeHandler(),
 			IBCChannelKeeper: app.IBCKeeper.ChannelKeeper,
+			RateLimit: customante.RateLimitOptions{
+				MaxTxsPerWindow: anteConfig.RateLimitMaxTxs,
+				WindowBlocks:    anteConfig.RateLimitWindowBlocks,
+			},
+			SpamPrevention: customante.SpammingPreventionOptions{
+				MaxEntries: anteConfig.OracleSpamMaxEntries,
+			},
 		},
 	)
 	if err != nil {
@@ -572,9 +637,16 @@ func NewIqApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// re-pin the persisted wasm codes into the VM in-memory cache
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedWasmKeeper = scopedWasmKeeper
 
 	return app
 }
@@ -592,6 +664,60 @@ func (app *IqApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Res
 	return app.mm.EndBlock(ctx, req)
 }
 
+// BeginBlock implements the ABCI interface. On top of BaseApp.BeginBlock, it starts
+// collecting the contract events of the block when the event indexing is enabled.
+func (app *IqApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.BaseApp.BeginBlock(req)
+	if eventIndex := app.WasmKeeper.ContractEventIndex(); eventIndex != nil {
+		eventIndex.ListenBeginBlock(req, res)
+	}
+
+	return res
+}
+
+// DeliverTx implements the ABCI interface. On top of BaseApp.DeliverTx, it collects
+// the contract events of the tx when the event indexing is enabled.
+func (app *IqApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	if eventIndex := app.WasmKeeper.ContractEventIndex(); eventIndex != nil {
+		eventIndex.ListenDeliverTx(req, res)
+	}
+
+	return res
+}
+
+// EndBlock implements the ABCI interface. On top of BaseApp.EndBlock, it collects
+// the contract events of the end blockers when the event indexing is enabled.
+func (app *IqApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	if eventIndex := app.WasmKeeper.ContractEventIndex(); eventIndex != nil {
+		eventIndex.ListenEndBlock(req, res)
+	}
+
+	return res
+}
+
+// Commit implements the ABCI interface. On top of BaseApp.Commit, it writes the collected
+// contract events of the block to the event index when the event indexing is enabled.
+// The events are written first, so that a block executed again after a crash in between
+// rewrites them; the index is not part of the consensus state and its failures are only logged.
+func (app *IqApp) Commit() abci.ResponseCommit {
+	if eventIndex := app.WasmKeeper.ContractEventIndex(); eventIndex != nil {
+		if err := eventIndex.ListenCommit(); err != nil {
+			app.Logger().Error("failed to write the contract event index", "err", err)
+		}
+	}
+
+	return app.BaseApp.Commit()
+}
+
+// Close closes the node local resources of the app, such as the contract event index,
+// which BaseApp does not manage.
+// It must be called once the node is stopped.
+func (app *IqApp) Close() error {
+	return app.WasmKeeper.Close()
+}
+
 // InitChainer application update at chain initialization
 func (app *IqApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
@@ -756,6 +882,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(markettypes.ModuleName)
 	paramsKeeper.Subspace(oracletypes.ModuleName)
 	paramsKeeper.Subspace(treasurytypes.ModuleName)
+	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(wasmtypes.ModuleName)
 
 	return paramsKeeper
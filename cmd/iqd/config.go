@@ -1,6 +1,7 @@
 package main
 
 import (
+	anteconfig "github.com/bitwebs/iq-core/custom/auth/ante/config"
 	wasmconfig "github.com/bitwebs/iq-core/x/wasm/config"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -11,6 +12,7 @@ type IqAppConfig struct {
 	serverconfig.Config
 
 	WASMConfig wasmconfig.Config `mapstructure:"wasm"`
+	AnteConfig anteconfig.Config `mapstructure:"ante"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -37,9 +39,10 @@ func initAppConfig() (string, interface{}) {
 	iqAppConfig := IqAppConfig{
 		Config:     *srvCfg,
 		WASMConfig: *wasmconfig.DefaultConfig(),
+		AnteConfig: *anteconfig.DefaultConfig(),
 	}
 
-	iqAppTemplate := serverconfig.DefaultConfigTemplate + wasmconfig.DefaultConfigTemplate
+	iqAppTemplate := serverconfig.DefaultConfigTemplate + wasmconfig.DefaultConfigTemplate + anteconfig.DefaultConfigTemplate
 
 	return iqAppTemplate, iqAppConfig
 }
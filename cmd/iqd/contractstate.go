@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	wasmtypes "github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+const flagPageLimit = "page-limit"
+
+// ExportContractStateCmd returns export-contract-state cobra Command.
+func ExportContractStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-contract-state [contract-addr-bech32] [output-file]",
+		Short: "Export the contract store of a contract from the node to a JSON file",
+		Long: `Export the contract store of a contract from the node to a JSON file.
+The exported models can be seeded into a local genesis with import-contract-state.
+
+Example:
+$ iqd export-contract-state iq1... state.json --node https://rpc.example.com:443 --height 1000000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := wasmtypes.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageLimit, err := cmd.Flags().GetUint64(flagPageLimit)
+			if err != nil {
+				return err
+			}
+
+			// the pages are queried at the height of the client context
+			models := []wasmtypes.Model{}
+			pageReq := &query.PageRequest{Limit: pageLimit}
+			for {
+				res, err := queryClient.AllContractState(context.Background(), &wasmtypes.QueryAllContractStateRequest{
+					ContractAddress: args[0],
+					Pagination:      pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				models = append(models, res.Models...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+
+				pageReq.Key = res.Pagination.NextKey
+			}
+
+			bz, err := json.MarshalIndent(models, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if err := ioutil.WriteFile(args[1], bz, 0o644); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported %d models of %s to %s\n", len(models), args[0], args[1])
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagPageLimit, 1000, "The number of models queried per request")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ImportContractStateCmd returns import-contract-state cobra Command.
+func ImportContractStateCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-contract-state [contract-addr-bech32] [input-file]",
+		Short: "Import the contract store of a contract from a JSON file to genesis.json",
+		Long: `Import the contract store of a contract exported by export-contract-state to genesis.json.
+The contract must exist in the genesis, and its contract store is replaced by the imported models.
+
+Example:
+$ iqd import-contract-state iq1... state.json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var models []wasmtypes.Model
+			if err := json.Unmarshal(bz, &models); err != nil {
+				return fmt.Errorf("failed to unmarshal models: %w", err)
+			}
+
+			// empty values are omitted in the JSON, but the store does not accept nil values
+			for i := range models {
+				if models[i].Value == nil {
+					models[i].Value = []byte{}
+				}
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			wasmGenState := wasmtypes.GetGenesisStateFromAppState(cdc, appState)
+
+			found := false
+			for i, contract := range wasmGenState.Contracts {
+				if contract.ContractInfo.Address == contractAddr.String() {
+					wasmGenState.Contracts[i].ContractStore = models
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("contract %s does not exist in the genesis", contractAddr)
+			}
+
+			if err := wasmtypes.ValidateGenesis(wasmGenState); err != nil {
+				return fmt.Errorf("failed to validate wasm genesis state: %w", err)
+			}
+
+			wasmGenStateBz, err := cdc.MarshalJSON(wasmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal wasm genesis state: %w", err)
+			}
+
+			appState[wasmtypes.ModuleName] = wasmGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
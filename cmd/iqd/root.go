@@ -106,13 +106,16 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.GenTxCmd(iqapp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, iqapp.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(iqapp.ModuleBasics),
 		AddGenesisAccountCmd(iqapp.DefaultNodeHome),
+		ExportContractStateCmd(),
+		ImportContractStateCmd(iqapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(iqapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 	)
 
-	a := appCreator{encodingConfig}
+	a := appCreator{encodingConfig: encodingConfig, apps: &[]*iqapp.IqApp{}}
 	server.AddCommands(rootCmd, iqapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	closeAppsOnStop(rootCmd, a)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -183,8 +186,34 @@ func txCommand() *cobra.Command {
 	return cmd
 }
 
+// closeAppsOnStop closes the apps created by the start command once the node is stopped;
+// the command returns after stopping the node, on the quit signal
+func closeAppsOnStop(rootCmd *cobra.Command, a appCreator) {
+	startCmd, _, err := rootCmd.Find([]string{"start"})
+	if err != nil || startCmd.RunE == nil {
+		return
+	}
+
+	runE := startCmd.RunE
+	startCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		defer a.closeApps(cmd)
+		return runE(cmd, args)
+	}
+}
+
 type appCreator struct {
 	encodingConfig params.EncodingConfig
+
+	// the apps created by newApp, closed on stop
+	apps *[]*iqapp.IqApp
+}
+
+func (a appCreator) closeApps(cmd *cobra.Command) {
+	for _, app := range *a.apps {
+		if err := app.Close(); err != nil {
+			server.GetServerContextFromCmd(cmd).Logger.Error("failed to close the app", "err", err)
+		}
+	}
 }
 
 // newApp is an AppCreator
@@ -221,7 +250,7 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
-	return iqapp.NewIqApp(
+	app := iqapp.NewIqApp(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
@@ -241,6 +270,9 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 		baseapp.SetIAVLCacheSize(int(cast.ToUint64(appOpts.Get(server.FlagIAVLCacheSize)))),
 	)
+
+	*a.apps = append(*a.apps, app)
+	return app
 }
 
 func (a appCreator) appExport(
@@ -8,19 +8,23 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
-	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	AccountKeeper    cosmosante.AccountKeeper
-	BankKeeper       types.BankKeeper
+	BankKeeper       BankKeeper
 	FeegrantKeeper   cosmosante.FeegrantKeeper
+	MarketKeeper     MarketKeeper
 	OracleKeeper     OracleKeeper
+	StakingKeeper    StakingKeeper
 	TreasuryKeeper   TreasuryKeeper
+	WasmKeeper       WasmKeeper
 	SignModeHandler  signing.SignModeHandler
 	SigGasConsumer   cosmosante.SignatureVerificationGasConsumer
 	IBCChannelKeeper channelkeeper.Keeper
+	RateLimit        RateLimitOptions
+	SpamPrevention   SpammingPreventionOptions
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -35,10 +39,18 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
 	}
 
+	if options.MarketKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "market keeper is required for ante builder")
+	}
+
 	if options.OracleKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "oracle keeper is required for ante builder")
 	}
 
+	if options.StakingKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "staking keeper is required for ante builder")
+	}
+
 	if options.TreasuryKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "treasury keeper is required for ante builder")
 	}
@@ -55,12 +67,13 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	return sdk.ChainAnteDecorators(
 		cosmosante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		cosmosante.NewRejectExtensionOptionsDecorator(),
-		NewSpammingPreventionDecorator(options.OracleKeeper), // spamming prevention
-		NewTaxFeeDecorator(options.TreasuryKeeper),           // mempool gas fee validation & record tax proceeds
+		NewSpammingPreventionDecorator(options.OracleKeeper, options.StakingKeeper, options.SpamPrevention), // spamming prevention
+		NewTaxFeeDecorator(options.BankKeeper, options.MarketKeeper, options.TreasuryKeeper),                // mempool gas fee validation & record tax proceeds
 		cosmosante.NewValidateBasicDecorator(),
 		cosmosante.NewTxTimeoutHeightDecorator(),
 		cosmosante.NewValidateMemoDecorator(options.AccountKeeper),
 		cosmosante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewGasSponsorshipDecorator(options.WasmKeeper), // pays the fee of the sponsored contract executions, before the fee deduction
 		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
 		cosmosante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		cosmosante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -68,5 +81,6 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		cosmosante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		cosmosante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCChannelKeeper),
+		NewRateLimitDecorator(options.RateLimit), // per sender rate limit, only counting the txs passing every other check
 	), nil
 }
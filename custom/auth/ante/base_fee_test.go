@@ -0,0 +1,104 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitwebs/iq-core/custom/auth/ante"
+	core "github.com/bitwebs/iq-core/types"
+	treasurytypes "github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+func (suite *AnteTestSuite) TestEnsureBaseFees() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures; 150atom fee with 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// Set high base gas price so standard test fee fails
+	params := suite.app.TreasuryKeeper.GetParams(suite.ctx)
+	params.MinBaseGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 3)))
+	suite.app.TreasuryKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on too low fee for base gas price")
+
+	// base fee is enforced in DeliverTx too
+	suite.ctx = suite.ctx.WithIsCheckTx(false)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on too low fee for base gas price in DeliverTx")
+
+	// simulation skips base fee check
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+
+	// Set low base gas price; 100atom base fee
+	params.MinBaseGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)))
+	suite.app.TreasuryKeeper.SetParams(suite.ctx, params)
+
+	// fee is expected to be deducted into the fee collector by the next decorators
+	suite.Require().NoError(simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, feeAmount))
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on fee higher than base gas price")
+
+	// base fee is moved to the burn module account
+	burnAddr := suite.app.AccountKeeper.GetModuleAddress(treasurytypes.BurnModuleName)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, burnAddr, "atom").Amount)
+
+	feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(sdk.NewInt(50), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, "atom").Amount)
+}
+
+func (suite *AnteTestSuite) TestComputeBaseFees() {
+	suite.SetupTest(true) // setup
+
+	gasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 3)),
+	)
+
+	// no base gas prices
+	fees, err := ante.ComputeBaseFees(suite.ctx, suite.app.MarketKeeper, 1000, sdk.NewCoins(), sdk.DecCoins{})
+	suite.Require().NoError(err)
+	suite.Require().True(fees.IsZero())
+
+	// covered by the second denom; ceil(1000 * 0.015)
+	fees, err = ante.ComputeBaseFees(suite.ctx, suite.app.MarketKeeper, 1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 20)), gasPrices)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), fees)
+
+	// not covered
+	_, err = ante.ComputeBaseFees(suite.ctx, suite.app.MarketKeeper, 1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 9)), gasPrices)
+	suite.Require().Error(err)
+
+	// covered by an oracle denom; 10ubiq is worth 20ubsdr
+	biqGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroBiqDenom, sdk.NewDecWithPrec(1, 2)))
+	suite.app.OracleKeeper.SetBiqExchangeRate(suite.ctx, core.MicroBSDRDenom, sdk.NewDec(2))
+	fees, err = ante.ComputeBaseFees(suite.ctx, suite.app.MarketKeeper, 1000, sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 20)), biqGasPrices)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 20)), fees)
+
+	_, err = ante.ComputeBaseFees(suite.ctx, suite.app.MarketKeeper, 1000, sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 19)), biqGasPrices)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// config default values
+const (
+	DefaultRateLimitMaxTxs       = uint64(0)
+	DefaultRateLimitWindowBlocks = uint64(1)
+	DefaultOracleSpamMaxEntries  = uint64(10_000)
+)
+
+// Config is the extra config required for the ante handler
+type Config struct {
+	// The maximum number of txs a sender can put into the mempool
+	// within a rate limit window; zero disables the rate limit.
+	// Oracle votes are never limited.
+	RateLimitMaxTxs uint64 `mapstructure:"rate-limit-max-txs"`
+
+	// The length of a rate limit window in blocks
+	RateLimitWindowBlocks uint64 `mapstructure:"rate-limit-window-blocks"`
+
+	// The maximum number of oracle submissions (prevotes, votes and
+	// feeder delegations) remembered for the current height. The
+	// remembered submissions are pruned whenever the height moves.
+	OracleSpamMaxEntries uint64 `mapstructure:"oracle-spam-max-entries"`
+}
+
+// DefaultConfig returns the default settings for AnteConfig
+func DefaultConfig() *Config {
+	return &Config{
+		RateLimitMaxTxs:       DefaultRateLimitMaxTxs,
+		RateLimitWindowBlocks: DefaultRateLimitWindowBlocks,
+		OracleSpamMaxEntries:  DefaultOracleSpamMaxEntries,
+	}
+}
+
+// GetConfig load config values from the app options
+func GetConfig(appOpts servertypes.AppOptions) *Config {
+	return &Config{
+		RateLimitMaxTxs:       cast.ToUint64(appOpts.Get("ante.rate-limit-max-txs")),
+		RateLimitWindowBlocks: cast.ToUint64(appOpts.Get("ante.rate-limit-window-blocks")),
+		OracleSpamMaxEntries:  cast.ToUint64(appOpts.Get("ante.oracle-spam-max-entries")),
+	}
+}
+
+// DefaultConfigTemplate default config template for ante handler
+const DefaultConfigTemplate = `
+[ante]
+# The maximum number of txs a sender can put into the mempool
+# within a rate limit window; zero disables the rate limit.
+# Oracle votes are never limited.
+rate-limit-max-txs = "{{ .AnteConfig.RateLimitMaxTxs }}"
+
+# The length of a rate limit window in blocks
+rate-limit-window-blocks = "{{ .AnteConfig.RateLimitWindowBlocks }}"
+
+# The maximum number of oracle submissions (prevotes, votes and
+# feeder delegations) remembered for the current height.
+# Submissions over the limit are rejected from the mempool.
+oracle-spam-max-entries = "{{ .AnteConfig.OracleSpamMaxEntries }}"
+`
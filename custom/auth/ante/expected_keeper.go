@@ -2,16 +2,52 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	oracleexported "github.com/bitwebs/iq-core/x/oracle/exported"
 )
 
 // TreasuryKeeper for tax charging & recording
 type TreasuryKeeper interface {
 	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
+	RecordPaymentProcessorTaxProceeds(ctx sdk.Context, payer sdk.AccAddress, delta sdk.Coins)
 	GetTaxRate(ctx sdk.Context) (taxRate sdk.Dec)
 	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
+	GetBaseGasPrices(ctx sdk.Context) (gasPrices sdk.DecCoins)
 }
 
-// OracleKeeper for feeder validation
+// TaxKeeper for tax computing
+type TaxKeeper interface {
+	GetTaxRate(ctx sdk.Context) (taxRate sdk.Dec)
+	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
+}
+
+// OracleKeeper for feeder validation & spamming prevention
 type OracleKeeper interface {
 	ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error
+	GetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress) sdk.AccAddress
+	GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (oracleexported.AggregateExchangeRatePrevote, error)
+	GetAggregateExchangeRateVote(ctx sdk.Context, voter sdk.ValAddress) (oracleexported.AggregateExchangeRateVote, error)
+}
+
+// StakingKeeper for validating the operators of feed consents
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI
+}
+
+// MarketKeeper for valuing fees paid in oracle-whitelisted denoms
+type MarketKeeper interface {
+	ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, error)
+}
+
+// BankKeeper for fee deduction & base fee burning
+type BankKeeper interface {
+	types.BankKeeper
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+}
+
+// WasmKeeper for paying the fees of the contract executions from the sponsoring contracts
+type WasmKeeper interface {
+	SponsorFee(ctx sdk.Context, contractAddress, user sdk.AccAddress, fee sdk.Coins) error
 }
@@ -0,0 +1,86 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmexported "github.com/bitwebs/iq-core/x/wasm/exported"
+)
+
+// GasSponsorshipDecorator pays the fee of a tx executing a single contract from
+// the contract balance when the contract sponsors the gas of its callers. The fee
+// is sent to the fee payer before the DeductFeeDecorator, which then deducts it as
+// usual; a tx over the sponsorship limits falls back to the fee payer balance.
+// CONTRACT: must be placed before the DeductFeeDecorator
+type GasSponsorshipDecorator struct {
+	wasmKeeper WasmKeeper
+}
+
+// NewGasSponsorshipDecorator returns new gas sponsorship decorator instance
+func NewGasSponsorshipDecorator(wasmKeeper WasmKeeper) GasSponsorshipDecorator {
+	return GasSponsorshipDecorator{
+		wasmKeeper: wasmKeeper,
+	}
+}
+
+// AnteHandle handles the fee sponsorship of the contract executions
+func (gsd GasSponsorshipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if gsd.wasmKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the fee of a granted tx is paid by the granter
+	fee := feeTx.GetFee()
+	if fee.IsZero() || feeTx.FeeGranter() != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feePayer := feeTx.FeePayer()
+	contractAddr, ok := sponsorableContract(feeTx.GetMsgs(), feePayer)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	// the failed sponsorship is discarded with its events
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := gsd.wasmKeeper.SponsorFee(cacheCtx, contractAddr, feePayer, fee); err == nil {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// sponsorableContract returns the contract executed by all the msgs
+// when the fee payer is the sender of every execution
+func sponsorableContract(msgs []sdk.Msg, feePayer sdk.AccAddress) (sdk.AccAddress, bool) {
+	var contract string
+	for _, msg := range msgs {
+		executeMsg, ok := msg.(*wasmexported.MsgExecuteContract)
+		if !ok || executeMsg.Sender != feePayer.String() {
+			return nil, false
+		}
+
+		if contract != "" && executeMsg.Contract != contract {
+			return nil, false
+		}
+		contract = executeMsg.Contract
+	}
+
+	if contract == "" {
+		return nil, false
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, false
+	}
+
+	return contractAddr, true
+}
@@ -0,0 +1,92 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/bitwebs/iq-core/custom/auth/ante"
+	core "github.com/bitwebs/iq-core/types"
+	wasmtypes "github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func (suite *AnteTestSuite) TestGasSponsorship() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	gsd := ante.NewGasSponsorshipDecorator(suite.app.WasmKeeper)
+	dfd := cosmosante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(gsd, dfd)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, contractAddr := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// the fee payer account holds no funds
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, deposit))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, contractAddr, deposit))
+	suite.app.WasmKeeper.SetContractInfo(suite.ctx, contractAddr, wasmtypes.NewContractInfo(1, contractAddr, addr1, sdk.AccAddress{}, []byte("{}"), ""))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100))
+	suite.txBuilder.SetFeeAmount(fee)
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(wasmtypes.NewMsgExecuteContract(addr1, contractAddr, []byte("{}"), sdk.Coins{})))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// not sponsored
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	userLimit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 150))
+	suite.Require().NoError(suite.app.WasmKeeper.RegisterGasSponsorship(suite.ctx, contractAddr, userLimit, 0, deposit))
+
+	// the contract pays the fee
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(deposit.Sub(fee), suite.app.BankKeeper.GetAllBalances(suite.ctx, contractAddr))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1).IsZero())
+
+	var sponsored bool
+	for _, event := range ctx.EventManager().Events() {
+		sponsored = sponsored || event.Type == wasmtypes.EventTypeSponsorFee
+	}
+	suite.Require().True(sponsored)
+
+	// over the user limit the fee payer pays
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+	suite.Require().Equal(deposit.Sub(fee), suite.app.BankKeeper.GetAllBalances(suite.ctx, contractAddr))
+
+	// the executions of other contracts and the other msgs are not sponsored
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		wasmtypes.NewMsgExecuteContract(addr1, contractAddr, []byte("{}"), sdk.Coins{}),
+		wasmtypes.NewMsgExecuteContract(addr1, addr2, []byte("{}"), sdk.Coins{}),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.app.WasmKeeper.RegisterGasSponsorship(suite.ctx, contractAddr, deposit, 0, deposit))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		wasmtypes.NewMsgExecuteContract(addr1, contractAddr, []byte("{}"), sdk.Coins{}),
+		banktypes.NewMsgSend(addr1, addr2, fee),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+	suite.Require().Equal(deposit.Sub(fee), suite.app.BankKeeper.GetAllBalances(suite.ctx, contractAddr))
+}
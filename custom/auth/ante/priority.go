@@ -0,0 +1,79 @@
+package ante
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	oracleexported "github.com/bitwebs/iq-core/x/oracle/exported"
+)
+
+// TxPriority is the priority class of a transaction. The Tendermint v0.34.14
+// mempool has no tx ordering but the oracle lane, which only the txs made of
+// oracle votes enter through BaseApp; the gov vote and IBC client update classes
+// classify the txs, but give them no mempool lane, CheckTx priority or rate limit
+// exemption.
+type TxPriority int64
+
+// Priority classes, from the lowest to the highest
+const (
+	PriorityDefault         TxPriority = 0
+	PriorityIBCClientUpdate TxPriority = 100
+	PriorityGovVote         TxPriority = 200
+	PriorityOracleVote      TxPriority = 300
+)
+
+// String implements fmt.Stringer
+func (p TxPriority) String() string {
+	switch p {
+	case PriorityIBCClientUpdate:
+		return "ibc_client_update"
+	case PriorityGovVote:
+		return "gov_vote"
+	case PriorityOracleVote:
+		return "oracle_vote"
+	default:
+		return "default"
+	}
+}
+
+// IsPrioritized returns whether the priority is above the default class
+func (p TxPriority) IsPrioritized() bool {
+	return p > PriorityDefault
+}
+
+// GetMsgPriority returns the priority class of a single msg
+func GetMsgPriority(msg sdk.Msg) TxPriority {
+	switch msg.(type) {
+	case *oracleexported.MsgAggregateExchangeRatePrevote,
+		*oracleexported.MsgAggregateExchangeRateVote:
+		return PriorityOracleVote
+	case *govtypes.MsgVote,
+		*govtypes.MsgVoteWeighted:
+		return PriorityGovVote
+	case *clienttypes.MsgUpdateClient:
+		return PriorityIBCClientUpdate
+	default:
+		return PriorityDefault
+	}
+}
+
+// GetTxPriority returns the priority class of a tx, which is the lowest
+// class of its msgs. A tx containing any unclassified msg, or no msg at all,
+// falls back to PriorityDefault so that priority cannot be borrowed by
+// bundling arbitrary msgs with a vote.
+func GetTxPriority(msgs []sdk.Msg) TxPriority {
+	if len(msgs) == 0 {
+		return PriorityDefault
+	}
+
+	priority := PriorityOracleVote
+	for _, msg := range msgs {
+		if p := GetMsgPriority(msg); p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
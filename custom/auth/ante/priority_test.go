@@ -0,0 +1,38 @@
+package ante_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/bitwebs/iq-core/custom/auth/ante"
+	oracletypes "github.com/bitwebs/iq-core/x/oracle/types"
+)
+
+func (suite *AnteTestSuite) TestGetTxPriority() {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1))
+	vote := oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1))
+	govVote := govtypes.NewMsgVote(addr1, 1, govtypes.OptionYes)
+	govVoteWeighted := govtypes.NewMsgVoteWeighted(addr1, 1, govtypes.NewNonSplitVoteOption(govtypes.OptionNo))
+	updateClient := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: addr1.String()}
+	send := banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+
+	suite.Require().Equal(ante.PriorityOracleVote, ante.GetTxPriority([]sdk.Msg{prevote, vote}))
+	suite.Require().Equal(ante.PriorityGovVote, ante.GetTxPriority([]sdk.Msg{govVote, govVoteWeighted}))
+	suite.Require().Equal(ante.PriorityIBCClientUpdate, ante.GetTxPriority([]sdk.Msg{updateClient}))
+
+	// mixed txs take the lowest priority of their msgs
+	suite.Require().Equal(ante.PriorityGovVote, ante.GetTxPriority([]sdk.Msg{vote, govVote}))
+	suite.Require().Equal(ante.PriorityDefault, ante.GetTxPriority([]sdk.Msg{vote, send}))
+	suite.Require().Equal(ante.PriorityDefault, ante.GetTxPriority([]sdk.Msg{send}))
+	suite.Require().Equal(ante.PriorityDefault, ante.GetTxPriority(nil))
+
+	suite.Require().True(ante.PriorityIBCClientUpdate.IsPrioritized())
+	suite.Require().False(ante.PriorityDefault.IsPrioritized())
+	suite.Require().Equal("oracle_vote", ante.PriorityOracleVote.String())
+}
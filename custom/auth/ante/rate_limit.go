@@ -0,0 +1,103 @@
+package ante
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RateLimitOptions are the per sender rate limit settings of the mempool
+type RateLimitOptions struct {
+	// MaxTxsPerWindow is the maximum number of txs a sender can submit
+	// to the mempool within a window; zero disables rate limiting
+	MaxTxsPerWindow uint64
+
+	// WindowBlocks is the length of a rate limit window in blocks
+	WindowBlocks uint64
+}
+
+// IsEnabled returns whether rate limiting is enabled
+func (opts RateLimitOptions) IsEnabled() bool {
+	return opts.MaxTxsPerWindow > 0 && opts.WindowBlocks > 0
+}
+
+// RateLimitDecorator limits the number of txs a sender can put into the
+// mempool within a window of blocks. Only the oracle vote txs are not limited,
+// as the spamming prevention already rejects their duplicates; gov votes and
+// IBC client updates are limited like any other tx, so they cannot be used to
+// wrap spam.
+// CONTRACT: the decorator must be placed after signature verification, so that
+// only authenticated txs are counted against the limit of their fee payer.
+type RateLimitDecorator struct {
+	options RateLimitOptions
+	state   *rateLimitState
+}
+
+// rateLimitState holds the tx counts of the current window
+type rateLimitState struct {
+	mu          sync.Mutex
+	windowStart int64
+	txCounts    map[string]uint64
+}
+
+// NewRateLimitDecorator returns new rate limit decorator instance
+func NewRateLimitDecorator(options RateLimitOptions) RateLimitDecorator {
+	return RateLimitDecorator{
+		options: options,
+		state: &rateLimitState{
+			txCounts: make(map[string]uint64),
+		},
+	}
+}
+
+// AnteHandle handles per sender rate limit checking
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !rld.options.IsEnabled() || simulate || !ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	if GetTxPriority(tx.GetMsgs()) == PriorityOracleVote {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if err := rld.CheckRateLimit(ctx, feeTx.FeePayer()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckRateLimit counts a tx from the sender and returns an error
+// when the sender exceeded its limit in the current window
+func (rld RateLimitDecorator) CheckRateLimit(ctx sdk.Context, sender sdk.AccAddress) error {
+	state := rld.state
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	// windows are aligned to multiples of WindowBlocks; counts are reset
+	// as soon as a new window starts
+	curHeight := ctx.BlockHeight()
+	windowStart := curHeight - curHeight%int64(rld.options.WindowBlocks)
+	if windowStart != state.windowStart {
+		state.windowStart = windowStart
+		state.txCounts = make(map[string]uint64)
+	}
+
+	key := sender.String()
+	if state.txCounts[key] >= rld.options.MaxTxsPerWindow {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"the sender %s has exceeded the rate limit of %d txs per %d blocks",
+			sender, rld.options.MaxTxsPerWindow, rld.options.WindowBlocks,
+		)
+	}
+
+	state.txCounts[key]++
+	return nil
+}
@@ -0,0 +1,109 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/bitwebs/iq-core/custom/auth/ante"
+	oracletypes "github.com/bitwebs/iq-core/x/oracle/types"
+)
+
+func (suite *AnteTestSuite) TestRateLimit() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	rld := ante.NewRateLimitDecorator(ante.RateLimitOptions{MaxTxsPerWindow: 2, WindowBlocks: 5})
+	antehandler := sdk.ChainAnteDecorators(rld)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithBlockHeight(100)
+
+	// two txs are allowed within the window
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithBlockHeight(101), tx, false)
+	suite.Require().NoError(err)
+
+	// third one is rejected
+	_, err = antehandler(suite.ctx.WithBlockHeight(104), tx, false)
+	suite.Require().Error(err)
+
+	// not limited in DeliverTx, ReCheckTx and simulation
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithIsReCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+
+	// oracle votes are never limited
+	suite.Require().NoError(suite.txBuilder.SetMsgs(oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1))))
+	oracleVoteTx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, oracleVoteTx, false)
+	suite.Require().NoError(err)
+
+	// while gov votes are limited like any other tx
+	suite.Require().NoError(suite.txBuilder.SetMsgs(govtypes.NewMsgVote(addr1, 1, govtypes.OptionYes)))
+	govVoteTx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, govVoteTx, false)
+	suite.Require().Error(err)
+
+	// next window resets the count
+	_, err = antehandler(suite.ctx.WithBlockHeight(105), tx, false)
+	suite.Require().NoError(err)
+
+	// disabled rate limit
+	antehandler = sdk.ChainAnteDecorators(ante.NewRateLimitDecorator(ante.RateLimitOptions{}))
+	for i := 0; i < 5; i++ {
+		_, err = antehandler(suite.ctx, tx, false)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *AnteTestSuite) TestRateLimitIgnoresRejectedTxs() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithBlockHeight(100)
+	rld := ante.NewRateLimitDecorator(ante.RateLimitOptions{MaxTxsPerWindow: 1, WindowBlocks: 5})
+
+	// txs rejected by the previous decorators are not counted
+	antehandler := sdk.ChainAnteDecorators(rejectDecorator{}, rld)
+	for i := 0; i < 3; i++ {
+		_, err = antehandler(suite.ctx, tx, false)
+		suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	}
+
+	antehandler = sdk.ChainAnteDecorators(rld)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
+
+// rejectDecorator rejects every tx, as a failed signature verification would
+type rejectDecorator struct{}
+
+func (rejectDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, sdkerrors.ErrUnauthorized
+}
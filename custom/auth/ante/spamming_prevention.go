@@ -3,105 +3,312 @@ package ante
 import (
 	"sync"
 
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/bitwebs/iq-core/custom/auth/ante/config"
 	oracleexported "github.com/bitwebs/iq-core/x/oracle/exported"
 )
 
+// oracle spam rejection reasons, used as metric labels
+const (
+	spamReasonDuplicatePrevote     = "duplicate_prevote"
+	spamReasonDuplicatePrevoteHash = "duplicate_prevote_hash"
+	spamReasonDuplicateVote        = "duplicate_vote"
+	spamReasonDuplicateFeedConsent = "duplicate_feed_consent"
+	spamReasonUnchangedFeedConsent = "unchanged_feed_consent"
+	spamReasonInvalidFeeder        = "invalid_feeder"
+	spamReasonInvalidOperator      = "invalid_operator"
+	spamReasonCacheFull            = "cache_full"
+)
+
+// SpammingPreventionOptions are the oracle spam prevention settings
+type SpammingPreventionOptions struct {
+	// MaxEntries is the maximum number of oracle submissions remembered
+	// for the current height; submissions over the limit are rejected
+	MaxEntries uint64
+}
+
 // SpammingPreventionDecorator will check if the transaction's gas is smaller than
 // configured hard cap
 type SpammingPreventionDecorator struct {
-	oracleKeeper     OracleKeeper
-	oraclePrevoteMap map[string]int64
-	oracleVoteMap    map[string]int64
-	mu               *sync.Mutex
+	oracleKeeper  OracleKeeper
+	stakingKeeper StakingKeeper
+	submissions   *submissionCache
 }
 
 // NewSpammingPreventionDecorator returns new spamming prevention decorator instance
-func NewSpammingPreventionDecorator(oracleKeeper OracleKeeper) SpammingPreventionDecorator {
+func NewSpammingPreventionDecorator(oracleKeeper OracleKeeper, stakingKeeper StakingKeeper, options SpammingPreventionOptions) SpammingPreventionDecorator {
+	maxEntries := options.MaxEntries
+	if maxEntries == 0 {
+		maxEntries = config.DefaultOracleSpamMaxEntries
+	}
+
 	return SpammingPreventionDecorator{
-		oracleKeeper:     oracleKeeper,
-		oraclePrevoteMap: make(map[string]int64),
-		oracleVoteMap:    make(map[string]int64),
-		mu:               &sync.Mutex{},
+		oracleKeeper:  oracleKeeper,
+		stakingKeeper: stakingKeeper,
+		submissions:   newSubmissionCache(maxEntries),
 	}
 }
 
 // AnteHandle handles msg tax fee checking
 func (spd SpammingPreventionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if ctx.IsReCheckTx() {
+	if ctx.IsReCheckTx() || !(ctx.IsCheckTx() || simulate) {
 		return next(ctx, tx, simulate)
 	}
 
-	if !simulate {
-		if ctx.IsCheckTx() {
-			err := spd.CheckOracleSpamming(ctx, tx.GetMsgs())
-			if err != nil {
-				return ctx, err
-			}
-		}
+	// Simulation runs the same checks against the shared submissions,
+	// without recording the simulated tx
+	if err := spd.CheckOracleSpamming(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil || simulate {
+		return newCtx, err
+	}
+
+	// The submissions are recorded once the signatures are verified, so that
+	// an unsigned tx can neither block the submissions of a validator nor fill
+	// the submissions of the height
+	if err := spd.RecordOracleSubmissions(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
 	}
 
-	return next(ctx, tx, simulate)
+	return newCtx, nil
 }
 
-// CheckOracleSpamming check whether the msgs are spamming purpose or not
+// CheckOracleSpamming check whether the msgs are spamming purpose or not.
 func (spd SpammingPreventionDecorator) CheckOracleSpamming(ctx sdk.Context, msgs []sdk.Msg) error {
-	spd.mu.Lock()
-	defer spd.mu.Unlock()
+	spd.submissions.mu.Lock()
+	defer spd.submissions.mu.Unlock()
+
+	_, err := spd.checkOracleSpamming(ctx, msgs)
+	return err
+}
+
+// RecordOracleSubmissions checks the msgs again and records their submissions
+// for the current height, when all msgs pass and the submissions have room.
+func (spd SpammingPreventionDecorator) RecordOracleSubmissions(ctx sdk.Context, msgs []sdk.Msg) error {
+	spd.submissions.mu.Lock()
+	defer spd.submissions.mu.Unlock()
+
+	keys, err := spd.checkOracleSpamming(ctx, msgs)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	if !spd.submissions.hasRoom(len(keys)) {
+		return rejectSpam(spamReasonCacheFull, "too many oracle submissions at the current height")
+	}
+
+	spd.submissions.add(keys...)
+	return nil
+}
+
+// checkOracleSpamming returns the submission keys of the msgs once they all pass;
+// the caller must hold the submissions lock
+func (spd SpammingPreventionDecorator) checkOracleSpamming(ctx sdk.Context, msgs []sdk.Msg) ([]string, error) {
+	spd.submissions.prune(ctx.BlockHeight())
 
-	curHeight := ctx.BlockHeight()
+	pending := make(map[string]struct{})
+	var keys []string
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case *oracleexported.MsgAggregateExchangeRatePrevote:
-			feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+			valAddr, err := spd.validateFeeder(ctx, msg.Feeder, msg.Validator)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
-			valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
-			if err != nil {
-				return err
+			key := submissionKey("prevote", msg.Validator)
+			if spd.submissions.has(key) || hasKey(pending, key) {
+				return nil, rejectSpam(spamReasonDuplicatePrevote, "the validator has already been submitted prevote at the current height")
 			}
 
-			err = spd.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr)
-			if err != nil {
-				return err
+			// the stored prevote survives restarts, unlike the submissions cache
+			if prevote, err := spd.oracleKeeper.GetAggregateExchangeRatePrevote(ctx, valAddr); err == nil && prevote.Hash == msg.Hash {
+				return nil, rejectSpam(spamReasonDuplicatePrevoteHash, "the validator has already been submitted prevote with the same hash")
 			}
 
-			if lastSubmittedHeight, ok := spd.oraclePrevoteMap[msg.Validator]; ok && lastSubmittedHeight == curHeight {
-				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the validator has already been submitted prevote at the current height")
+			pending[key] = struct{}{}
+			keys = append(keys, key)
+		case *oracleexported.MsgAggregateExchangeRateVote:
+			if _, err := spd.validateFeeder(ctx, msg.Feeder, msg.Validator); err != nil {
+				return nil, err
 			}
 
-			spd.oraclePrevoteMap[msg.Validator] = curHeight
-			continue
-		case *oracleexported.MsgAggregateExchangeRateVote:
-			feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
-			if err != nil {
-				return err
+			key := submissionKey("vote", msg.Validator)
+			if spd.submissions.has(key) || hasKey(pending, key) {
+				return nil, rejectSpam(spamReasonDuplicateVote, "the validator has already been submitted vote at the current height")
 			}
 
-			valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+			pending[key] = struct{}{}
+			keys = append(keys, key)
+		case *oracleexported.MsgDelegateFeedConsent:
+			operator, err := sdk.ValAddressFromBech32(msg.Operator)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
-			err = spd.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr)
+			delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
-			if lastSubmittedHeight, ok := spd.oracleVoteMap[msg.Validator]; ok && lastSubmittedHeight == curHeight {
-				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the validator has already been submitted vote at the current height")
+			if spd.stakingKeeper.Validator(ctx, operator) == nil {
+				return nil, rejectSpam(spamReasonInvalidOperator, "the operator is not a validator")
 			}
 
-			spd.oracleVoteMap[msg.Validator] = curHeight
-			continue
+			key := submissionKey("feeder", msg.Operator)
+			if spd.submissions.has(key) || hasKey(pending, key) {
+				return nil, rejectSpam(spamReasonDuplicateFeedConsent, "the validator has already been submitted feed consent at the current height")
+			}
+
+			if spd.oracleKeeper.GetFeederDelegation(ctx, operator).Equals(delegate) {
+				return nil, rejectSpam(spamReasonUnchangedFeedConsent, "the feeder is already delegated to the given address")
+			}
+
+			pending[key] = struct{}{}
+			keys = append(keys, key)
 		default:
-			return nil
+			continue
+		}
+	}
+
+	return keys, nil
+}
+
+// CheckContractOracleMsg checks the oracle msg dispatched by a contract, which the ante handler
+// never sees. It runs in the consensus, so the duplicates are checked against the state instead
+// of the submissions cache; it is registered to the wasm keeper as a msg filter.
+func (spd SpammingPreventionDecorator) CheckContractOracleMsg(ctx sdk.Context, _ sdk.AccAddress, msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *oracleexported.MsgAggregateExchangeRatePrevote:
+		valAddr, err := spd.validateFeeder(ctx, msg.Feeder, msg.Validator)
+		if err != nil {
+			return err
+		}
+
+		if prevote, err := spd.oracleKeeper.GetAggregateExchangeRatePrevote(ctx, valAddr); err == nil {
+			if prevote.SubmitBlock == uint64(ctx.BlockHeight()) {
+				return rejectSpam(spamReasonDuplicatePrevote, "the validator has already been submitted prevote at the current height")
+			}
+
+			if prevote.Hash == msg.Hash {
+				return rejectSpam(spamReasonDuplicatePrevoteHash, "the validator has already been submitted prevote with the same hash")
+			}
+		}
+	case *oracleexported.MsgAggregateExchangeRateVote:
+		valAddr, err := spd.validateFeeder(ctx, msg.Feeder, msg.Validator)
+		if err != nil {
+			return err
+		}
+
+		// the votes are cleared at the end of each vote period
+		if _, err := spd.oracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr); err == nil {
+			return rejectSpam(spamReasonDuplicateVote, "the validator has already been submitted vote at the current vote period")
+		}
+	case *oracleexported.MsgDelegateFeedConsent:
+		operator, err := sdk.ValAddressFromBech32(msg.Operator)
+		if err != nil {
+			return err
+		}
+
+		delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+		if err != nil {
+			return err
+		}
+
+		if spd.oracleKeeper.GetFeederDelegation(ctx, operator).Equals(delegate) {
+			return rejectSpam(spamReasonUnchangedFeedConsent, "the feeder is already delegated to the given address")
 		}
 	}
 
 	return nil
 }
+
+func (spd SpammingPreventionDecorator) validateFeeder(ctx sdk.Context, feeder string, validator string) (sdk.ValAddress, error) {
+	feederAddr, err := sdk.AccAddressFromBech32(feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := spd.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		incrSpamRejected(spamReasonInvalidFeeder)
+		return nil, err
+	}
+
+	return valAddr, nil
+}
+
+func rejectSpam(reason string, msg string) error {
+	incrSpamRejected(reason)
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msg)
+}
+
+func incrSpamRejected(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"ante", "oracle_spam", "rejected"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
+
+func hasKey(keys map[string]struct{}, key string) bool {
+	_, ok := keys[key]
+	return ok
+}
+
+func submissionKey(kind string, validator string) string {
+	return kind + "/" + validator
+}
+
+// submissionCache remembers the oracle submissions of a single height.
+// It is bounded by maxEntries and pruned whenever the height moves.
+type submissionCache struct {
+	mu         sync.Mutex
+	height     int64
+	maxEntries uint64
+	entries    map[string]struct{}
+}
+
+func newSubmissionCache(maxEntries uint64) *submissionCache {
+	return &submissionCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]struct{}),
+	}
+}
+
+// prune drops all the submissions once the height moves past the cached one
+func (c *submissionCache) prune(height int64) {
+	if height <= c.height {
+		return
+	}
+
+	c.height = height
+	c.entries = make(map[string]struct{})
+}
+
+func (c *submissionCache) has(key string) bool {
+	_, ok := c.entries[key]
+	return ok
+}
+
+func (c *submissionCache) hasRoom(n int) bool {
+	return uint64(len(c.entries)+n) <= c.maxEntries
+}
+
+func (c *submissionCache) add(keys ...string) {
+	for _, key := range keys {
+		c.entries[key] = struct{}{}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/bitwebs/iq-core/custom/auth/ante"
 	oracletypes "github.com/bitwebs/iq-core/x/oracle/types"
@@ -22,7 +24,7 @@ func (suite *AnteTestSuite) TestOracleSpamming() {
 			sdk.ValAddress(addr1).String(): addr1.String(),
 			sdk.ValAddress(addr2).String(): addr2.String(),
 		},
-	})
+	}, dummyStakingKeeper{}, ante.SpammingPreventionOptions{})
 	antehandler := sdk.ChainAnteDecorators(spd)
 
 	// Set IsCheckTx to true
@@ -75,8 +77,228 @@ func (suite *AnteTestSuite) TestOracleSpamming() {
 	suite.Require().Error(err)
 }
 
+func (suite *AnteTestSuite) TestOracleSpammingPrevoteHash() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	hash := oracletypes.GetAggregateVoteHash("salt", "1.0ubsdr", sdk.ValAddress(addr1))
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders:  map[string]string{sdk.ValAddress(addr1).String(): addr1.String()},
+		prevotes: map[string]string{sdk.ValAddress(addr1).String(): hash.String()},
+	}, dummyStakingKeeper{}, ante.SpammingPreventionOptions{})
+	antehandler := sdk.ChainAnteDecorators(spd)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithBlockHeight(100)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// same hash as the stored prevote is rejected
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(hash, addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// new hash is ok
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.GetAggregateVoteHash("salt2", "1.0ubsdr", sdk.ValAddress(addr1)), addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// simulation does not record the submission
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// simulation sees the recorded submission
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().Error(err)
+
+	// duplicate prevotes in a single tx are rejected
+	suite.ctx = suite.ctx.WithBlockHeight(101)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1)),
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
+
+func (suite *AnteTestSuite) TestOracleSpammingFeedConsent() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders: map[string]string{sdk.ValAddress(addr1).String(): addr2.String()},
+	}, dummyStakingKeeper{sdk.ValAddress(addr1).String(): true}, ante.SpammingPreventionOptions{})
+	antehandler := sdk.ChainAnteDecorators(spd)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithBlockHeight(100)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// delegating to the current feeder is rejected
+	suite.Require().NoError(suite.txBuilder.SetMsgs(oracletypes.NewMsgDelegateFeedConsent(sdk.ValAddress(addr1), addr2)))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// new feeder is ok
+	suite.Require().NoError(suite.txBuilder.SetMsgs(oracletypes.NewMsgDelegateFeedConsent(sdk.ValAddress(addr1), addr3)))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// do it again is blocked
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// next block; pruned
+	_, err = antehandler(suite.ctx.WithBlockHeight(101), tx, false)
+	suite.Require().NoError(err)
+
+	// the operator must be a validator
+	suite.Require().NoError(suite.txBuilder.SetMsgs(oracletypes.NewMsgDelegateFeedConsent(sdk.ValAddress(addr3), addr2)))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
+
+func (suite *AnteTestSuite) TestOracleSpammingRejectedTx() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders: map[string]string{sdk.ValAddress(addr1).String(): addr1.String()},
+	}, dummyStakingKeeper{}, ante.SpammingPreventionOptions{MaxEntries: 1})
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithBlockHeight(100)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the submissions of the txs rejected by the following decorators are not recorded
+	_, err = sdk.ChainAnteDecorators(spd, rejectDecorator{})(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	antehandler := sdk.ChainAnteDecorators(spd)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
+
+func (suite *AnteTestSuite) TestOracleSpammingMaxEntries() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders: map[string]string{sdk.ValAddress(addr1).String(): addr1.String()},
+	}, dummyStakingKeeper{}, ante.SpammingPreventionOptions{MaxEntries: 1})
+	antehandler := sdk.ChainAnteDecorators(spd)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithBlockHeight(100)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// two submissions do not fit
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1)),
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// one does
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
+
+func (suite *AnteTestSuite) TestContractOracleMsg() {
+	suite.SetupTest(true) // setup
+
+	// the contract is the feeder of the validator
+	_, _, operator := testdata.KeyTestPubAddr()
+	_, _, contractAddr := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(operator)
+	hash := oracletypes.GetAggregateVoteHash("salt", "1.0ubsdr", valAddr)
+
+	oracleKeeper := dummyOracleKeeper{
+		feeders:  map[string]string{valAddr.String(): contractAddr.String()},
+		prevotes: map[string]string{},
+		votes:    map[string]bool{},
+	}
+	spd := ante.NewSpammingPreventionDecorator(oracleKeeper, dummyStakingKeeper{}, ante.SpammingPreventionOptions{})
+	ctx := suite.ctx.WithBlockHeight(100)
+
+	// the state has no submissions, so the contract can submit any of them repeatedly
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, contractAddr, valAddr)
+	vote := oracletypes.NewMsgAggregateExchangeRateVote("salt", "1.0ubsdr", contractAddr, valAddr)
+	suite.Require().NoError(spd.CheckContractOracleMsg(ctx, contractAddr, prevote))
+	suite.Require().NoError(spd.CheckContractOracleMsg(ctx, contractAddr, prevote))
+	suite.Require().NoError(spd.CheckContractOracleMsg(ctx, contractAddr, vote))
+
+	// not the feeder of the validator
+	suite.Require().Error(spd.CheckContractOracleMsg(ctx, other, oracletypes.NewMsgAggregateExchangeRatePrevote(hash, other, valAddr)))
+	suite.Require().Error(spd.CheckContractOracleMsg(ctx, other, oracletypes.NewMsgAggregateExchangeRateVote("salt", "1.0ubsdr", other, valAddr)))
+
+	// the stored prevote with the same hash
+	oracleKeeper.prevotes[valAddr.String()] = hash.String()
+	suite.Require().Error(spd.CheckContractOracleMsg(ctx, contractAddr, prevote))
+	suite.Require().NoError(spd.CheckContractOracleMsg(ctx, contractAddr,
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.GetAggregateVoteHash("salt2", "1.0ubsdr", valAddr), contractAddr, valAddr)))
+
+	// the stored vote of the vote period
+	oracleKeeper.votes[valAddr.String()] = true
+	suite.Require().Error(spd.CheckContractOracleMsg(ctx, contractAddr, vote))
+
+	// the unchanged feed consent of the contract operator
+	contractVal := sdk.ValAddress(contractAddr)
+	oracleKeeper.feeders[contractVal.String()] = other.String()
+	suite.Require().Error(spd.CheckContractOracleMsg(ctx, contractAddr, oracletypes.NewMsgDelegateFeedConsent(contractVal, other)))
+	suite.Require().NoError(spd.CheckContractOracleMsg(ctx, contractAddr, oracletypes.NewMsgDelegateFeedConsent(contractVal, operator)))
+
+	// the other msgs are not checked
+	suite.Require().NoError(spd.CheckContractOracleMsg(ctx, contractAddr, &banktypes.MsgSend{}))
+}
+
 type dummyOracleKeeper struct {
-	feeders map[string]string
+	feeders  map[string]string
+	prevotes map[string]string
+	votes    map[string]bool
 }
 
 func (ok dummyOracleKeeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
@@ -86,3 +308,38 @@ func (ok dummyOracleKeeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAd
 
 	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cannot ensure feeder right")
 }
+
+func (ok dummyOracleKeeper) GetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress) sdk.AccAddress {
+	if feeder, ok := ok.feeders[operator.String()]; ok {
+		feederAddr, _ := sdk.AccAddressFromBech32(feeder)
+		return feederAddr
+	}
+
+	return sdk.AccAddress(operator)
+}
+
+func (ok dummyOracleKeeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (oracletypes.AggregateExchangeRatePrevote, error) {
+	if hash, ok := ok.prevotes[voter.String()]; ok {
+		return oracletypes.AggregateExchangeRatePrevote{Hash: hash, Voter: voter.String()}, nil
+	}
+
+	return oracletypes.AggregateExchangeRatePrevote{}, sdkerrors.Wrap(oracletypes.ErrNoAggregatePrevote, voter.String())
+}
+
+func (ok dummyOracleKeeper) GetAggregateExchangeRateVote(ctx sdk.Context, voter sdk.ValAddress) (oracletypes.AggregateExchangeRateVote, error) {
+	if ok.votes[voter.String()] {
+		return oracletypes.AggregateExchangeRateVote{Voter: voter.String()}, nil
+	}
+
+	return oracletypes.AggregateExchangeRateVote{}, sdkerrors.Wrap(oracletypes.ErrNoAggregateVote, voter.String())
+}
+
+type dummyStakingKeeper map[string]bool
+
+func (sk dummyStakingKeeper) Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI {
+	if !sk[address.String()] {
+		return nil
+	}
+
+	return stakingtypes.Validator{OperatorAddress: address.String()}
+}
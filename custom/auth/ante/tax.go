@@ -5,12 +5,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	core "github.com/bitwebs/iq-core/types"
 	marketexported "github.com/bitwebs/iq-core/x/market/exported"
-	oracleexported "github.com/bitwebs/iq-core/x/oracle/exported"
+	treasurytypes "github.com/bitwebs/iq-core/x/treasury/types"
 	wasmexported "github.com/bitwebs/iq-core/x/wasm/exported"
 )
 
@@ -22,15 +23,24 @@ const MaxOracleMsgGasUsage = uint64(100_000)
 // and record tax proceeds to treasury module to track tax proceeds.
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
+// The fee must also cover the on-chain base fee (gas * base gas price) in both
+// CheckTx and DeliverTx; the base fee is burned through the burn module account
+// once the fee has been deducted.
+// Fees paid in any oracle-whitelisted denom are valued at the current oracle
+// rate against the canonical gas price denom (ubiq).
 // If fee is high enough or not CheckTx, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type TaxFeeDecorator struct {
+	bankKeeper     BankKeeper
+	marketKeeper   MarketKeeper
 	treasuryKeeper TreasuryKeeper
 }
 
 // NewTaxFeeDecorator returns new tax fee decorator instance
-func NewTaxFeeDecorator(treasuryKeeper TreasuryKeeper) TaxFeeDecorator {
+func NewTaxFeeDecorator(bankKeeper BankKeeper, marketKeeper MarketKeeper, treasuryKeeper TreasuryKeeper) TaxFeeDecorator {
 	return TaxFeeDecorator{
+		bankKeeper:     bankKeeper,
+		marketKeeper:   marketKeeper,
 		treasuryKeeper: treasuryKeeper,
 	}
 }
@@ -46,15 +56,17 @@ func (tfd TaxFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 	gas := feeTx.GetGas()
 	msgs := feeTx.GetMsgs()
 
+	baseFees := sdk.Coins{}
 	if !simulate {
 		// Compute taxes
 		taxes := FilterMsgAndComputeTax(ctx, tfd.treasuryKeeper, msgs...)
 
+		// No gas fee validation for oracle txs
+		isOracleTx := isOracleTx(ctx, msgs) && gas <= uint64(len(msgs))*MaxOracleMsgGasUsage
+
 		// Mempool fee validation
-		// No fee validation for oracle txs
-		if ctx.IsCheckTx() &&
-			!(isOracleTx(ctx, msgs) && gas <= uint64(len(msgs))*MaxOracleMsgGasUsage) {
-			if err := EnsureSufficientMempoolFees(ctx, gas, feeCoins, taxes); err != nil {
+		if ctx.IsCheckTx() && !isOracleTx {
+			if err := EnsureSufficientMempoolFees(ctx, tfd.marketKeeper, gas, feeCoins, taxes); err != nil {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
 			}
 		}
@@ -64,22 +76,87 @@ func (tfd TaxFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, taxes)
 		}
 
+		// Ensure paid fee is enough to cover base fee
+		if !isOracleTx {
+			if baseFees, err = ComputeBaseFees(ctx, tfd.marketKeeper, gas, feeCoins.Sub(taxes), tfd.treasuryKeeper.GetBaseGasPrices(ctx)); err != nil {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
+			}
+		}
+
 		// Record tax proceeds
 		if !taxes.IsZero() {
 			tfd.treasuryKeeper.RecordEpochTaxProceeds(ctx, taxes)
+
+			// Track taxes paid by registered payment processors for epoch rebates
+			payer := feeTx.FeePayer()
+			if granter := feeTx.FeeGranter(); granter != nil {
+				payer = granter
+			}
+
+			tfd.treasuryKeeper.RecordPaymentProcessorTaxProceeds(ctx, payer, taxes)
 		}
 	}
 
-	return next(ctx, tx, simulate)
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil || baseFees.IsZero() {
+		return newCtx, err
+	}
+
+	// Burn base fee from the deducted fee
+	if err := tfd.bankKeeper.SendCoinsFromModuleToModule(newCtx, authtypes.FeeCollectorName, treasurytypes.BurnModuleName, baseFees); err != nil {
+		return newCtx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	return newCtx, nil
+}
+
+// ComputeBaseFees returns the base fee (gas * base gas price) to be burned,
+// charged in the first denom of the base gas prices the fee can cover.
+// When the fee covers none of them, the base fee is charged in the first fee
+// denom that covers it at the current oracle rate.
+// Returns an error when the fee cannot cover the base fee in any denom.
+func ComputeBaseFees(ctx sdk.Context, mk MarketKeeper, gas uint64, feeCoins sdk.Coins, baseGasPrices sdk.DecCoins) (sdk.Coins, error) {
+	if baseGasPrices.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	requiredFees := make(sdk.Coins, 0, len(baseGasPrices))
+	glDec := sdk.NewDec(int64(gas))
+	for _, gp := range baseGasPrices {
+		fee := sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+		if feeCoins.AmountOf(fee.Denom).GTE(fee.Amount) {
+			return sdk.NewCoins(fee), nil
+		}
+
+		requiredFees = append(requiredFees, fee)
+	}
+
+	// Value the base fee in the other fee denoms
+	requiredFee := sdk.NewDecCoinFromCoin(requiredFees[0])
+	for _, coin := range feeCoins {
+		converted, err := mk.ComputeInternalSwap(ctx, requiredFee, coin.Denom)
+		if err != nil {
+			continue
+		}
+
+		if fee := sdk.NewCoin(coin.Denom, converted.Amount.Ceil().RoundInt()); coin.Amount.GTE(fee.Amount) {
+			return sdk.NewCoins(fee), nil
+		}
+	}
+
+	return nil, fmt.Errorf("insufficient fees; got: %q, required one of: %q(base fee)", feeCoins, requiredFees)
 }
 
 // EnsureSufficientMempoolFees verifies that the given transaction has supplied
 // enough fees(gas + stability) to cover a proposer's minimum fees. A result object is returned
 // indicating success or failure.
+// When the fee does not cover the minimum fees in any of their denoms, the fee
+// coins are valued at the current oracle rate against the minimum fee in the
+// canonical gas price denom (ubiq).
 //
 // Contract: This should only be called during CheckTx as it cannot be part of
 // consensus.
-func EnsureSufficientMempoolFees(ctx sdk.Context, gas uint64, feeCoins sdk.Coins, taxes sdk.Coins) error {
+func EnsureSufficientMempoolFees(ctx sdk.Context, mk MarketKeeper, gas uint64, feeCoins sdk.Coins, taxes sdk.Coins) error {
 	requiredFees := sdk.Coins{}
 	minGasPrices := ctx.MinGasPrices()
 	if !minGasPrices.IsZero() {
@@ -100,15 +177,37 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, gas uint64, feeCoins sdk.Coins
 		return fmt.Errorf("insufficient fees; got: %q, required: %q = %q(gas) +%q(stability)", feeCoins.Add(taxes...), requiredFees.Add(taxes...), requiredFees, taxes)
 	}
 
-	if !requiredFees.IsZero() && !feeCoins.IsAnyGTE(requiredFees) {
+	if !requiredFees.IsZero() && !feeCoins.IsAnyGTE(requiredFees) && !isSufficientInCanonicalDenom(ctx, mk, feeCoins, requiredFees) {
 		return fmt.Errorf("insufficient fees; got: %q, required: %q = %q(gas) +%q(stability)", feeCoins.Add(taxes...), requiredFees.Add(taxes...), requiredFees, taxes)
 	}
 
 	return nil
 }
 
+// isSufficientInCanonicalDenom returns whether the fee coins, valued at the current
+// oracle rates, cover the required fee in the canonical gas price denom.
+// Denoms without an oracle rate are ignored.
+func isSufficientInCanonicalDenom(ctx sdk.Context, mk MarketKeeper, feeCoins sdk.Coins, requiredFees sdk.Coins) bool {
+	requiredAmount := requiredFees.AmountOf(core.MicroBiqDenom)
+	if !requiredAmount.IsPositive() {
+		return false
+	}
+
+	totalAmount := sdk.ZeroDec()
+	for _, coin := range feeCoins {
+		converted, err := mk.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(coin), core.MicroBiqDenom)
+		if err != nil {
+			continue
+		}
+
+		totalAmount = totalAmount.Add(converted.Amount)
+	}
+
+	return totalAmount.GTE(requiredAmount.ToDec())
+}
+
 // FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
-func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs ...sdk.Msg) sdk.Coins {
+func FilterMsgAndComputeTax(ctx sdk.Context, tk TaxKeeper, msgs ...sdk.Msg) sdk.Coins {
 	taxes := sdk.Coins{}
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
@@ -143,7 +242,7 @@ func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs ...sdk.Msg)
 }
 
 // computes the stability tax according to tax-rate and tax-cap
-func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coins {
+func computeTax(ctx sdk.Context, tk TaxKeeper, principal sdk.Coins) sdk.Coins {
 	taxRate := tk.GetTaxRate(ctx)
 	if taxRate.Equal(sdk.ZeroDec()) {
 		return sdk.Coins{}
@@ -174,16 +273,5 @@ func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coi
 }
 
 func isOracleTx(ctx sdk.Context, msgs []sdk.Msg) bool {
-	for _, msg := range msgs {
-		switch msg.(type) {
-		case *oracleexported.MsgAggregateExchangeRatePrevote:
-			continue
-		case *oracleexported.MsgAggregateExchangeRateVote:
-			continue
-		default:
-			return false
-		}
-	}
-
-	return true
+	return GetTxPriority(msgs) == PriorityOracleVote
 }
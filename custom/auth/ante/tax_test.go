@@ -17,7 +17,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesGas() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -69,7 +69,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -77,7 +77,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSend() {
 
 	// msg and signatures
 	sendAmount := int64(1000000)
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, sendAmount))
 	msg := banktypes.NewMsgSend(addr1, addr1, sendCoins)
 
 	feeAmount := testdata.NewTestFeeAmount()
@@ -102,12 +102,12 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSend() {
 
 	tk := suite.app.TreasuryKeeper
 	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
-	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroBSDRDenom); expectedTax.GT(taxCap) {
 		expectedTax = taxCap
 	}
 
 	// set tax amount
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax)))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
@@ -120,7 +120,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -128,8 +128,8 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapSend() {
 
 	// msg and signatures
 	sendAmount := int64(1000000)
-	sendCoin := sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount)
-	msg := markettypes.NewMsgSwapSend(addr1, addr1, sendCoin, core.MicroKRWDenom)
+	sendCoin := sdk.NewInt64Coin(core.MicroBSDRDenom, sendAmount)
+	msg := markettypes.NewMsgSwapSend(addr1, addr1, sendCoin, core.MicroBKRWDenom)
 
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
@@ -153,12 +153,12 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapSend() {
 
 	tk := suite.app.TreasuryKeeper
 	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
-	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroBSDRDenom); expectedTax.GT(taxCap) {
 		expectedTax = taxCap
 	}
 
 	// set tax amount
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax)))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
@@ -171,7 +171,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesMultiSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -179,7 +179,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesMultiSend() {
 
 	// msg and signatures
 	sendAmount := int64(1000000)
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, sendAmount))
 	msg := banktypes.NewMsgMultiSend(
 		[]banktypes.Input{
 			banktypes.NewInput(addr1, sendCoins),
@@ -212,18 +212,18 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesMultiSend() {
 
 	tk := suite.app.TreasuryKeeper
 	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
-	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroBSDRDenom); expectedTax.GT(taxCap) {
 		expectedTax = taxCap
 	}
 
 	// set tax amount
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax)))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().Error(err, "Decorator should errored on low fee for local gasPrice + tax")
 
 	// must pass with tax
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax.Add(expectedTax))))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax.Add(expectedTax))))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
@@ -234,7 +234,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesInstantiateContract() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -242,8 +242,8 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesInstantiateContract() {
 
 	// msg and signatures
 	sendAmount := int64(1000000)
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
-	msg := wasmtypes.NewMsgInstantiateContract(addr1, addr1, 0, []byte{}, sendCoins)
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, sendAmount))
+	msg := wasmtypes.NewMsgInstantiateContract(addr1, addr1, 0, []byte{}, sendCoins, "")
 
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
@@ -267,12 +267,12 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesInstantiateContract() {
 
 	tk := suite.app.TreasuryKeeper
 	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
-	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroBSDRDenom); expectedTax.GT(taxCap) {
 		expectedTax = taxCap
 	}
 
 	// set tax amount
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax)))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
@@ -286,7 +286,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExecuteContract() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -294,7 +294,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExecuteContract() {
 
 	// msg and signatures
 	sendAmount := int64(1000000)
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, sendAmount))
 	msg := wasmtypes.NewMsgExecuteContract(addr1, addr1, []byte{}, sendCoins)
 
 	feeAmount := testdata.NewTestFeeAmount()
@@ -319,12 +319,12 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExecuteContract() {
 
 	tk := suite.app.TreasuryKeeper
 	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
-	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroBSDRDenom); expectedTax.GT(taxCap) {
 		expectedTax = taxCap
 	}
 
 	// set tax amount
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax)))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
@@ -338,7 +338,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExec() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -346,7 +346,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExec() {
 
 	// msg and signatures
 	sendAmount := int64(1000000)
-	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, sendAmount))
 	msg := authz.NewMsgExec(addr1, []sdk.Msg{banktypes.NewMsgSend(addr1, addr1, sendCoins)})
 
 	feeAmount := testdata.NewTestFeeAmount()
@@ -371,12 +371,12 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExec() {
 
 	tk := suite.app.TreasuryKeeper
 	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
-	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroBSDRDenom); expectedTax.GT(taxCap) {
 		expectedTax = taxCap
 	}
 
 	// set tax amount
-	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroBSDRDenom, expectedTax)))
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
@@ -384,3 +384,49 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExec() {
 	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
+
+func (suite *AnteTestSuite) TestEnsureMempoolFeesOracleDenom() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.BankKeeper, suite.app.MarketKeeper, suite.app.TreasuryKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures; fee paid in ubsdr
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 1000))
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// min gas price in the canonical denom; 1000ubiq required
+	biqPrice := sdk.NewDecCoinFromDec(core.MicroBiqDenom, sdk.NewDecWithPrec(1, 2))
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.DecCoins{biqPrice}).WithIsCheckTx(true)
+
+	// no oracle rate for the fee denom
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on fee denom without oracle rate")
+
+	// 1000ubsdr is worth 500ubiq
+	suite.app.OracleKeeper.SetBiqExchangeRate(suite.ctx, core.MicroBSDRDenom, sdk.NewDec(2))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on too low converted fee")
+
+	// 1000ubsdr is worth 2000ubiq
+	suite.app.OracleKeeper.SetBiqExchangeRate(suite.ctx, core.MicroBSDRDenom, sdk.NewDecWithPrec(5, 1))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on converted fee higher than local gasPrice")
+
+	// min gas price without the canonical denom cannot be converted
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2))})
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
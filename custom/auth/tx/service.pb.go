@@ -152,33 +152,33 @@ func init() {
 }
 
 var fileDescriptor_0b3c73e5d85273f4 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x8f, 0x94, 0x40,
-	0x18, 0xc6, 0x19, 0x4c, 0x3c, 0x9d, 0xb3, 0x50, 0xa2, 0x09, 0x47, 0x74, 0x6e, 0x83, 0x16, 0x78,
-	0xc9, 0xcd, 0x78, 0x6b, 0x67, 0x27, 0xd7, 0x58, 0xe3, 0x35, 0xda, 0x6c, 0x06, 0x9c, 0xb0, 0xa8,
-	0xcc, 0x8b, 0xcc, 0xcb, 0x66, 0xb6, 0x53, 0x7b, 0x13, 0x13, 0xbf, 0x85, 0x9f, 0xc2, 0x72, 0xcb,
-	0x4d, 0x6c, 0xac, 0xd4, 0x2c, 0x7e, 0x10, 0xb3, 0x80, 0x2b, 0x71, 0x13, 0x2b, 0x98, 0xfc, 0xde,
-	0x3f, 0xcf, 0xf3, 0xbc, 0x94, 0xa1, 0xaa, 0x6b, 0x29, 0xd0, 0x8a, 0xc5, 0x59, 0xaa, 0x50, 0x9e,
-	0x09, 0xa3, 0xea, 0x45, 0x91, 0x29, 0x5e, 0xd5, 0x80, 0xe0, 0x5d, 0xef, 0x38, 0x47, 0xcb, 0x07,
-	0x1e, 0xdc, 0xcc, 0x21, 0x87, 0x0e, 0x8a, 0xed, 0x5f, 0x5f, 0x17, 0xdc, 0xce, 0x01, 0xf2, 0xd7,
-	0x4a, 0xc8, 0xaa, 0x10, 0x52, 0x6b, 0x40, 0x89, 0x05, 0x68, 0x33, 0x50, 0x96, 0x81, 0x29, 0xc1,
-	0x88, 0x54, 0x1a, 0xb5, 0x5b, 0x94, 0x41, 0xa1, 0x07, 0x1e, 0x0c, 0x7c, 0x24, 0x03, 0x6d, 0xcf,
-	0xc2, 0x67, 0xf4, 0xc6, 0x39, 0x94, 0x55, 0x83, 0xea, 0x42, 0xda, 0x44, 0xbd, 0x69, 0x94, 0x41,
-	0xef, 0x3e, 0x75, 0xd1, 0xfa, 0x64, 0x42, 0xa2, 0xc3, 0xe9, 0x2d, 0xde, 0x77, 0x8f, 0x44, 0xf2,
-	0x0b, 0x1b, 0xbb, 0x3e, 0x49, 0x5c, 0xb4, 0xde, 0x11, 0xbd, 0x82, 0x76, 0x96, 0x2e, 0x51, 0x19,
-	0xdf, 0x9d, 0x90, 0xe8, 0x5a, 0x72, 0x80, 0x36, 0xde, 0x3e, 0xc3, 0xb7, 0x84, 0x7a, 0xe3, 0xd9,
-	0xa6, 0x02, 0x6d, 0x94, 0xf7, 0x92, 0x52, 0x94, 0x76, 0x26, 0x4b, 0x68, 0x34, 0xfa, 0x64, 0x72,
-	0x29, 0x3a, 0x9c, 0x1e, 0xfd, 0x59, 0xb2, 0xb5, 0xb0, 0x5b, 0x73, 0x0e, 0x85, 0x8e, 0x1f, 0xac,
-	0xbe, 0x1f, 0x3b, 0x9f, 0x7f, 0x1c, 0x47, 0x79, 0x81, 0xf3, 0x26, 0xe5, 0x19, 0x94, 0x62, 0xf0,
-	0xd3, 0x7f, 0x4e, 0xcd, 0x8b, 0x57, 0x02, 0x97, 0x95, 0x32, 0x5d, 0x83, 0x49, 0xae, 0xa2, 0xb4,
-	0x8f, 0xbb, 0xe9, 0xd3, 0x0f, 0x84, 0x1e, 0x3c, 0xed, 0x13, 0xf7, 0xde, 0x11, 0x4a, 0xff, 0xca,
-	0xf1, 0xee, 0xf2, 0x7f, 0xb3, 0xe7, 0x7b, 0x41, 0x04, 0xf7, 0xfe, 0x5f, 0xd4, 0x3b, 0x0a, 0xa3,
-	0xf7, 0x5f, 0x7f, 0x7d, 0x72, 0xc3, 0xf0, 0x8e, 0xd8, 0x3b, 0x77, 0xd6, 0x57, 0xcf, 0x50, 0xda,
-	0x47, 0xe4, 0x24, 0x7e, 0xb2, 0xda, 0x30, 0xb2, 0xde, 0x30, 0xf2, 0x73, 0xc3, 0xc8, 0xc7, 0x96,
-	0x39, 0x5f, 0x5a, 0x46, 0xd6, 0x2d, 0x73, 0xbe, 0xb5, 0xcc, 0x79, 0x7e, 0x32, 0xb2, 0xd8, 0x4d,
-	0x3a, 0x2d, 0x41, 0xab, 0xa5, 0xc8, 0xa0, 0x56, 0x22, 0x6b, 0x0c, 0x42, 0x29, 0x64, 0x83, 0x73,
-	0x81, 0x36, 0xbd, 0xdc, 0x9d, 0xef, 0xe1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xeb, 0xe4,
-	0x8f, 0x62, 0x02, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbd, 0x6e, 0xd4, 0x40,
+	0x14, 0x85, 0x3d, 0x46, 0x22, 0x30, 0x81, 0x02, 0x0b, 0x24, 0xc7, 0xa0, 0x59, 0x63, 0x09, 0xc9,
+	0x41, 0xca, 0x0c, 0x59, 0x3a, 0x3a, 0x9c, 0x82, 0xde, 0xa4, 0x81, 0x66, 0x35, 0x36, 0x23, 0x67,
+	0x00, 0xfb, 0xda, 0x3b, 0xd7, 0x61, 0xd2, 0x21, 0x78, 0x01, 0x24, 0xde, 0x82, 0xa7, 0xa0, 0x4c,
+	0x19, 0x89, 0x86, 0x0a, 0xd0, 0x9a, 0x07, 0x41, 0x6b, 0x9b, 0xc5, 0x2b, 0xa4, 0x54, 0xf6, 0xe8,
+	0xbb, 0x3f, 0xe7, 0x9c, 0x4b, 0xef, 0xea, 0x46, 0xa0, 0x15, 0xa7, 0x87, 0x99, 0x42, 0x79, 0x28,
+	0x8c, 0x5a, 0x9e, 0xea, 0x5c, 0xf1, 0x7a, 0x09, 0x08, 0xde, 0x4d, 0xdd, 0x70, 0xb4, 0x7c, 0x84,
+	0xc1, 0xed, 0x02, 0x0a, 0xe8, 0x89, 0x58, 0xff, 0x0d, 0x45, 0xc1, 0xbd, 0x02, 0xa0, 0x78, 0xab,
+	0x84, 0xac, 0xb5, 0x90, 0x55, 0x05, 0x28, 0x51, 0x43, 0x65, 0x46, 0xca, 0x72, 0x30, 0x25, 0x18,
+	0x91, 0x49, 0xa3, 0x36, 0x5b, 0x72, 0xd0, 0xd5, 0xc8, 0x83, 0x91, 0x4f, 0x34, 0xa0, 0x1d, 0x58,
+	0xf4, 0x82, 0xde, 0x3a, 0x82, 0xb2, 0x6e, 0x51, 0x1d, 0x4b, 0x9b, 0xaa, 0xa6, 0x55, 0x06, 0xbd,
+	0x7d, 0xea, 0xa2, 0xf5, 0x49, 0x48, 0xe2, 0xdd, 0xf9, 0x1d, 0x3e, 0x74, 0x4f, 0x44, 0xf2, 0x63,
+	0x9b, 0xb8, 0x3e, 0x49, 0x5d, 0xb4, 0xde, 0x1e, 0xbd, 0x86, 0x76, 0x91, 0x9d, 0xa1, 0x32, 0xbe,
+	0x1b, 0x92, 0xf8, 0x46, 0xba, 0x83, 0x36, 0x59, 0x3f, 0xa3, 0xf7, 0x84, 0x7a, 0xd3, 0xd9, 0xa6,
+	0x86, 0xca, 0x28, 0xef, 0x35, 0xa5, 0x28, 0xed, 0x42, 0x96, 0xd0, 0x56, 0xe8, 0x93, 0xf0, 0x4a,
+	0xbc, 0x3b, 0xdf, 0xfb, 0xbb, 0x64, 0x6d, 0x61, 0xb3, 0xe6, 0x08, 0x74, 0x95, 0x3c, 0x3a, 0xff,
+	0x31, 0x73, 0xbe, 0xfc, 0x9c, 0xc5, 0x85, 0xc6, 0x93, 0x36, 0xe3, 0x39, 0x94, 0x62, 0xf4, 0x33,
+	0x7c, 0x0e, 0xcc, 0xab, 0x37, 0x02, 0xcf, 0x6a, 0x65, 0xfa, 0x06, 0x93, 0x5e, 0x47, 0x69, 0x9f,
+	0xf6, 0xd3, 0xe7, 0x1f, 0x09, 0xdd, 0x79, 0x3e, 0xc4, 0xed, 0x59, 0x4a, 0xff, 0xa9, 0xf1, 0x42,
+	0xbe, 0x95, 0x3b, 0xff, 0x2f, 0x84, 0xe0, 0xfe, 0x25, 0x15, 0x83, 0x95, 0xe8, 0xc1, 0x87, 0x6f,
+	0xbf, 0x3f, 0xbb, 0xb3, 0x28, 0x10, 0xdb, 0x17, 0xce, 0x87, 0xd2, 0x05, 0x4a, 0xfb, 0x84, 0x3c,
+	0x4c, 0x9e, 0x9d, 0xaf, 0x18, 0xb9, 0x58, 0x31, 0xf2, 0x6b, 0xc5, 0xc8, 0xa7, 0x8e, 0x39, 0x5f,
+	0x3b, 0x46, 0x2e, 0x3a, 0xe6, 0x7c, 0xef, 0x98, 0xf3, 0x72, 0x7f, 0x62, 0x2c, 0xd3, 0xf8, 0x4e,
+	0x65, 0x46, 0xe8, 0xe6, 0x20, 0x87, 0xa5, 0x12, 0x79, 0x6b, 0x10, 0x4a, 0x21, 0x5b, 0x3c, 0x11,
+	0x68, 0xb3, 0xab, 0xfd, 0xcd, 0x1e, 0xff, 0x19, 0x00, 0x60, 0xf2, 0x38, 0x51, 0x51, 0x02, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
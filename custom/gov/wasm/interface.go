@@ -2,9 +2,15 @@ package wasm
 
 import (
 	"encoding/json"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
@@ -12,6 +18,7 @@ import (
 )
 
 var _ wasm.WasmMsgParserInterface = WasmMsgParser{}
+var _ wasm.WasmQuerierInterface = WasmQuerier{}
 
 // WasmMsgParser - wasm msg parser for staking msgs
 type WasmMsgParser struct{}
@@ -24,6 +31,9 @@ func NewWasmMsgParser() WasmMsgParser {
 // Parse implements wasm staking msg parser
 func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.CosmosMsg) (sdk.Msg, error) {
 	msg := wasmMsg.Gov
+	if msg.Vote == nil {
+		return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of Gov")
+	}
 
 	var option types.VoteOption
 	switch msg.Vote.Vote {
@@ -35,6 +45,8 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.Cosm
 		option = types.OptionNoWithVeto
 	case wasmvmtypes.Abstain:
 		option = types.OptionAbstain
+	default:
+		return nil, sdkerrors.Wrapf(wasm.ErrInvalidMsg, "Unknown vote option %d", msg.Vote.Vote)
 	}
 
 	cosmosMsg := &types.MsgVote{
@@ -46,7 +58,321 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.Cosm
 	return cosmosMsg, cosmosMsg.ValidateBasic()
 }
 
+// TextProposal - text proposal content
+type TextProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// ParamChange - param change of the param change proposal
+type ParamChange struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+}
+
+// ParamChangeProposal - param change proposal content
+type ParamChangeProposal struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Changes     []ParamChange `json:"changes"`
+}
+
+// CommunityPoolSpendProposal - community pool spend proposal content
+type CommunityPoolSpendProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Recipient   string            `json:"recipient"`
+	Amount      wasmvmtypes.Coins `json:"amount"`
+}
+
+// ProposalContent - content of the proposal submitted by the contract; only one of them is set
+type ProposalContent struct {
+	Text               *TextProposal               `json:"text,omitempty"`
+	ParamChange        *ParamChangeProposal        `json:"param_change,omitempty"`
+	CommunityPoolSpend *CommunityPoolSpendProposal `json:"community_pool_spend,omitempty"`
+}
+
+// SubmitProposalMsg - submits the proposal with the contract as the proposer
+type SubmitProposalMsg struct {
+	Content        ProposalContent   `json:"content"`
+	InitialDeposit wasmvmtypes.Coins `json:"initial_deposit"`
+}
+
+// DepositMsg - deposits the amount on the proposal from the contract
+type DepositMsg struct {
+	ProposalID uint64            `json:"proposal_id"`
+	Amount     wasmvmtypes.Coins `json:"amount"`
+}
+
+// WeightedVoteOption - vote option and its weight
+type WeightedVoteOption struct {
+	// one of "yes", "no", "abstain" and "no_with_veto"
+	Option string `json:"option"`
+	// decimal string, eg "0.5"
+	Weight string `json:"weight"`
+}
+
+// VoteWeightedMsg - casts the weighted vote of the contract
+type VoteWeightedMsg struct {
+	ProposalID uint64               `json:"proposal_id"`
+	Options    []WeightedVoteOption `json:"options"`
+}
+
+// CosmosMsg contains the gov msgs not covered by the wasmvm gov msg
+type CosmosMsg struct {
+	SubmitProposal *SubmitProposalMsg `json:"submit_proposal,omitempty"`
+	Deposit        *DepositMsg        `json:"deposit,omitempty"`
+	VoteWeighted   *VoteWeightedMsg   `json:"vote_weighted,omitempty"`
+}
+
 // ParseCustom implements custom parser
-func (WasmMsgParser) ParseCustom(_ sdk.AccAddress, _ json.RawMessage) (sdk.Msg, error) {
+func (WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) (sdk.Msg, error) {
+	var sdkMsg CosmosMsg
+	err := json.Unmarshal(data, &sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse gov custom msg")
+	}
+
+	if sdkMsg.SubmitProposal != nil {
+		content, err := parseProposalContent(sdkMsg.SubmitProposal.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		initialDeposit, err := wasm.ParseToCoins(sdkMsg.SubmitProposal.InitialDeposit)
+		if err != nil {
+			return nil, err
+		}
+
+		cosmosMsg, err := types.NewMsgSubmitProposal(content, initialDeposit, contractAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	} else if sdkMsg.Deposit != nil {
+		amount, err := wasm.ParseToCoins(sdkMsg.Deposit.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		cosmosMsg := types.NewMsgDeposit(contractAddr, sdkMsg.Deposit.ProposalID, amount)
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	} else if sdkMsg.VoteWeighted != nil {
+		options := make(types.WeightedVoteOptions, len(sdkMsg.VoteWeighted.Options))
+		for i, option := range sdkMsg.VoteWeighted.Options {
+			voteOption, ok := voteOptions[option.Option]
+			if !ok {
+				return nil, sdkerrors.Wrapf(wasm.ErrInvalidMsg, "Unknown vote option %s", option.Option)
+			}
+
+			weight, err := sdk.NewDecFromStr(option.Weight)
+			if err != nil {
+				return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, err.Error())
+			}
+
+			options[i] = types.WeightedVoteOption{Option: voteOption, Weight: weight}
+		}
+
+		cosmosMsg := types.NewMsgVoteWeighted(contractAddr, sdkMsg.VoteWeighted.ProposalID, options)
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of Gov")
+}
+
+func parseProposalContent(content ProposalContent) (types.Content, error) {
+	if content.Text != nil {
+		return types.NewTextProposal(content.Text.Title, content.Text.Description), nil
+	} else if content.ParamChange != nil {
+		changes := make([]paramproposal.ParamChange, len(content.ParamChange.Changes))
+		for i, change := range content.ParamChange.Changes {
+			changes[i] = paramproposal.NewParamChange(change.Subspace, change.Key, change.Value)
+		}
+
+		return paramproposal.NewParameterChangeProposal(content.ParamChange.Title, content.ParamChange.Description, changes), nil
+	} else if content.CommunityPoolSpend != nil {
+		recipient, err := sdk.AccAddressFromBech32(content.CommunityPoolSpend.Recipient)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		amount, err := wasm.ParseToCoins(content.CommunityPoolSpend.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		return distrtypes.NewCommunityPoolSpendProposal(
+			content.CommunityPoolSpend.Title, content.CommunityPoolSpend.Description, recipient, amount), nil
+	}
+
+	return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown proposal content")
+}
+
+// the vote options of the weighted votes and the vote query responses
+var (
+	voteOptions = map[string]types.VoteOption{
+		"yes":          types.OptionYes,
+		"no":           types.OptionNo,
+		"abstain":      types.OptionAbstain,
+		"no_with_veto": types.OptionNoWithVeto,
+	}
+	voteOptionNames = map[types.VoteOption]string{
+		types.OptionYes:        "yes",
+		types.OptionNo:         "no",
+		types.OptionAbstain:    "abstain",
+		types.OptionNoWithVeto: "no_with_veto",
+	}
+	proposalStatusNames = map[types.ProposalStatus]string{
+		types.StatusDepositPeriod: "deposit_period",
+		types.StatusVotingPeriod:  "voting_period",
+		types.StatusPassed:        "passed",
+		types.StatusRejected:      "rejected",
+		types.StatusFailed:        "failed",
+	}
+)
+
+// WasmQuerier - gov query interface for wasm contract
+type WasmQuerier struct {
+	keeper keeper.Keeper
+}
+
+// NewWasmQuerier return gov wasm query interface
+func NewWasmQuerier(keeper keeper.Keeper) WasmQuerier {
+	return WasmQuerier{keeper}
+}
+
+// Query - implement query function
+func (WasmQuerier) Query(_ sdk.Context, _ wasmvmtypes.QueryRequest) ([]byte, error) {
 	return nil, nil
 }
+
+// ProposalQueryParams query request params for a proposal
+type ProposalQueryParams struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// VoteQueryParams query request params for the vote of a voter
+type VoteQueryParams struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Voter      string `json:"voter"`
+}
+
+// CosmosQuery contains various gov queries
+type CosmosQuery struct {
+	Proposal *ProposalQueryParams `json:"proposal,omitempty"`
+	Tally    *ProposalQueryParams `json:"tally,omitempty"`
+	Vote     *VoteQueryParams     `json:"vote,omitempty"`
+}
+
+// ProposalQueryResponse - proposal query response for wasm module;
+// the times are uint64 strings of the unix nanoseconds, eg "1640995200000000000",
+// and "0" when not set yet, eg the voting times in the deposit period
+type ProposalQueryResponse struct {
+	ProposalID      uint64            `json:"proposal_id"`
+	Status          string            `json:"status"`
+	SubmitTime      string            `json:"submit_time"`
+	DepositEndTime  string            `json:"deposit_end_time"`
+	TotalDeposit    wasmvmtypes.Coins `json:"total_deposit"`
+	VotingStartTime string            `json:"voting_start_time"`
+	VotingEndTime   string            `json:"voting_end_time"`
+}
+
+// TallyQueryResponse - tally query response for wasm module;
+// the current tally in the voting period, and the final tally after it
+type TallyQueryResponse struct {
+	// uint128 strings of the voting power, eg "1000000"
+	Yes        string `json:"yes"`
+	Abstain    string `json:"abstain"`
+	No         string `json:"no"`
+	NoWithVeto string `json:"no_with_veto"`
+}
+
+// VoteQueryResponse - vote query response for wasm module;
+// the options are empty when the voter has not voted
+type VoteQueryResponse struct {
+	Options []WeightedVoteOption `json:"options"`
+}
+
+// QueryCustom implements custom query interface
+func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+	var query CosmosQuery
+	err := json.Unmarshal(data, &query)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res interface{}
+	if query.Proposal != nil {
+		proposal, found := querier.keeper.GetProposal(ctx, query.Proposal.ProposalID)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", query.Proposal.ProposalID)
+		}
+
+		res = ProposalQueryResponse{
+			ProposalID:      proposal.ProposalId,
+			Status:          proposalStatusNames[proposal.Status],
+			SubmitTime:      formatTime(proposal.SubmitTime),
+			DepositEndTime:  formatTime(proposal.DepositEndTime),
+			TotalDeposit:    wasm.EncodeSdkCoins(proposal.TotalDeposit),
+			VotingStartTime: formatTime(proposal.VotingStartTime),
+			VotingEndTime:   formatTime(proposal.VotingEndTime),
+		}
+	} else if query.Tally != nil {
+		proposal, found := querier.keeper.GetProposal(ctx, query.Tally.ProposalID)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", query.Tally.ProposalID)
+		}
+
+		tally := proposal.FinalTallyResult
+		if proposal.Status == types.StatusVotingPeriod {
+			// the tally deletes the votes, so it runs on a discarded cache
+			cacheCtx, _ := ctx.CacheContext()
+			_, _, tally = querier.keeper.Tally(cacheCtx, proposal)
+		}
+
+		res = TallyQueryResponse{
+			Yes:        tally.Yes.String(),
+			Abstain:    tally.Abstain.String(),
+			No:         tally.No.String(),
+			NoWithVeto: tally.NoWithVeto.String(),
+		}
+	} else if query.Vote != nil {
+		voter, err := sdk.AccAddressFromBech32(query.Vote.Voter)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		options := []WeightedVoteOption{}
+		if vote, found := querier.keeper.GetVote(ctx, query.Vote.ProposalID, voter); found {
+			for _, option := range vote.Options {
+				options = append(options, WeightedVoteOption{
+					Option: voteOptionNames[option.Option],
+					Weight: option.Weight.String(),
+				})
+			}
+		}
+
+		res = VoteQueryResponse{Options: options}
+	} else {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown Gov variant"}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// formatTime returns the unix nanoseconds string of the time, or "0" for the zero time
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
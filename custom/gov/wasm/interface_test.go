@@ -1,16 +1,26 @@
 package wasm
 
 import (
+	"encoding/json"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestEncoding(t *testing.T) {
@@ -92,6 +102,25 @@ func TestEncoding(t *testing.T) {
 				Option:     govtypes.OptionAbstain,
 			},
 		},
+		"unknown vote option": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Gov: &wasmvmtypes.GovMsg{
+					Vote: &wasmvmtypes.VoteMsg{
+						ProposalId: 1,
+						Vote:       10,
+					},
+				},
+			},
+			isError: true,
+		},
+		"empty gov msg": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Gov: &wasmvmtypes.GovMsg{},
+			},
+			isError: true,
+		},
 	}
 
 	parser := NewWasmMsgParser()
@@ -109,3 +138,295 @@ func TestEncoding(t *testing.T) {
 	}
 
 }
+
+func TestParseCustom(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	deposit := wasmvmtypes.Coins{wasmvmtypes.NewCoin(1000, "ubiq")}
+	sdkDeposit := sdk.NewCoins(sdk.NewInt64Coin("ubiq", 1000))
+
+	textProposal, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), sdkDeposit, addrs[0])
+	require.NoError(t, err)
+
+	paramChangeProposal, err := govtypes.NewMsgSubmitProposal(paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange("staking", "MaxValidators", "1"),
+	}), sdkDeposit, addrs[0])
+	require.NoError(t, err)
+
+	communityPoolSpendProposal, err := govtypes.NewMsgSubmitProposal(
+		distrtypes.NewCommunityPoolSpendProposal("title", "description", addrs[1], sdkDeposit), sdkDeposit, addrs[0])
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  CosmosMsg
+		// set if valid
+		output sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"text proposal": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SubmitProposal: &SubmitProposalMsg{
+					Content: ProposalContent{
+						Text: &TextProposal{Title: "title", Description: "description"},
+					},
+					InitialDeposit: deposit,
+				},
+			},
+			output: textProposal,
+		},
+		"param change proposal": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SubmitProposal: &SubmitProposalMsg{
+					Content: ProposalContent{
+						ParamChange: &ParamChangeProposal{
+							Title:       "title",
+							Description: "description",
+							Changes:     []ParamChange{{Subspace: "staking", Key: "MaxValidators", Value: "1"}},
+						},
+					},
+					InitialDeposit: deposit,
+				},
+			},
+			output: paramChangeProposal,
+		},
+		"community pool spend proposal": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SubmitProposal: &SubmitProposalMsg{
+					Content: ProposalContent{
+						CommunityPoolSpend: &CommunityPoolSpendProposal{
+							Title:       "title",
+							Description: "description",
+							Recipient:   addrs[1].String(),
+							Amount:      deposit,
+						},
+					},
+					InitialDeposit: deposit,
+				},
+			},
+			output: communityPoolSpendProposal,
+		},
+		"empty proposal content": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SubmitProposal: &SubmitProposalMsg{InitialDeposit: deposit},
+			},
+			isError: true,
+		},
+		"invalid community pool spend recipient": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SubmitProposal: &SubmitProposalMsg{
+					Content: ProposalContent{
+						CommunityPoolSpend: &CommunityPoolSpendProposal{
+							Title:       "title",
+							Description: "description",
+							Recipient:   "invalid",
+							Amount:      deposit,
+						},
+					},
+				},
+			},
+			isError: true,
+		},
+		"deposit": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				Deposit: &DepositMsg{ProposalID: 1, Amount: deposit},
+			},
+			output: govtypes.NewMsgDeposit(addrs[0], 1, sdkDeposit),
+		},
+		"weighted vote": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				VoteWeighted: &VoteWeightedMsg{
+					ProposalID: 1,
+					Options: []WeightedVoteOption{
+						{Option: "yes", Weight: "0.7"},
+						{Option: "no_with_veto", Weight: "0.3"},
+					},
+				},
+			},
+			output: govtypes.NewMsgVoteWeighted(addrs[0], 1, govtypes.WeightedVoteOptions{
+				{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+				{Option: govtypes.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(3, 1)},
+			}),
+		},
+		"weighted vote with unknown option": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				VoteWeighted: &VoteWeightedMsg{
+					ProposalID: 1,
+					Options:    []WeightedVoteOption{{Option: "maybe", Weight: "1"}},
+				},
+			},
+			isError: true,
+		},
+		"weighted vote with invalid weight": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				VoteWeighted: &VoteWeightedMsg{
+					ProposalID: 1,
+					Options:    []WeightedVoteOption{{Option: "yes", Weight: "one"}},
+				},
+			},
+			isError: true,
+		},
+		"weighted vote with weights not summing to one": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				VoteWeighted: &VoteWeightedMsg{
+					ProposalID: 1,
+					Options:    []WeightedVoteOption{{Option: "yes", Weight: "0.5"}},
+				},
+			},
+			isError: true,
+		},
+		"empty msg": {
+			sender:  addrs[0],
+			input:   CosmosMsg{},
+			isError: true,
+		},
+	}
+
+	parser := NewWasmMsgParser()
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+
+			res, err := parser.ParseCustom(tc.sender, bz)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
+
+func TestQueryCustom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1640995200, 0)})
+	querier := NewWasmQuerier(app.GovKeeper)
+
+	query := func(q CosmosQuery, res interface{}) error {
+		bz, err := json.Marshal(q)
+		require.NoError(t, err)
+
+		bz, err = querier.QueryCustom(ctx, bz)
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(bz, res)
+	}
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+
+	var proposalRes ProposalQueryResponse
+	require.NoError(t, query(CosmosQuery{Proposal: &ProposalQueryParams{ProposalID: proposal.ProposalId}}, &proposalRes))
+	require.Equal(t, ProposalQueryResponse{
+		ProposalID:      proposal.ProposalId,
+		Status:          "deposit_period",
+		SubmitTime:      "1640995200000000000",
+		DepositEndTime:  strconv.FormatInt(proposal.DepositEndTime.UnixNano(), 10),
+		VotingStartTime: "0",
+		VotingEndTime:   "0",
+	}, proposalRes)
+
+	// a bonded validator votes with all of its tokens
+	validator := createValidator(t, ctx, app, 10)
+	voter := sdk.AccAddress(validator.GetOperator())
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, voter, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(4, 1)},
+	}))
+
+	require.NoError(t, query(CosmosQuery{Proposal: &ProposalQueryParams{ProposalID: proposal.ProposalId}}, &proposalRes))
+	require.Equal(t, "voting_period", proposalRes.Status)
+	require.Equal(t, "1640995200000000000", proposalRes.VotingStartTime)
+
+	tokens := validator.GetBondedTokens().ToDec()
+	var tallyRes TallyQueryResponse
+	require.NoError(t, query(CosmosQuery{Tally: &ProposalQueryParams{ProposalID: proposal.ProposalId}}, &tallyRes))
+	require.Equal(t, TallyQueryResponse{
+		Yes:        tokens.Mul(sdk.NewDecWithPrec(6, 1)).TruncateInt().String(),
+		Abstain:    tokens.Mul(sdk.NewDecWithPrec(4, 1)).TruncateInt().String(),
+		No:         "0",
+		NoWithVeto: "0",
+	}, tallyRes)
+
+	// the tally query must not delete the votes
+	_, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, voter)
+	require.True(t, found)
+
+	var voteRes VoteQueryResponse
+	require.NoError(t, query(CosmosQuery{Vote: &VoteQueryParams{ProposalID: proposal.ProposalId, Voter: voter.String()}}, &voteRes))
+	require.Equal(t, VoteQueryResponse{Options: []WeightedVoteOption{
+		{Option: "yes", Weight: "0.600000000000000000"},
+		{Option: "abstain", Weight: "0.400000000000000000"},
+	}}, voteRes)
+
+	nonVoter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, query(CosmosQuery{Vote: &VoteQueryParams{ProposalID: proposal.ProposalId, Voter: nonVoter.String()}}, &voteRes))
+	require.Equal(t, VoteQueryResponse{Options: []WeightedVoteOption{}}, voteRes)
+
+	// the final tally is returned after the voting period
+	proposal, _ = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	proposal.Status = govtypes.StatusPassed
+	proposal.FinalTallyResult = govtypes.NewTallyResult(sdk.NewInt(3), sdk.NewInt(2), sdk.NewInt(1), sdk.ZeroInt())
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, query(CosmosQuery{Tally: &ProposalQueryParams{ProposalID: proposal.ProposalId}}, &tallyRes))
+	require.Equal(t, TallyQueryResponse{Yes: "3", Abstain: "2", No: "1", NoWithVeto: "0"}, tallyRes)
+
+	require.Error(t, query(CosmosQuery{Proposal: &ProposalQueryParams{ProposalID: 100}}, &proposalRes))
+	require.Error(t, query(CosmosQuery{Tally: &ProposalQueryParams{ProposalID: 100}}, &tallyRes))
+	require.Error(t, query(CosmosQuery{Vote: &VoteQueryParams{ProposalID: proposal.ProposalId, Voter: "invalid"}}, &voteRes))
+	require.Error(t, query(CosmosQuery{}, &voteRes))
+}
+
+func createValidator(t *testing.T, ctx sdk.Context, app *simapp.SimApp, power int64) stakingtypes.Validator {
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))[0]
+	pk := simapp.CreateTestPubKeys(1)[0]
+
+	// the staking keeper without the hooks of the simapp, which need the distribution state of the validator
+	stakingKeeper := stakingkeeper.NewKeeper(
+		simapp.MakeTestEncodingConfig().Marshaler,
+		app.GetKey(stakingtypes.StoreKey),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.GetSubspace(stakingtypes.ModuleName),
+	)
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(addr), pk, stakingtypes.Description{})
+	require.NoError(t, err)
+
+	stakingKeeper.SetValidator(ctx, validator)
+	require.NoError(t, stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	stakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+
+	_, err = stakingKeeper.Delegate(ctx, addr, stakingKeeper.TokensFromConsensusPower(ctx, power), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	validator, found := stakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+
+	return validator
+}
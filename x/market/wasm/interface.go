@@ -2,6 +2,7 @@ package wasm
 
 import (
 	"encoding/json"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -69,9 +70,11 @@ func (WasmQuerier) Query(_ sdk.Context, _ wasmvmtypes.QueryRequest) ([]byte, err
 	return nil, nil
 }
 
-// CosmosQuery only contains swap simulation
+// CosmosQuery contains swap simulation and market state queries
 type CosmosQuery struct {
-	Swap *types.QuerySwapParams `json:"swap,omitempty"`
+	Swap        *types.QuerySwapParams `json:"swap,omitempty"`
+	IqPoolDelta *struct{}              `json:"iq_pool_delta,omitempty"`
+	Params      *struct{}              `json:"params,omitempty"`
 }
 
 // SwapQueryResponse - swap simulation query response for wasm module
@@ -79,6 +82,20 @@ type SwapQueryResponse struct {
 	Receive wasmvmtypes.Coin `json:"receive"`
 }
 
+// IqPoolDeltaQueryResponse - iq pool delta query response for wasm module
+type IqPoolDeltaQueryResponse struct {
+	// decimal string, eg "-1000.5"
+	IqPoolDelta string `json:"iq_pool_delta"`
+}
+
+// ParamsQueryResponse - market params query response for wasm module;
+// the decimals and the uint64 numbers are encoded as strings
+type ParamsQueryResponse struct {
+	BasePool           string `json:"base_pool"`
+	PoolRecoveryPeriod string `json:"pool_recovery_period"`
+	MinStabilitySpread string `json:"min_stability_spread"`
+}
+
 // QueryCustom implements custom query interface
 func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 	var params CosmosQuery
@@ -103,6 +120,28 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
 
+		return bz, err
+	} else if params.IqPoolDelta != nil {
+		delta := querier.keeper.GetIqPoolDelta(ctx)
+
+		bz, err := json.Marshal(IqPoolDeltaQueryResponse{IqPoolDelta: delta.String()})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, err
+	} else if params.Params != nil {
+		marketParams := querier.keeper.GetParams(ctx)
+
+		bz, err := json.Marshal(ParamsQueryResponse{
+			BasePool:           marketParams.BasePool.String(),
+			PoolRecoveryPeriod: strconv.FormatUint(marketParams.PoolRecoveryPeriod, 10),
+			MinStabilitySpread: marketParams.MinStabilitySpread.String(),
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
 		return bz, err
 	}
 
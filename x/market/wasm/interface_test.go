@@ -173,3 +173,47 @@ func TestQuerySwap(t *testing.T) {
 	require.True(t, sdk.NewInt(17).GTE(swapAmount))
 	require.True(t, swapAmount.IsPositive())
 }
+
+func TestQueryIqPoolDelta(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	delta := sdk.NewDecWithPrec(-12345, 1)
+	input.MarketKeeper.SetIqPoolDelta(input.Ctx, delta)
+
+	querier := NewWasmQuerier(input.MarketKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		IqPoolDelta: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var deltaResponse IqPoolDeltaQueryResponse
+	require.NoError(t, json.Unmarshal(res, &deltaResponse))
+	require.Equal(t, delta.String(), deltaResponse.IqPoolDelta)
+}
+
+func TestQueryMarketParams(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	params := input.MarketKeeper.GetParams(input.Ctx)
+
+	querier := NewWasmQuerier(input.MarketKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		Params: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var paramsResponse ParamsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &paramsResponse))
+	require.Equal(t, ParamsQueryResponse{
+		BasePool:           params.BasePool.String(),
+		PoolRecoveryPeriod: fmt.Sprintf("%d", params.PoolRecoveryPeriod),
+		MinStabilitySpread: params.MinStabilitySpread.String(),
+	}, paramsResponse)
+}
@@ -7,4 +7,7 @@ import "github.com/bitwebs/iq-core/x/oracle/types"
 type (
 	MsgAggregateExchangeRatePrevote = types.MsgAggregateExchangeRatePrevote
 	MsgAggregateExchangeRateVote    = types.MsgAggregateExchangeRateVote
+	MsgDelegateFeedConsent          = types.MsgDelegateFeedConsent
+	AggregateExchangeRatePrevote    = types.AggregateExchangeRatePrevote
+	AggregateExchangeRateVote       = types.AggregateExchangeRateVote
 )
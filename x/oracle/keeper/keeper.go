@@ -348,7 +348,8 @@ func (k Keeper) ClearTobinTaxes(ctx sdk.Context) {
 	}
 }
 
-// ValidateFeeder return the given feeder is allowed to feed the message or not
+// ValidateFeeder return the given feeder is allowed to feed the message or not;
+// the feeder is the validator operator or its delegated feeder, which can be a contract
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
 	if !feederAddr.Equals(validatorAddr) {
 		delegate := k.GetFeederDelegation(ctx, validatorAddr)
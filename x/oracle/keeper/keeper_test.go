@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
 
 	core "github.com/bitwebs/iq-core/types"
 	"github.com/bitwebs/iq-core/x/oracle/types"
@@ -375,6 +376,12 @@ func TestValidateFeeder(t *testing.T) {
 	require.NoError(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(addr1), sdk.ValAddress(addr)))
 	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(Addrs[2]), sdk.ValAddress(addr)))
 
+	// delegate to a contract works
+	contractAddr := sdk.AccAddress(tmcrypto.AddressHash([]byte("feeder contract")))
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, sdk.ValAddress(addr1), contractAddr)
+	require.NoError(t, input.OracleKeeper.ValidateFeeder(input.Ctx, contractAddr, sdk.ValAddress(addr1)))
+	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, contractAddr, sdk.ValAddress(addr)))
+
 	// only active validators can do oracle votes
 	validator, found := input.StakingKeeper.GetValidator(input.Ctx, sdk.ValAddress(addr))
 	require.True(t, found)
@@ -2,6 +2,7 @@ package wasm
 
 import (
 	"encoding/json"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,10 +10,55 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
 	"github.com/bitwebs/iq-core/x/oracle/keeper"
+	"github.com/bitwebs/iq-core/x/oracle/types"
 	wasm "github.com/bitwebs/iq-core/x/wasm/exported"
 )
 
 var _ wasm.WasmQuerierInterface = WasmQuerier{}
+var _ wasm.WasmMsgParserInterface = WasmMsgParser{}
+
+// WasmMsgParser - wasm msg parser for oracle msgs
+type WasmMsgParser struct{}
+
+// NewWasmMsgParser returns oracle wasm msg parser
+func NewWasmMsgParser() WasmMsgParser {
+	return WasmMsgParser{}
+}
+
+// Parse implements wasm oracle msg parser
+func (WasmMsgParser) Parse(_ sdk.AccAddress, _ wasmvmtypes.CosmosMsg) (sdk.Msg, error) {
+	return nil, nil
+}
+
+// CosmosMsg contains the oracle feeder msgs; the contract is the feeder of the votes,
+// and the operator of the validator delegating its feed consent
+type CosmosMsg struct {
+	AggregateExchangeRatePrevote *types.MsgAggregateExchangeRatePrevote `json:"aggregate_exchange_rate_prevote,omitempty"`
+	AggregateExchangeRateVote    *types.MsgAggregateExchangeRateVote    `json:"aggregate_exchange_rate_vote,omitempty"`
+	DelegateFeedConsent          *types.MsgDelegateFeedConsent          `json:"delegate_feed_consent,omitempty"`
+}
+
+// ParseCustom implements custom parser
+func (WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) (sdk.Msg, error) {
+	var sdkMsg CosmosMsg
+	err := json.Unmarshal(data, &sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse oracle custom msg")
+	}
+
+	if sdkMsg.AggregateExchangeRatePrevote != nil {
+		sdkMsg.AggregateExchangeRatePrevote.Feeder = contractAddr.String()
+		return sdkMsg.AggregateExchangeRatePrevote, sdkMsg.AggregateExchangeRatePrevote.ValidateBasic()
+	} else if sdkMsg.AggregateExchangeRateVote != nil {
+		sdkMsg.AggregateExchangeRateVote.Feeder = contractAddr.String()
+		return sdkMsg.AggregateExchangeRateVote, sdkMsg.AggregateExchangeRateVote.ValidateBasic()
+	} else if sdkMsg.DelegateFeedConsent != nil {
+		sdkMsg.DelegateFeedConsent.Operator = sdk.ValAddress(contractAddr).String()
+		return sdkMsg.DelegateFeedConsent, sdkMsg.DelegateFeedConsent.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of Oracle")
+}
 
 // WasmQuerier - staking query interface for wasm contract
 type WasmQuerier struct {
@@ -35,9 +81,18 @@ type ExchangeRateQueryParams struct {
 	QuoteDenoms []string `json:"quote_denoms"`
 }
 
+// TobinTaxQueryParams query request params for tobin tax
+type TobinTaxQueryParams struct {
+	Denom string `json:"denom"`
+}
+
 // CosmosQuery custom query interface for oracle querier
 type CosmosQuery struct {
 	ExchangeRates *ExchangeRateQueryParams `json:"exchange_rates,omitempty"`
+	TobinTax      *TobinTaxQueryParams     `json:"tobin_tax,omitempty"`
+	TobinTaxes    *struct{}                `json:"tobin_taxes,omitempty"`
+	VoteTargets   *struct{}                `json:"vote_targets,omitempty"`
+	Params        *struct{}                `json:"params,omitempty"`
 }
 
 // ExchangeRatesQueryResponseItem - exchange rates query response item
@@ -52,6 +107,41 @@ type ExchangeRatesQueryResponse struct {
 	BaseDenom     string             `json:"base_denom"`
 }
 
+// TobinTaxQueryResponse - tobin tax query response for wasm module
+type TobinTaxQueryResponse struct {
+	// decimal string, eg "0.0025"
+	Rate string `json:"rate"`
+}
+
+// TobinTaxItem - tobin taxes query response item
+type TobinTaxItem struct {
+	Denom    string `json:"denom"`
+	TobinTax string `json:"tobin_tax"`
+}
+
+// TobinTaxesQueryResponse - tobin taxes query response for wasm module
+type TobinTaxesQueryResponse struct {
+	TobinTaxes []TobinTaxItem `json:"tobin_taxes"`
+}
+
+// VoteTargetsQueryResponse - vote targets query response for wasm module
+type VoteTargetsQueryResponse struct {
+	VoteTargets []string `json:"vote_targets"`
+}
+
+// ParamsQueryResponse - oracle params query response for wasm module;
+// the decimals and the uint64 numbers are encoded as strings
+type ParamsQueryResponse struct {
+	VotePeriod               string         `json:"vote_period"`
+	VoteThreshold            string         `json:"vote_threshold"`
+	RewardBand               string         `json:"reward_band"`
+	RewardDistributionWindow string         `json:"reward_distribution_window"`
+	Whitelist                []TobinTaxItem `json:"whitelist"`
+	SlashFraction            string         `json:"slash_fraction"`
+	SlashWindow              string         `json:"slash_window"`
+	MinValidPerWindow        string         `json:"min_valid_per_window"`
+}
+
 // QueryCustom implements custom query interface
 func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 	var params CosmosQuery
@@ -61,6 +151,7 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
+	var res interface{}
 	if params.ExchangeRates != nil {
 		// LUNA / BASE_DENOM
 		baseDenomExchangeRate, err := querier.keeper.GetBiqExchangeRate(ctx, params.ExchangeRates.BaseDenom)
@@ -83,17 +174,55 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 			})
 		}
 
-		bz, err := json.Marshal(ExchangeRatesQueryResponse{
+		res = ExchangeRatesQueryResponse{
 			BaseDenom:     params.ExchangeRates.BaseDenom,
 			ExchangeRates: items,
+		}
+	} else if params.TobinTax != nil {
+		tobinTax, err := querier.keeper.GetTobinTax(ctx, params.TobinTax.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		res = TobinTaxQueryResponse{Rate: tobinTax.String()}
+	} else if params.TobinTaxes != nil {
+		items := []TobinTaxItem{}
+		querier.keeper.IterateTobinTaxes(ctx, func(denom string, tobinTax sdk.Dec) (stop bool) {
+			items = append(items, TobinTaxItem{Denom: denom, TobinTax: tobinTax.String()})
+			return false
 		})
 
-		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		res = TobinTaxesQueryResponse{TobinTaxes: items}
+	} else if params.VoteTargets != nil {
+		// the empty list is encoded as [] rather than null
+		voteTargets := append([]string{}, querier.keeper.GetVoteTargets(ctx)...)
+		res = VoteTargetsQueryResponse{VoteTargets: voteTargets}
+	} else if params.Params != nil {
+		oracleParams := querier.keeper.GetParams(ctx)
+
+		whitelist := make([]TobinTaxItem, len(oracleParams.Whitelist))
+		for i, denom := range oracleParams.Whitelist {
+			whitelist[i] = TobinTaxItem{Denom: denom.Name, TobinTax: denom.TobinTax.String()}
 		}
 
-		return bz, nil
+		res = ParamsQueryResponse{
+			VotePeriod:               strconv.FormatUint(oracleParams.VotePeriod, 10),
+			VoteThreshold:            oracleParams.VoteThreshold.String(),
+			RewardBand:               oracleParams.RewardBand.String(),
+			RewardDistributionWindow: strconv.FormatUint(oracleParams.RewardDistributionWindow, 10),
+			Whitelist:                whitelist,
+			SlashFraction:            oracleParams.SlashFraction.String(),
+			SlashWindow:              strconv.FormatUint(oracleParams.SlashWindow, 10),
+			MinValidPerWindow:        oracleParams.MinValidPerWindow.String(),
+		}
+	} else {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown Oracle variant"}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
 
-	return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown Oracle variant"}
+	return bz, nil
 }
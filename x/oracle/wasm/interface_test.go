@@ -2,14 +2,17 @@ package wasm_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	core "github.com/bitwebs/iq-core/types"
 	"github.com/bitwebs/iq-core/x/oracle/keeper"
+	"github.com/bitwebs/iq-core/x/oracle/types"
 	"github.com/bitwebs/iq-core/x/oracle/wasm"
 )
 
@@ -97,3 +100,144 @@ func TestQueryExchangeRates(t *testing.T) {
 		},
 	})
 }
+
+func TestQueryTobinTaxes(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+
+	querier := wasm.NewWasmQuerier(input.OracleKeeper)
+
+	// not existing tobin tax
+	bz, err := json.Marshal(wasm.CosmosQuery{
+		TobinTax: &wasm.TobinTaxQueryParams{Denom: core.MicroBKRWDenom},
+	})
+	require.NoError(t, err)
+
+	_, err = querier.QueryCustom(input.Ctx, bz)
+	require.Error(t, err)
+
+	// empty vote targets are encoded as []
+	bz, err = json.Marshal(wasm.CosmosQuery{
+		VoteTargets: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+	require.Equal(t, `{"vote_targets":[]}`, string(res))
+
+	KRWTobinTax := sdk.NewDecWithPrec(2, 3)
+	SDRTobinTax := sdk.NewDecWithPrec(25, 4)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroBKRWDenom, KRWTobinTax)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroBSDRDenom, SDRTobinTax)
+
+	// tobin tax query
+	bz, err = json.Marshal(wasm.CosmosQuery{
+		TobinTax: &wasm.TobinTaxQueryParams{Denom: core.MicroBKRWDenom},
+	})
+	require.NoError(t, err)
+
+	res, err = querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var tobinTaxResponse wasm.TobinTaxQueryResponse
+	require.NoError(t, json.Unmarshal(res, &tobinTaxResponse))
+	require.Equal(t, KRWTobinTax.String(), tobinTaxResponse.Rate)
+
+	// tobin taxes query
+	bz, err = json.Marshal(wasm.CosmosQuery{
+		TobinTaxes: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err = querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var tobinTaxesResponse wasm.TobinTaxesQueryResponse
+	require.NoError(t, json.Unmarshal(res, &tobinTaxesResponse))
+	require.ElementsMatch(t, []wasm.TobinTaxItem{
+		{Denom: core.MicroBKRWDenom, TobinTax: KRWTobinTax.String()},
+		{Denom: core.MicroBSDRDenom, TobinTax: SDRTobinTax.String()},
+	}, tobinTaxesResponse.TobinTaxes)
+
+	// vote targets query
+	bz, err = json.Marshal(wasm.CosmosQuery{
+		VoteTargets: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err = querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var voteTargetsResponse wasm.VoteTargetsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &voteTargetsResponse))
+	require.ElementsMatch(t, []string{core.MicroBKRWDenom, core.MicroBSDRDenom}, voteTargetsResponse.VoteTargets)
+}
+
+func TestQueryOracleParams(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+
+	querier := wasm.NewWasmQuerier(input.OracleKeeper)
+
+	bz, err := json.Marshal(wasm.CosmosQuery{
+		Params: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var paramsResponse wasm.ParamsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &paramsResponse))
+	require.Equal(t, fmt.Sprintf("%d", params.VotePeriod), paramsResponse.VotePeriod)
+	require.Equal(t, params.VoteThreshold.String(), paramsResponse.VoteThreshold)
+	require.Equal(t, params.RewardBand.String(), paramsResponse.RewardBand)
+	require.Equal(t, fmt.Sprintf("%d", params.SlashWindow), paramsResponse.SlashWindow)
+	require.Equal(t, len(params.Whitelist), len(paramsResponse.Whitelist))
+	for i, denom := range params.Whitelist {
+		require.Equal(t, wasm.TobinTaxItem{Denom: denom.Name, TobinTax: denom.TobinTax.String()}, paramsResponse.Whitelist[i])
+	}
+}
+
+func TestParseCustom(t *testing.T) {
+	_, _, contractAddr := testdata.KeyTestPubAddr()
+	_, _, operator := testdata.KeyTestPubAddr()
+	_, _, delegate := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(operator)
+	hash := types.GetAggregateVoteHash("salt", "1.0ubsdr", valAddr)
+
+	parser := wasm.NewWasmMsgParser()
+
+	// prevote with the contract feeder
+	msg, err := parser.ParseCustom(contractAddr, []byte(fmt.Sprintf(
+		`{"aggregate_exchange_rate_prevote":{"hash":"%s","validator":"%s"}}`, hash, valAddr)))
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgAggregateExchangeRatePrevote(hash, contractAddr, valAddr), msg)
+
+	// the feeder is always the contract
+	msg, err = parser.ParseCustom(contractAddr, []byte(fmt.Sprintf(
+		`{"aggregate_exchange_rate_vote":{"salt":"salt","exchange_rates":"1.0ubsdr","feeder":"%s","validator":"%s"}}`, delegate, valAddr)))
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgAggregateExchangeRateVote("salt", "1.0ubsdr", contractAddr, valAddr), msg)
+	require.Equal(t, []sdk.AccAddress{contractAddr}, msg.GetSigners())
+
+	// the contract is the operator delegating its feed consent
+	msg, err = parser.ParseCustom(contractAddr, []byte(fmt.Sprintf(
+		`{"delegate_feed_consent":{"delegate":"%s"}}`, delegate)))
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgDelegateFeedConsent(sdk.ValAddress(contractAddr), delegate), msg)
+	require.Equal(t, []sdk.AccAddress{contractAddr}, msg.GetSigners())
+
+	// invalid msgs
+	_, err = parser.ParseCustom(contractAddr, []byte(fmt.Sprintf(
+		`{"aggregate_exchange_rate_prevote":{"hash":"invalid","validator":"%s"}}`, valAddr)))
+	require.Error(t, err)
+
+	_, err = parser.ParseCustom(contractAddr, []byte(fmt.Sprintf(
+		`{"aggregate_exchange_rate_vote":{"salt":"salt","exchange_rates":"","validator":"%s"}}`, valAddr)))
+	require.Error(t, err)
+
+	_, err = parser.ParseCustom(contractAddr, []byte(`{"unknown":{}}`))
+	require.Error(t, err)
+}
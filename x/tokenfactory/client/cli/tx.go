@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	tokenfactoryTxCmd := &cobra.Command{
+		Use:                        "tokenfactory",
+		Short:                      "Tokenfactory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryTxCmd.AddCommand(
+		GetCreateDenomCmd(),
+		GetMintCmd(),
+		GetBurnCmd(),
+		GetChangeAdminCmd(),
+		GetSetDenomMetadataCmd(),
+	)
+
+	return tokenfactoryTxCmd
+}
+
+// GetCreateDenomCmd will create and send a MsgCreateDenom
+func GetCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a new denom namespaced by the sender",
+		Long: strings.TrimSpace(`
+Create the denom factory/{sender}/{subdenom} with the sender as its admin. The denom creation fee is paid to the community pool.
+
+$ iqd tx tokenfactory create-denom mytoken
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[0])
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMintCmd will create and send a MsgMint
+func GetMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount] [recipient]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Mint coins of a denom administered by the sender",
+		Long: strings.TrimSpace(`
+Mint coins of a factory denom administered by the sender. A default recipient is the sender.
+
+$ iqd tx tokenfactory mint 1000factory/iq1.../mytoken
+
+$ iqd tx tokenfactory mint 1000factory/iq1.../mytoken iq1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var recipient sdk.AccAddress
+			if len(args) == 2 {
+				recipient, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), amount, recipient)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetBurnCmd will create and send a MsgBurn
+func GetBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Burn coins of a denom administered by the sender",
+		Long: strings.TrimSpace(`
+Burn coins of a factory denom administered by the sender from the sender balance.
+
+$ iqd tx tokenfactory burn 1000factory/iq1.../mytoken
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), amount)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetChangeAdminCmd will create and send a MsgChangeAdmin
+func GetChangeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Change the admin of a denom administered by the sender",
+		Long: strings.TrimSpace(`
+Hand the authority of a factory denom over to the new admin. Omitting the new admin renounces the authority for good.
+
+$ iqd tx tokenfactory change-admin factory/iq1.../mytoken iq1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var newAdmin sdk.AccAddress
+			if len(args) == 2 {
+				newAdmin, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[0], newAdmin)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSetDenomMetadataCmd will create and send a MsgSetDenomMetadata
+func GetSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the bank metadata of a denom administered by the sender",
+		Long: strings.TrimSpace(`
+Set the bank metadata of a factory denom administered by the sender from a JSON file.
+
+$ iqd tx tokenfactory set-denom-metadata metadata.json
+
+Where metadata.json contains:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/iq1.../mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/iq1.../mytoken",
+  "display": "mytoken",
+  "name": "My Token",
+  "symbol": "MTK"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
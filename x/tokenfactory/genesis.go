@@ -0,0 +1,47 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/keeper"
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+// InitGenesis initialize default parameters
+// and the factory denoms with their authority
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, factoryDenom := range data.FactoryDenoms {
+		if err := keeper.ImportDenom(ctx, factoryDenom.Denom, factoryDenom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+
+	// check if the module account exists
+	moduleAcc := keeper.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisState) {
+	params := keeper.GetParams(ctx)
+
+	factoryDenoms := []types.GenesisDenom{}
+	keeper.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) (stop bool) {
+		factoryDenoms = append(factoryDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+
+		return false
+	})
+
+	return types.NewGenesisState(params, factoryDenoms)
+}
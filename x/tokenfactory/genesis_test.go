@@ -0,0 +1,44 @@
+package tokenfactory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/keeper"
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+func TestExportInitGenesis(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	denom1, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, keeper.Addrs[0], "bitcoin")
+	require.NoError(t, err)
+	denom2, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, keeper.Addrs[1], "atom")
+	require.NoError(t, err)
+	require.NoError(t, input.TokenFactoryKeeper.ChangeAdmin(input.Ctx, keeper.Addrs[1], denom2, ""))
+
+	params := input.TokenFactoryKeeper.GetParams(input.Ctx)
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin("ubiq", 123))
+	input.TokenFactoryKeeper.SetParams(input.Ctx, params)
+
+	genesis := ExportGenesis(input.Ctx, input.TokenFactoryKeeper)
+	require.NoError(t, types.ValidateGenesis(genesis))
+	require.Len(t, genesis.FactoryDenoms, 2)
+
+	newInput := keeper.CreateTestInput(t)
+	InitGenesis(newInput.Ctx, newInput.TokenFactoryKeeper, genesis)
+	newGenesis := ExportGenesis(newInput.Ctx, newInput.TokenFactoryKeeper)
+
+	require.Equal(t, genesis, newGenesis)
+
+	// the creator index is rebuilt
+	require.Equal(t, []string{denom1}, newInput.TokenFactoryKeeper.GetDenomsFromCreator(newInput.Ctx, keeper.Addrs[0]))
+	require.Equal(t, []string{denom2}, newInput.TokenFactoryKeeper.GetDenomsFromCreator(newInput.Ctx, keeper.Addrs[1]))
+
+	// the imported denoms cannot be created again
+	_, err = newInput.TokenFactoryKeeper.CreateDenom(newInput.Ctx, keeper.Addrs[0], "bitcoin")
+	require.ErrorIs(t, err, types.ErrDenomExists)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+// CreateDenom charges the denom creation fee to the creator and registers the
+// factory denom factory/{creator}/{subdenom} with the creator as its admin
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := k.GetAuthorityMetadata(ctx, denom); err == nil {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if _, found := k.BankKeeper.GetDenomMetaData(ctx, denom); found {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if k.BankKeeper.GetSupply(ctx, denom).IsPositive() {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if fee := k.DenomCreationFee(ctx); !fee.IsZero() {
+		if err := k.DistributionKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	k.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	})
+
+	k.createDenomAfterValidation(ctx, creator, denom, types.DenomAuthorityMetadata{Admin: creator.String()})
+	return denom, nil
+}
+
+// createDenomAfterValidation stores the authority metadata and the creator index of the denom
+func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creator sdk.AccAddress, denom string, metadata types.DenomAuthorityMetadata) {
+	k.SetAuthorityMetadata(ctx, denom, metadata)
+	k.addDenomFromCreator(ctx, creator, denom)
+}
+
+// assertAdmin returns an error unless the sender is the admin of the factory denom
+func (k Keeper) assertAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+
+	return nil
+}
+
+// Mint mints the factory coin to the recipient on behalf of the denom admin
+func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress) error {
+	if err := k.assertAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+// Burn burns the factory coin from the balance of the denom admin
+func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.BankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChangeAdmin hands the authority of the factory denom over to the new admin;
+// an empty new admin renounces the authority for good
+func (k Keeper) ChangeAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string, newAdmin string) error {
+	if err := k.assertAdmin(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	metadata := types.DenomAuthorityMetadata{Admin: newAdmin}
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, metadata)
+	return nil
+}
+
+// SetDenomMetadata replaces the bank metadata of the factory denom on behalf of the denom admin
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, sender sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	if err := k.assertAdmin(ctx, sender, metadata.Base); err != nil {
+		return err
+	}
+
+	k.BankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// ImportDenom registers a factory denom from the genesis; the bank metadata and
+// the supply are imported by the bank module and the creation fee is not charged
+func (k Keeper) ImportDenom(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	k.createDenomAfterValidation(ctx, creator, denom, metadata)
+	return nil
+}
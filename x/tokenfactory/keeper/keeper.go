@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramstypes.Subspace
+
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	DistributionKeeper types.DistributionKeeper
+}
+
+// NewKeeper constructs a new keeper for tokenfactory
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramstore paramstypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+) Keeper {
+
+	// ensure tokenfactory module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		paramSpace:         paramstore,
+		AccountKeeper:      accountKeeper,
+		BankKeeper:         bankKeeper,
+		DistributionKeeper: distributionKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthorityMetadata returns the authority metadata of the factory denom
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, sdkerrors.Wrap(types.ErrDenomNotFound, denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, nil
+}
+
+// SetAuthorityMetadata stores the authority metadata of the factory denom
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// IterateAuthorityMetadata iterates over the factory denoms with their authority metadata
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, handler func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomAuthorityMetadataKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomAuthorityMetadataKey):])
+
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iter.Value(), &metadata)
+		if handler(denom, metadata) {
+			break
+		}
+	}
+}
+
+// addDenomFromCreator indexes the factory denom under its creator
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCreatorDenomKey(creator, denom), []byte{})
+}
+
+// GetDenomsFromCreator returns the factory denoms created by the creator
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCreatorDenomsPrefix(creator))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	denoms := []string{}
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()))
+	}
+
+	return denoms
+}
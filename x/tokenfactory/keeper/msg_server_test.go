@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+func TestMsgCreateDenom(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	msgServer := NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	fee := input.TokenFactoryKeeper.DenomCreationFee(input.Ctx)
+	require.False(t, fee.IsZero())
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[0], "bitcoin"))
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+Addrs[0].String()+"/bitcoin", res.NewTokenDenom)
+
+	// the creation fee is paid to the community pool
+	require.Equal(t, InitCoins.Sub(fee), input.BankKeeper.GetAllBalances(input.Ctx, Addrs[0]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fee...), input.DistrKeeper.GetFeePoolCommunityCoins(input.Ctx))
+
+	metadata, err := input.TokenFactoryKeeper.GetAuthorityMetadata(input.Ctx, res.NewTokenDenom)
+	require.NoError(t, err)
+	require.Equal(t, Addrs[0].String(), metadata.Admin)
+
+	bankMetadata, found := input.BankKeeper.GetDenomMetaData(input.Ctx, res.NewTokenDenom)
+	require.True(t, found)
+	require.NoError(t, bankMetadata.Validate())
+
+	require.Equal(t, []string{res.NewTokenDenom}, input.TokenFactoryKeeper.GetDenomsFromCreator(input.Ctx, Addrs[0]))
+	require.Empty(t, input.TokenFactoryKeeper.GetDenomsFromCreator(input.Ctx, Addrs[1]))
+
+	// the same subdenom of the same creator cannot be created twice
+	_, err = msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[0], "bitcoin"))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// the denoms are namespaced by the creator
+	res, err = msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[1], "bitcoin"))
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+Addrs[1].String()+"/bitcoin", res.NewTokenDenom)
+
+	// the creator has to pay the fee
+	params := input.TokenFactoryKeeper.GetParams(input.Ctx)
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewCoin(core.MicroBiqDenom, InitTokens.MulRaw(2)))
+	input.TokenFactoryKeeper.SetParams(input.Ctx, params)
+
+	_, err = msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[2], "bitcoin"))
+	require.Error(t, err)
+}
+
+func TestMsgMintAndBurn(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	msgServer := NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[0], "bitcoin"))
+	require.NoError(t, err)
+	denom := res.NewTokenDenom
+
+	// mint to the admin itself
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[0], sdk.NewInt64Coin(denom, 100), nil))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(input.Ctx, Addrs[0], denom).Amount)
+
+	// mint to a recipient
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[0], sdk.NewInt64Coin(denom, 50), Addrs[1]))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(input.Ctx, Addrs[1], denom).Amount)
+	require.Equal(t, sdk.NewInt(150), input.BankKeeper.GetSupply(input.Ctx, denom).Amount)
+
+	// only the admin can mint and burn
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[1], sdk.NewInt64Coin(denom, 50), nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(Addrs[1], sdk.NewInt64Coin(denom, 50)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// unknown denoms cannot be minted
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[0], sdk.NewInt64Coin(core.MicroBiqDenom, 50), nil))
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+
+	// burn from the admin balance
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(Addrs[0], sdk.NewInt64Coin(denom, 60)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(40), input.BankKeeper.GetBalance(input.Ctx, Addrs[0], denom).Amount)
+	require.Equal(t, sdk.NewInt(90), input.BankKeeper.GetSupply(input.Ctx, denom).Amount)
+
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(Addrs[0], sdk.NewInt64Coin(denom, 60)))
+	require.Error(t, err)
+}
+
+func TestMsgChangeAdmin(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	msgServer := NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[0], "bitcoin"))
+	require.NoError(t, err)
+	denom := res.NewTokenDenom
+
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(Addrs[1], denom, Addrs[1]))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(Addrs[0], denom, Addrs[1]))
+	require.NoError(t, err)
+
+	metadata, err := input.TokenFactoryKeeper.GetAuthorityMetadata(input.Ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, Addrs[1].String(), metadata.Admin)
+
+	// the former admin lost the authority
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[0], sdk.NewInt64Coin(denom, 100), nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[1], sdk.NewInt64Coin(denom, 100), nil))
+	require.NoError(t, err)
+
+	// the denom stays indexed under its creator
+	require.Equal(t, []string{denom}, input.TokenFactoryKeeper.GetDenomsFromCreator(input.Ctx, Addrs[0]))
+
+	// renounce the authority for good
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(Addrs[1], denom, nil))
+	require.NoError(t, err)
+
+	metadata, err = input.TokenFactoryKeeper.GetAuthorityMetadata(input.Ctx, denom)
+	require.NoError(t, err)
+	require.Empty(t, metadata.Admin)
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(Addrs[1], sdk.NewInt64Coin(denom, 100), nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(Addrs[1], denom, Addrs[1]))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestMsgSetDenomMetadata(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	msgServer := NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(Addrs[0], "bitcoin"))
+	require.NoError(t, err)
+	denom := res.NewTokenDenom
+
+	metadata := banktypes.Metadata{
+		Description: "Bitcoin on IQ",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "bitcoin", Exponent: 8}},
+		Base:        denom,
+		Display:     "bitcoin",
+		Name:        "Bitcoin",
+		Symbol:      "BTC",
+	}
+
+	_, err = msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(Addrs[1], metadata))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(Addrs[0], metadata))
+	require.NoError(t, err)
+
+	bankMetadata, found := input.BankKeeper.GetDenomMetaData(input.Ctx, denom)
+	require.True(t, found)
+	require.Equal(t, metadata, bankMetadata)
+
+	// the metadata of the other denoms cannot be set
+	metadata.Base = core.MicroBiqDenom
+	metadata.DenomUnits[0].Denom = core.MicroBiqDenom
+	_, err = msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(Addrs[0], metadata))
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+}
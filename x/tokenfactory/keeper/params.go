@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+// DenomCreationFee is the fee paid to the community pool on the creation of a denom
+func (k Keeper) DenomCreationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyDenomCreationFee, &res)
+	return
+}
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of tokenfactory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
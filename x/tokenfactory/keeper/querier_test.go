@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+)
+
+func TestQueryParams(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	querier := NewQuerier(input.TokenFactoryKeeper)
+	res, err := querier.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, input.TokenFactoryKeeper.GetParams(input.Ctx), res.Params)
+}
+
+func TestQueryDenomAuthorityMetadata(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	denom, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, Addrs[0], "bitcoin")
+	require.NoError(t, err)
+
+	querier := NewQuerier(input.TokenFactoryKeeper)
+	_, err = querier.DenomAuthorityMetadata(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "ubiq"})
+	require.Error(t, err)
+
+	res, err := querier.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, Addrs[0].String(), res.AuthorityMetadata.Admin)
+}
+
+func TestQueryDenomsFromCreator(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	denom1, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, Addrs[0], "bitcoin")
+	require.NoError(t, err)
+	denom2, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, Addrs[0], "atom")
+	require.NoError(t, err)
+
+	querier := NewQuerier(input.TokenFactoryKeeper)
+	_, err = querier.DenomsFromCreator(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	require.Error(t, err)
+
+	res, err := querier.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: Addrs[0].String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{denom1, denom2}, res.Denoms)
+
+	res, err = querier.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: Addrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Denoms)
+}
@@ -0,0 +1,217 @@
+package keeper
+
+//nolint
+//DONTCOVER
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	customauth "github.com/bitwebs/iq-core/custom/auth"
+	custombank "github.com/bitwebs/iq-core/custom/bank"
+	customdistr "github.com/bitwebs/iq-core/custom/distribution"
+	customparams "github.com/bitwebs/iq-core/custom/params"
+	customstaking "github.com/bitwebs/iq-core/custom/staking"
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const faucetAccountName = "faucet"
+
+// ModuleBasics nolint
+var ModuleBasics = module.NewBasicManager(
+	customauth.AppModuleBasic{},
+	custombank.AppModuleBasic{},
+	customstaking.AppModuleBasic{},
+	customdistr.AppModuleBasic{},
+	customparams.AppModuleBasic{},
+)
+
+// MakeTestCodec nolint
+func MakeTestCodec(t *testing.T) codec.Codec {
+	return MakeEncodingConfig(t).Marshaler
+}
+
+// MakeEncodingConfig nolint
+func MakeEncodingConfig(_ *testing.T) simparams.EncodingConfig {
+	amino := codec.NewLegacyAmino()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	txCfg := tx.NewTxConfig(marshaler, tx.DefaultSignModes)
+
+	std.RegisterInterfaces(interfaceRegistry)
+	std.RegisterLegacyAminoCodec(amino)
+
+	ModuleBasics.RegisterLegacyAminoCodec(amino)
+	ModuleBasics.RegisterInterfaces(interfaceRegistry)
+	types.RegisterLegacyAminoCodec(amino)
+	types.RegisterInterfaces(interfaceRegistry)
+
+	return simparams.EncodingConfig{
+		InterfaceRegistry: interfaceRegistry,
+		Marshaler:         marshaler,
+		TxConfig:          txCfg,
+		Amino:             amino,
+	}
+}
+
+// Test Account
+var (
+	PubKeys = []crypto.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+
+	Addrs = []sdk.AccAddress{
+		sdk.AccAddress(PubKeys[0].Address()),
+		sdk.AccAddress(PubKeys[1].Address()),
+		sdk.AccAddress(PubKeys[2].Address()),
+	}
+
+	InitTokens = sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	InitCoins  = sdk.NewCoins(sdk.NewCoin(core.MicroBiqDenom, InitTokens))
+)
+
+// TestInput nolint
+type TestInput struct {
+	Ctx                sdk.Context
+	Cdc                *codec.LegacyAmino
+	AccountKeeper      authkeeper.AccountKeeper
+	BankKeeper         bankkeeper.Keeper
+	DistrKeeper        distrkeeper.Keeper
+	TokenFactoryKeeper Keeper
+}
+
+// CreateTestInput nolint
+func CreateTestInput(t *testing.T) TestInput {
+	keyAcc := sdk.NewKVStoreKey(authtypes.StoreKey)
+	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
+	keyTokenFactory := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Now().UTC()}, false, log.NewNopLogger())
+	encodingConfig := MakeEncodingConfig(t)
+	appCodec, legacyAmino := encodingConfig.Marshaler, encodingConfig.Amino
+
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTokenFactory, sdk.StoreTypeIAVL, db)
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	blackListAddrs := map[string]bool{
+		faucetAccountName:              true,
+		authtypes.FeeCollectorName:     true,
+		stakingtypes.NotBondedPoolName: true,
+		stakingtypes.BondedPoolName:    true,
+		distrtypes.ModuleName:          true,
+	}
+
+	maccPerms := map[string][]string{
+		faucetAccountName:              {authtypes.Minter},
+		authtypes.FeeCollectorName:     nil,
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		distrtypes.ModuleName:          nil,
+		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+	}
+
+	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams, tKeyParams)
+	accountKeeper := authkeeper.NewAccountKeeper(appCodec, keyAcc, paramsKeeper.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms)
+	bankKeeper := bankkeeper.NewBaseKeeper(appCodec, keyBank, accountKeeper, paramsKeeper.Subspace(banktypes.ModuleName), blackListAddrs)
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
+
+	stakingKeeper := stakingkeeper.NewKeeper(
+		appCodec,
+		keyStaking,
+		accountKeeper,
+		bankKeeper,
+		paramsKeeper.Subspace(stakingtypes.ModuleName),
+	)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = core.MicroBiqDenom
+	stakingKeeper.SetParams(ctx, stakingParams)
+
+	distrKeeper := distrkeeper.NewKeeper(
+		appCodec,
+		keyDistr, paramsKeeper.Subspace(distrtypes.ModuleName),
+		accountKeeper, bankKeeper, &stakingKeeper,
+		authtypes.FeeCollectorName, blackListAddrs)
+
+	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, distrtypes.DefaultParams())
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	distrAcc := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
+	tokenFactoryAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner)
+
+	accountKeeper.SetModuleAccount(ctx, feeCollectorAcc)
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+	accountKeeper.SetModuleAccount(ctx, tokenFactoryAcc)
+
+	for _, addr := range Addrs {
+		accountKeeper.SetAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+		err := FundAccount(bankKeeper, ctx, addr, InitCoins)
+		require.NoError(t, err)
+	}
+
+	keeper := NewKeeper(
+		appCodec,
+		keyTokenFactory, paramsKeeper.Subspace(types.ModuleName),
+		accountKeeper,
+		bankKeeper,
+		distrKeeper,
+	)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, distrKeeper, keeper}
+}
+
+// FundAccount is a utility function that funds an account by minting and
+// sending the coins to the address. This should be used for testing purposes
+// only!
+func FundAccount(bankKeeper bankkeeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := bankKeeper.MintCoins(ctx, faucetAccountName, amounts); err != nil {
+		return err
+	}
+
+	return bankKeeper.SendCoinsFromModuleToAccount(ctx, faucetAccountName, addr, amounts)
+}
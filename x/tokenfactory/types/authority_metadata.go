@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks the admin, which is empty once the authority is renounced
+func (metadata DenomAuthorityMetadata) Validate() error {
+	if metadata.Admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(metadata.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of the factory denoms
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom chosen by the creator
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the factory denom factory/{creator}/{subdenom}
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom is longer than %d", MaxSubdenomLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s contains /", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator address and the subdenom of the factory denom
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	// the subdenom may contain slashes
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address (%s)", err)
+	}
+
+	if parts[2] == "" || len(parts[2]) > MaxSubdenomLength {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom must be between 1 and %d characters", MaxSubdenomLength)
+	}
+
+	return creator, parts[2], nil
+}
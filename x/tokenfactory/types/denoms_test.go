@@ -0,0 +1,65 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("addr1_______________"))
+
+	denom, err := GetTokenDenom(creator.String(), "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator.String()+"/bitcoin", denom)
+
+	denomCreator, subdenom, err := DeconstructDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, denomCreator)
+	require.Equal(t, "bitcoin", subdenom)
+
+	// the subdenom may contain slashes
+	denom, err = GetTokenDenom(creator.String(), "bit/coin")
+	require.NoError(t, err)
+	_, subdenom, err = DeconstructDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, "bit/coin", subdenom)
+
+	_, err = GetTokenDenom(creator.String(), "")
+	require.Error(t, err)
+	_, err = GetTokenDenom(creator.String(), strings.Repeat("a", MaxSubdenomLength+1))
+	require.Error(t, err)
+	_, err = GetTokenDenom(creator.String(), "bit coin")
+	require.Error(t, err)
+	_, err = GetTokenDenom("iq/1", "bitcoin")
+	require.Error(t, err)
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("addr1_______________"))
+
+	tests := []struct {
+		denom string
+		valid bool
+	}{
+		{"factory/" + creator.String() + "/bitcoin", true},
+		{"ubiq", false},
+		{"factory/" + creator.String(), false},
+		{"factory/" + creator.String() + "/", false},
+		{"factory/invalidaddr/bitcoin", false},
+		{"fabric/" + creator.String() + "/bitcoin", false},
+		{"factory/" + creator.String() + "/" + strings.Repeat("a", MaxSubdenomLength+1), false},
+	}
+
+	for _, tc := range tests {
+		_, _, err := DeconstructDenom(tc.denom)
+		if tc.valid {
+			require.NoError(t, err, tc.denom)
+		} else {
+			require.Error(t, err, tc.denom)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Tokenfactory errors
+var (
+	ErrInvalidDenom  = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists   = sdkerrors.Register(ModuleName, 3, "denom already exists")
+	ErrDenomNotFound = sdkerrors.Register(ModuleName, 4, "denom not found")
+	ErrUnauthorized  = sdkerrors.Register(ModuleName, 5, "unauthorized account")
+)
@@ -0,0 +1,20 @@
+package types
+
+// Tokenfactory module event types
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyBurnFrom      = "burn_from"
+	AttributeKeyNewAdmin      = "new_admin"
+
+	AttributeValueCategory = ModuleName
+)
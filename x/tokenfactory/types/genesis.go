@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, factoryDenoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: factoryDenoms,
+	}
+}
+
+// DefaultGenesisState returns raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:        DefaultParams(),
+		FactoryDenoms: []GenesisDenom{},
+	}
+}
+
+// ValidateGenesis validates the provided tokenfactory genesis state
+func ValidateGenesis(data *GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenDenoms := map[string]bool{}
+	for _, factoryDenom := range data.FactoryDenoms {
+		if seenDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicated factory denom %s", factoryDenom.Denom)
+		}
+
+		seenDenoms[factoryDenom.Denom] = true
+
+		if _, _, err := DeconstructDenom(factoryDenom.Denom); err != nil {
+			return err
+		}
+
+		if err := factoryDenom.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns x/tokenfactory GenesisState given raw application
+// genesis state.
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *GenesisState {
+	var genesisState GenesisState
+
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return &genesisState
+}
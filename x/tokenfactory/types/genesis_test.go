@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisValidation(t *testing.T) {
+	genState := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genState))
+
+	creator := sdk.AccAddress([]byte("addr1_______________"))
+	denom, err := GetTokenDenom(creator.String(), "bitcoin")
+	require.NoError(t, err)
+
+	genState.FactoryDenoms = []GenesisDenom{{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: creator.String()}}}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// the authority may be renounced
+	genState.FactoryDenoms = []GenesisDenom{{Denom: denom}}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.FactoryDenoms = []GenesisDenom{{Denom: denom}, {Denom: denom}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.FactoryDenoms = []GenesisDenom{{Denom: "ubiq"}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.FactoryDenoms = []GenesisDenom{{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: "invalid"}}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
+	genState.Params.DenomCreationFee = sdk.Coins{sdk.Coin{Denom: "ubiq", Amount: sdk.NewInt(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the tokenfactory module
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the tokenfactory module
+	RouterKey = ModuleName
+)
+
+// Keys for tokenfactory store
+// Items are stored with the following key: values
+//
+// - 0x01<denom_Bytes>: DenomAuthorityMetadata
+//
+// - 0x02<creator_Address_len (1 byte)><creator_Address_Bytes><denom_Bytes>: []byte{}
+var (
+	// Keys for store prefixes
+	DenomAuthorityMetadataKey = []byte{0x01} // prefix for the authority of each factory denom
+	CreatorDenomsKey          = []byte{0x02} // prefix for the denoms of each creator
+)
+
+// GetDenomAuthorityMetadataKey - stored by *denom*
+func GetDenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataKey, []byte(denom)...)
+}
+
+// GetCreatorDenomsPrefix - prefix of the denoms created by *creator*
+func GetCreatorDenomsPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomsKey, address.MustLengthPrefix(creator)...)
+}
+
+// GetCreatorDenomKey - stored by *creator* and *denom*
+func GetCreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(GetCreatorDenomsPrefix(creator), []byte(denom)...)
+}
@@ -0,0 +1,264 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+)
+
+// tokenfactory message types
+const (
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "mint"
+	TypeMsgBurn             = "burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+)
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// NewMsgCreateDenom creates a MsgCreateDenom instance
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender.String(),
+		Subdenom: subdenom,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// GetSignBytes Implements Msg
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgCreateDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = GetTokenDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// NewMsgMint creates a MsgMint instance; an empty recipient mints to the sender
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress) *MsgMint {
+	msg := &MsgMint{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+
+	if !recipient.Empty() {
+		msg.Recipient = recipient.String()
+	}
+
+	return msg
+}
+
+// Route Implements Msg
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// GetSignBytes Implements Msg
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Recipient != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+		}
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgBurn creates a MsgBurn instance
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// GetSignBytes Implements Msg
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgBurn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgChangeAdmin creates a MsgChangeAdmin instance; an empty new admin renounces the authority
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	msg := &MsgChangeAdmin{
+		Sender: sender.String(),
+		Denom:  denom,
+	}
+
+	if !newAdmin.Empty() {
+		msg.NewAdmin = newAdmin.String()
+	}
+
+	return msg
+}
+
+// Route Implements Msg
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// GetSignBytes Implements Msg
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.NewAdmin != "" {
+		_, err = sdk.AccAddressFromBech32(msg.NewAdmin)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new admin address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(msg.Denom)
+	return err
+}
+
+// NewMsgSetDenomMetadata creates a MsgSetDenomMetadata instance
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender.String(),
+		Metadata: metadata,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// GetSignBytes Implements Msg
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	_, _, err = DeconstructDenom(msg.Metadata.Base)
+	return err
+}
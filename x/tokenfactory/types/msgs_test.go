@@ -0,0 +1,172 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgCreateDenom(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		subdenom   string
+		expectPass bool
+	}{
+		{addrs[0], "bitcoin", true},
+		{sdk.AccAddress{}, "bitcoin", false},
+		{addrs[0], "", false},
+		{addrs[0], "bit coin", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCreateDenom(tc.sender, tc.subdenom)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgMint(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	denom, err := GetTokenDenom(addrs[0].String(), "bitcoin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		amount     sdk.Coin
+		recipient  sdk.AccAddress
+		expectPass bool
+	}{
+		{addrs[0], sdk.NewInt64Coin(denom, 100), nil, true},
+		{addrs[0], sdk.NewInt64Coin(denom, 100), addrs[1], true},
+		{sdk.AccAddress{}, sdk.NewInt64Coin(denom, 100), addrs[1], false},
+		{addrs[0], sdk.NewInt64Coin(denom, 0), addrs[1], false},
+		{addrs[0], sdk.Coin{Denom: "1bitcoin", Amount: sdk.NewInt(100)}, addrs[1], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgMint(tc.sender, tc.amount, tc.recipient)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	// an empty recipient mints to the sender
+	require.Empty(t, NewMsgMint(addrs[0], sdk.NewInt64Coin(denom, 100), nil).Recipient)
+}
+
+func TestMsgBurn(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	denom, err := GetTokenDenom(addrs[0].String(), "bitcoin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		amount     sdk.Coin
+		expectPass bool
+	}{
+		{addrs[0], sdk.NewInt64Coin(denom, 100), true},
+		{sdk.AccAddress{}, sdk.NewInt64Coin(denom, 100), false},
+		{addrs[0], sdk.NewInt64Coin(denom, 0), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgBurn(tc.sender, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgChangeAdmin(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	denom, err := GetTokenDenom(addrs[0].String(), "bitcoin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		denom      string
+		newAdmin   sdk.AccAddress
+		expectPass bool
+	}{
+		{addrs[0], denom, addrs[1], true},
+		{addrs[0], denom, nil, true},
+		{sdk.AccAddress{}, denom, addrs[1], false},
+		{addrs[0], "ubiq", addrs[1], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgChangeAdmin(tc.sender, tc.denom, tc.newAdmin)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSetDenomMetadata(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	denom, err := GetTokenDenom(addrs[0].String(), "bitcoin")
+	require.NoError(t, err)
+
+	metadata := func(base string) banktypes.Metadata {
+		return banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: base, Exponent: 0}, {Denom: "bitcoin", Exponent: 8}},
+			Base:       base,
+			Display:    "bitcoin",
+			Name:       "Bitcoin",
+			Symbol:     "BTC",
+		}
+	}
+
+	invalidMetadata := metadata(denom)
+	invalidMetadata.Symbol = ""
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		metadata   banktypes.Metadata
+		expectPass bool
+	}{
+		{addrs[0], metadata(denom), true},
+		{sdk.AccAddress{}, metadata(denom), false},
+		{addrs[0], metadata("ubiq"), false},
+		{addrs[0], invalidMetadata, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetDenomMetadata(tc.sender, tc.metadata)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
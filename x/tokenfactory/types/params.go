@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	core "github.com/bitwebs/iq-core/types"
+)
+
+// Parameter keys
+var (
+	KeyDenomCreationFee = []byte("DenomCreationFee")
+)
+
+// Default parameter values
+var (
+	DefaultDenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 10*core.MicroUnit)) // 10biq
+)
+
+var _ paramstypes.ParamSet = &Params{}
+
+// DefaultParams creates default tokenfactory module parameters
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: DefaultDenomCreationFee,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// String implements fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of tokenfactory module's parameters.
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+	}
+}
+
+// Validate a set of params
+func (p Params) Validate() error {
+	return validateDenomCreationFee(p.DenomCreationFee)
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsEqual(t *testing.T) {
+	p1 := DefaultParams()
+	err := p1.Validate()
+	require.NoError(t, err)
+
+	// the creation fee may be free
+	p1.DenomCreationFee = sdk.NewCoins()
+	err = p1.Validate()
+	require.NoError(t, err)
+
+	// unsorted fee
+	p2 := DefaultParams()
+	p2.DenomCreationFee = sdk.Coins{sdk.NewInt64Coin("ubiq", 1), sdk.NewInt64Coin("ubiq", 2)}
+	err = p2.Validate()
+	require.Error(t, err)
+
+	p3 := DefaultParams()
+	require.NotNil(t, p3.ParamSetPairs())
+	require.NotNil(t, p3.String())
+}
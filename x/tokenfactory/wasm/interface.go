@@ -0,0 +1,155 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/keeper"
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+	wasm "github.com/bitwebs/iq-core/x/wasm/exported"
+)
+
+var _ wasm.WasmQuerierInterface = WasmQuerier{}
+var _ wasm.WasmMsgParserInterface = WasmMsgParser{}
+
+// WasmMsgParser - wasm msg parser for tokenfactory msgs
+type WasmMsgParser struct{}
+
+// NewWasmMsgParser returns tokenfactory wasm msg parser
+func NewWasmMsgParser() WasmMsgParser {
+	return WasmMsgParser{}
+}
+
+// Parse implements wasm tokenfactory msg parser
+func (WasmMsgParser) Parse(_ sdk.AccAddress, _ wasmvmtypes.CosmosMsg) (sdk.Msg, error) {
+	return nil, nil
+}
+
+// CosmosMsg contains the tokenfactory msgs sent by the contract as the sender
+type CosmosMsg struct {
+	CreateDenom      *types.MsgCreateDenom      `json:"create_denom,omitempty"`
+	Mint             *types.MsgMint             `json:"mint,omitempty"`
+	Burn             *types.MsgBurn             `json:"burn,omitempty"`
+	ChangeAdmin      *types.MsgChangeAdmin      `json:"change_admin,omitempty"`
+	SetDenomMetadata *types.MsgSetDenomMetadata `json:"set_denom_metadata,omitempty"`
+}
+
+// ParseCustom implements custom parser
+func (WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) (sdk.Msg, error) {
+	var sdkMsg CosmosMsg
+	err := json.Unmarshal(data, &sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse tokenfactory custom msg")
+	}
+
+	if sdkMsg.CreateDenom != nil {
+		sdkMsg.CreateDenom.Sender = contractAddr.String()
+		return sdkMsg.CreateDenom, sdkMsg.CreateDenom.ValidateBasic()
+	} else if sdkMsg.Mint != nil {
+		sdkMsg.Mint.Sender = contractAddr.String()
+		return sdkMsg.Mint, sdkMsg.Mint.ValidateBasic()
+	} else if sdkMsg.Burn != nil {
+		sdkMsg.Burn.Sender = contractAddr.String()
+		return sdkMsg.Burn, sdkMsg.Burn.ValidateBasic()
+	} else if sdkMsg.ChangeAdmin != nil {
+		sdkMsg.ChangeAdmin.Sender = contractAddr.String()
+		return sdkMsg.ChangeAdmin, sdkMsg.ChangeAdmin.ValidateBasic()
+	} else if sdkMsg.SetDenomMetadata != nil {
+		sdkMsg.SetDenomMetadata.Sender = contractAddr.String()
+		return sdkMsg.SetDenomMetadata, sdkMsg.SetDenomMetadata.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of TokenFactory")
+}
+
+// WasmQuerier - tokenfactory query interface for wasm contract
+type WasmQuerier struct {
+	keeper keeper.Keeper
+}
+
+// NewWasmQuerier return tokenfactory wasm query interface
+func NewWasmQuerier(keeper keeper.Keeper) WasmQuerier {
+	return WasmQuerier{keeper}
+}
+
+// Query - implement query function
+func (WasmQuerier) Query(_ sdk.Context, _ wasmvmtypes.QueryRequest) ([]byte, error) {
+	return nil, nil
+}
+
+// CosmosQuery contains the factory denom and tokenfactory state queries
+type CosmosQuery struct {
+	DenomAuthorityMetadata *types.QueryDenomAuthorityMetadataRequest `json:"denom_authority_metadata,omitempty"`
+	DenomsFromCreator      *types.QueryDenomsFromCreatorRequest      `json:"denoms_from_creator,omitempty"`
+	Params                 *struct{}                                 `json:"params,omitempty"`
+}
+
+// DenomAuthorityMetadataQueryResponse - denom authority metadata query response for wasm module;
+// the admin is empty once the authority is renounced
+type DenomAuthorityMetadataQueryResponse struct {
+	Admin string `json:"admin"`
+}
+
+// DenomsFromCreatorQueryResponse - denoms from creator query response for wasm module
+type DenomsFromCreatorQueryResponse struct {
+	Denoms []string `json:"denoms"`
+}
+
+// ParamsQueryResponse - tokenfactory params query response for wasm module
+type ParamsQueryResponse struct {
+	DenomCreationFee wasmvmtypes.Coins `json:"denom_creation_fee"`
+}
+
+// QueryCustom implements custom query interface
+func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+	var params CosmosQuery
+	err := json.Unmarshal(data, &params)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	q := keeper.NewQuerier(querier.keeper)
+	if params.DenomAuthorityMetadata != nil {
+		res, err := q.DenomAuthorityMetadata(sdk.WrapSDKContext(ctx), params.DenomAuthorityMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(DenomAuthorityMetadataQueryResponse{Admin: res.AuthorityMetadata.Admin})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, err
+	} else if params.DenomsFromCreator != nil {
+		res, err := q.DenomsFromCreator(sdk.WrapSDKContext(ctx), params.DenomsFromCreator)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(DenomsFromCreatorQueryResponse{Denoms: res.Denoms})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, err
+	} else if params.Params != nil {
+		tokenfactoryParams := querier.keeper.GetParams(ctx)
+
+		bz, err := json.Marshal(ParamsQueryResponse{
+			DenomCreationFee: wasm.EncodeSdkCoins(tokenfactoryParams.DenomCreationFee),
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, err
+	}
+
+	return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown TokenFactory variant"}
+}
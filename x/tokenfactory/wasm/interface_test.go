@@ -0,0 +1,222 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bitwebs/iq-core/x/tokenfactory/keeper"
+	"github.com/bitwebs/iq-core/x/tokenfactory/types"
+	wasm "github.com/bitwebs/iq-core/x/wasm/exported"
+)
+
+func TestEncoding(t *testing.T) {
+	addrs := keeper.Addrs
+	denom := fmt.Sprintf("factory/%s/bitcoin", addrs[0])
+
+	invalidAddr := "xrnd1d02kd90n38qvr3qb9qof83fn2d2"
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  wasmvmtypes.CosmosMsg
+		// set if valid
+		output sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"create denom": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"create_denom": {"subdenom": "bitcoin"}}`),
+			},
+			output: &types.MsgCreateDenom{
+				Sender:   addrs[0].String(),
+				Subdenom: "bitcoin",
+			},
+		},
+		"mint": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"mint": {"amount": {"amount": "1234", "denom": "%s"}, "recipient": "%s"}}`,
+						denom, addrs[1],
+					),
+				),
+			},
+			output: &types.MsgMint{
+				Sender:    addrs[0].String(),
+				Amount:    sdk.NewInt64Coin(denom, 1234),
+				Recipient: addrs[1].String(),
+			},
+		},
+		"burn": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(fmt.Sprintf(`{"burn": {"amount": {"amount": "1234", "denom": "%s"}}}`, denom)),
+			},
+			output: &types.MsgBurn{
+				Sender: addrs[0].String(),
+				Amount: sdk.NewInt64Coin(denom, 1234),
+			},
+		},
+		"change admin": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(fmt.Sprintf(`{"change_admin": {"denom": "%s", "new_admin": "%s"}}`, denom, addrs[1])),
+			},
+			output: &types.MsgChangeAdmin{
+				Sender:   addrs[0].String(),
+				Denom:    denom,
+				NewAdmin: addrs[1].String(),
+			},
+		},
+		"set denom metadata": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"set_denom_metadata": {"metadata": {"denom_units": [{"denom": "%s", "exponent": 0}, {"denom": "bitcoin", "exponent": 8}], "base": "%s", "display": "bitcoin", "name": "Bitcoin", "symbol": "BTC"}}}`,
+						denom, denom,
+					),
+				),
+			},
+			output: &types.MsgSetDenomMetadata{
+				Sender: addrs[0].String(),
+				Metadata: banktypes.Metadata{
+					DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "bitcoin", Exponent: 8}},
+					Base:       denom,
+					Display:    "bitcoin",
+					Name:       "Bitcoin",
+					Symbol:     "BTC",
+				},
+			},
+		},
+		"invalid subdenom": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"create_denom": {"subdenom": "bit coin"}}`),
+			},
+			isError: true,
+		},
+		"invalid mint amount": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(fmt.Sprintf(`{"mint": {"amount": {"amount": "1234.123", "denom": "%s"}}}`, denom)),
+			},
+			isError: true,
+		},
+		"invalid recipient": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(fmt.Sprintf(`{"mint": {"amount": {"amount": "1234", "denom": "%s"}, "recipient": "%s"}}`, denom, invalidAddr)),
+			},
+			isError: true,
+		},
+		"unknown variant": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"force_transfer": {}}`),
+			},
+			isError: true,
+		},
+	}
+
+	parser := NewWasmMsgParser()
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res, err := parser.ParseCustom(tc.sender, tc.input.Custom)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
+
+func TestQueryDenomAuthorityMetadata(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	denom, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, keeper.Addrs[0], "bitcoin")
+	require.NoError(t, err)
+
+	querier := NewWasmQuerier(input.TokenFactoryKeeper)
+
+	// empty data will occur error
+	_, err = querier.QueryCustom(input.Ctx, []byte{})
+	require.Error(t, err)
+
+	// unknown denom
+	bz, err := json.Marshal(CosmosQuery{
+		DenomAuthorityMetadata: &types.QueryDenomAuthorityMetadataRequest{Denom: "ubiq"},
+	})
+	require.NoError(t, err)
+
+	_, err = querier.QueryCustom(input.Ctx, bz)
+	require.Error(t, err)
+
+	bz, err = json.Marshal(CosmosQuery{
+		DenomAuthorityMetadata: &types.QueryDenomAuthorityMetadataRequest{Denom: denom},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var metadataResponse DenomAuthorityMetadataQueryResponse
+	require.NoError(t, json.Unmarshal(res, &metadataResponse))
+	require.Equal(t, keeper.Addrs[0].String(), metadataResponse.Admin)
+}
+
+func TestQueryDenomsFromCreator(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	denom, err := input.TokenFactoryKeeper.CreateDenom(input.Ctx, keeper.Addrs[0], "bitcoin")
+	require.NoError(t, err)
+
+	querier := NewWasmQuerier(input.TokenFactoryKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		DenomsFromCreator: &types.QueryDenomsFromCreatorRequest{Creator: keeper.Addrs[0].String()},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var denomsResponse DenomsFromCreatorQueryResponse
+	require.NoError(t, json.Unmarshal(res, &denomsResponse))
+	require.Equal(t, []string{denom}, denomsResponse.Denoms)
+}
+
+func TestQueryTokenFactoryParams(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	params := input.TokenFactoryKeeper.GetParams(input.Ctx)
+
+	querier := NewWasmQuerier(input.TokenFactoryKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		Params: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var paramsResponse ParamsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &paramsResponse))
+	require.Equal(t, ParamsQueryResponse{
+		DenomCreationFee: wasm.EncodeSdkCoins(params.DenomCreationFee),
+	}, paramsResponse)
+}
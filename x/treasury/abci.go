@@ -18,6 +18,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Burn all coins from the burn module account
 	k.BurnCoinsFromBurnAccount(ctx)
 
+	// Adjust base gas prices of the next block from the current block gas usage
+	k.UpdateBaseGasPrices(ctx)
+
 	// Check epoch last block
 	if !core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
 		return
@@ -29,6 +32,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Compute & Update internal indicators for the current epoch
 	k.UpdateIndicators(ctx)
 
+	// Refund tiered tax rebates to registered payment processors
+	k.SettlePaymentProcessorRebates(ctx)
+
 	// Check probation period
 	if ctx.BlockHeight() < int64(core.BlocksPerWeek*k.WindowProbation(ctx)) {
 		return
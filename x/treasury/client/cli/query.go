@@ -32,8 +32,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTaxCaps(),
 		GetCmdQueryRewardWeight(),
 		GetCmdQueryTaxProceeds(),
+		GetCmdQueryPaymentProcessor(),
 		GetCmdQuerySeigniorageProceeds(),
 		GetCmdQueryIndicators(),
+		GetCmdQueryGasPrices(),
 		GetCmdQueryParams(),
 	)
 
@@ -201,6 +203,69 @@ $ iqd query treasury tax-proceeds
 	return cmd
 }
 
+// GetCmdQueryPaymentProcessor implements the query payment-processor command.
+func GetCmdQueryPaymentProcessor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-processor [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tax paid by a payment processor for the current epoch",
+		Long: strings.TrimSpace(`
+Query the tax paid by a registered payment processor in the current epoch.
+
+$ iqd query treasury payment-processor iq1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PaymentProcessor(context.Background(), &types.QueryPaymentProcessorRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryGasPrices implements the query gas-prices command.
+func GetCmdQueryGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-prices",
+		Args:  cobra.NoArgs,
+		Short: "Query the on-chain base gas prices",
+		Long: strings.TrimSpace(`
+Query the on-chain base gas prices of the current block. A tx fee must cover
+the base fee (gas * base gas price) in at least one of the denoms on top of the stability tax.
+
+$ iqd query treasury gas-prices
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GasPrices(context.Background(), &types.QueryGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQuerySeigniorageProceeds implements the query seigniorage-proceeds command.
 func GetCmdQuerySeigniorageProceeds() *cobra.Command {
 	cmd := &cobra.Command{
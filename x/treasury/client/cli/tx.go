@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	treasuryTxCmd := &cobra.Command{
+		Use:                        "treasury",
+		Short:                      "Treasury transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	treasuryTxCmd.AddCommand(
+		GetCmdRegisterPaymentProcessor(),
+	)
+
+	return treasuryTxCmd
+}
+
+// GetCmdRegisterPaymentProcessor will create and send a MsgRegisterPaymentProcessor
+func GetCmdRegisterPaymentProcessor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-payment-processor",
+		Args:  cobra.NoArgs,
+		Short: "Register the sender as a payment processor",
+		Long: strings.TrimSpace(`
+Register the sender as a payment processor. The stability tax paid by a registered
+payment processor is tracked per epoch, and a governance-defined tiered portion of it
+is refunded from the treasury at the end of each epoch. The registration fee set by
+governance is paid to the treasury.
+
+$ iqd tx treasury register-payment-processor --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterPaymentProcessor(clientCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,5 +1,6 @@
+// DONTCOVER
+//
 //nolint:deadcode,unused
-//DONTCOVER
 package exported
 
 import (
@@ -37,6 +37,20 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetTSL(ctx, int64(epochState.Epoch), epochState.TotalStakedBiq)
 	}
 
+	for _, processor := range data.PaymentProcessors {
+		addr, err := sdk.AccAddressFromBech32(processor.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetPaymentProcessorTaxProceeds(ctx, addr, processor.TaxProceeds)
+	}
+
+	// If BaseGasPrices is empty, the min base gas prices are used until the first update
+	if !data.BaseGasPrices.Empty() {
+		keeper.SetBaseGasPrices(ctx, data.BaseGasPrices)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetTreasuryModuleAccount(ctx)
 	if moduleAcc == nil {
@@ -60,6 +74,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	rewardWeight := keeper.GetRewardWeight(ctx)
 	taxProceeds := keeper.PeekEpochTaxProceeds(ctx)
 	epochInitialIssuance := keeper.GetEpochInitialIssuance(ctx)
+	baseGasPrices := keeper.GetBaseGasPrices(ctx)
 
 	var taxCaps []types.TaxCap
 	keeper.IterateTaxCap(ctx, func(denom string, taxCap sdk.Int) bool {
@@ -79,10 +94,19 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 			Epoch:             uint64(e),
 			TaxReward:         keeper.GetTR(ctx, e),
 			SeigniorageReward: keeper.GetSR(ctx, e),
-			TotalStakedBiq:   keeper.GetTSL(ctx, e),
+			TotalStakedBiq:    keeper.GetTSL(ctx, e),
 		})
 	}
 
+	var paymentProcessors []types.PaymentProcessor
+	keeper.IteratePaymentProcessors(ctx, func(processor sdk.AccAddress, taxProceeds sdk.Coins) bool {
+		paymentProcessors = append(paymentProcessors, types.PaymentProcessor{
+			Address:     processor.String(),
+			TaxProceeds: taxProceeds,
+		})
+		return false
+	})
+
 	return types.NewGenesisState(params, taxRate, rewardWeight,
-		taxCaps, taxProceeds, epochInitialIssuance, epochStates)
+		taxCaps, taxProceeds, epochInitialIssuance, epochStates, paymentProcessors, baseGasPrices)
 }
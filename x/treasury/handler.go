@@ -0,0 +1,26 @@
+package treasury
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitwebs/iq-core/x/treasury/keeper"
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+// NewHandler creates a new handler for all treasury type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgRegisterPaymentProcessor:
+			res, err := msgServer.RegisterPaymentProcessor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized treasury message type: %T", msg)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+// GetBaseGasPrices returns the on-chain base gas prices of the current block,
+// falling back to the min base gas prices when none have been computed yet
+func (k Keeper) GetBaseGasPrices(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseGasPricesKey)
+	if bz == nil {
+		return k.MinBaseGasPrices(ctx)
+	}
+
+	gasPrices := types.BaseGasPrices{}
+	k.cdc.MustUnmarshal(bz, &gasPrices)
+	return gasPrices.GasPrices
+}
+
+// SetBaseGasPrices stores the on-chain base gas prices
+func (k Keeper) SetBaseGasPrices(ctx sdk.Context, gasPrices sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&types.BaseGasPrices{GasPrices: gasPrices})
+	store.Set(types.BaseGasPricesKey, bz)
+}
+
+// UpdateBaseGasPrices adjusts the base gas prices for the next block from the
+// gas consumed by the current block, EIP-1559 style. Prices move up when the
+// block consumed more than TargetBlockGas and down when it consumed less,
+// by at most BaseGasPriceChangeRateMax, and never drop below MinBaseGasPrices.
+func (k Keeper) UpdateBaseGasPrices(ctx sdk.Context) sdk.DecCoins {
+	minGasPrices := k.MinBaseGasPrices(ctx)
+	if minGasPrices.Empty() || ctx.BlockGasMeter() == nil {
+		k.SetBaseGasPrices(ctx, minGasPrices)
+		return minGasPrices
+	}
+
+	targetGas := sdk.NewDecFromInt(sdk.NewIntFromUint64(k.TargetBlockGas(ctx)))
+	gasUsed := sdk.NewDecFromInt(sdk.NewIntFromUint64(ctx.BlockGasMeter().GasConsumed()))
+
+	// utilization delta is bounded to [-1, 1] so that a single block
+	// cannot move prices by more than the max change rate
+	delta := gasUsed.Sub(targetGas).Quo(targetGas)
+	if delta.GT(sdk.OneDec()) {
+		delta = sdk.OneDec()
+	}
+
+	factor := sdk.OneDec().Add(k.BaseGasPriceChangeRateMax(ctx).Mul(delta))
+	prevGasPrices := k.GetBaseGasPrices(ctx)
+
+	gasPrices := make(sdk.DecCoins, len(minGasPrices))
+	for i, minGasPrice := range minGasPrices {
+		prevGasPrice := prevGasPrices.AmountOf(minGasPrice.Denom)
+		if prevGasPrice.LT(minGasPrice.Amount) {
+			prevGasPrice = minGasPrice.Amount
+		}
+
+		gasPrice := prevGasPrice.Mul(factor)
+		if gasPrice.LT(minGasPrice.Amount) {
+			gasPrice = minGasPrice.Amount
+		}
+
+		gasPrices[i] = sdk.NewDecCoinFromDec(minGasPrice.Denom, gasPrice)
+	}
+
+	k.SetBaseGasPrices(ctx, gasPrices)
+	return gasPrices
+}
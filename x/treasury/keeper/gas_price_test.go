@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/bitwebs/iq-core/types"
+)
+
+func TestBaseGasPricesDefault(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// feature is off by default
+	require.True(t, input.TreasuryKeeper.GetBaseGasPrices(input.Ctx).IsZero())
+	require.True(t, input.TreasuryKeeper.UpdateBaseGasPrices(input.Ctx).IsZero())
+}
+
+func TestUpdateBaseGasPrices(t *testing.T) {
+	input := CreateTestInput(t)
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroBSDRDenom, sdk.NewDecWithPrec(1, 2)))
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.MinBaseGasPrices = minGasPrices
+	params.TargetBlockGas = 1000
+	params.BaseGasPriceChangeRateMax = sdk.NewDecWithPrec(125, 3)
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	// falls back to min prices before the first update
+	require.Equal(t, minGasPrices, input.TreasuryKeeper.GetBaseGasPrices(input.Ctx))
+
+	// full block raises prices by the max change rate
+	gasMeter := sdk.NewGasMeter(10000)
+	gasMeter.ConsumeGas(2000, "test")
+	ctx := input.Ctx.WithBlockGasMeter(gasMeter)
+	input.TreasuryKeeper.UpdateBaseGasPrices(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 5), input.TreasuryKeeper.GetBaseGasPrices(ctx).AmountOf(core.MicroBSDRDenom))
+
+	// block at target keeps prices
+	gasMeter = sdk.NewGasMeter(10000)
+	gasMeter.ConsumeGas(1000, "test")
+	ctx = input.Ctx.WithBlockGasMeter(gasMeter)
+	input.TreasuryKeeper.UpdateBaseGasPrices(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 5), input.TreasuryKeeper.GetBaseGasPrices(ctx).AmountOf(core.MicroBSDRDenom))
+
+	// half-full block lowers prices
+	gasMeter = sdk.NewGasMeter(10000)
+	gasMeter.ConsumeGas(500, "test")
+	ctx = input.Ctx.WithBlockGasMeter(gasMeter)
+	input.TreasuryKeeper.UpdateBaseGasPrices(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 5).Mul(sdk.NewDecWithPrec(9375, 4)), input.TreasuryKeeper.GetBaseGasPrices(ctx).AmountOf(core.MicroBSDRDenom))
+
+	// empty blocks never drop prices below min
+	for i := 0; i < 10; i++ {
+		ctx = input.Ctx.WithBlockGasMeter(sdk.NewGasMeter(10000))
+		input.TreasuryKeeper.UpdateBaseGasPrices(ctx)
+	}
+	require.Equal(t, minGasPrices, input.TreasuryKeeper.GetBaseGasPrices(ctx))
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, setting the default payment processor
+// rebate tiers, the registration fee and base gas price params
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyPaymentProcessorRebateTiers, types.DefaultPaymentProcessorRebateTiers)
+	m.keeper.paramSpace.Set(ctx, types.KeyPaymentProcessorRegistrationFee, types.DefaultPaymentProcessorRegistrationFee)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinBaseGasPrices, types.DefaultMinBaseGasPrices)
+	m.keeper.paramSpace.Set(ctx, types.KeyTargetBlockGas, types.DefaultTargetBlockGas)
+	m.keeper.paramSpace.Set(ctx, types.KeyBaseGasPriceChangeRateMax, types.DefaultBaseGasPriceChangeRateMax)
+	return nil
+}
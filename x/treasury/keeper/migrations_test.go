@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.TreasuryKeeper
+
+	params := keeper.GetParams(ctx)
+	params.PaymentProcessorRebateTiers = types.RebateTiers{{Threshold: sdk.NewInt(1), Rate: sdk.NewDecWithPrec(1, 1)}}
+	params.PaymentProcessorRegistrationFee = sdk.Coins{}
+	params.MinBaseGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroBiqDenom, sdk.NewDecWithPrec(15, 3)))
+	params.TargetBlockGas = 1
+	params.BaseGasPriceChangeRateMax = sdk.OneDec()
+	keeper.SetParams(ctx, params)
+
+	require.NoError(t, NewMigrator(keeper).Migrate1to2(ctx))
+
+	require.Equal(t, types.DefaultPaymentProcessorRebateTiers, keeper.PaymentProcessorRebateTiers(ctx))
+	require.Equal(t, types.DefaultPaymentProcessorRegistrationFee, keeper.PaymentProcessorRegistrationFee(ctx))
+	require.Equal(t, types.DefaultMinBaseGasPrices, keeper.MinBaseGasPrices(ctx))
+	require.Equal(t, types.DefaultTargetBlockGas, keeper.TargetBlockGas(ctx))
+	require.Equal(t, types.DefaultBaseGasPriceChangeRateMax, keeper.BaseGasPriceChangeRateMax(ctx))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the treasury MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (ms msgServer) RegisterPaymentProcessor(goCtx context.Context, msg *types.MsgRegisterPaymentProcessor) (*types.MsgRegisterPaymentProcessorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	processor, err := sdk.AccAddressFromBech32(msg.Processor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RegisterPaymentProcessor(ctx, processor); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterPaymentProcessor,
+			sdk.NewAttribute(types.AttributeKeyProcessor, msg.Processor),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Processor),
+		),
+	})
+
+	return &types.MsgRegisterPaymentProcessorResponse{}, nil
+}
@@ -48,6 +48,36 @@ func (k Keeper) WindowProbation(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// PaymentProcessorRebateTiers defines the tiered rebate rates for registered payment processors
+func (k Keeper) PaymentProcessorRebateTiers(ctx sdk.Context) (res types.RebateTiers) {
+	k.paramSpace.Get(ctx, types.KeyPaymentProcessorRebateTiers, &res)
+	return
+}
+
+// MinBaseGasPrices defines the floor of the on-chain base gas prices
+func (k Keeper) MinBaseGasPrices(ctx sdk.Context) (res sdk.DecCoins) {
+	k.paramSpace.Get(ctx, types.KeyMinBaseGasPrices, &res)
+	return
+}
+
+// TargetBlockGas is the block gas consumption at which base gas prices stay unchanged
+func (k Keeper) TargetBlockGas(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyTargetBlockGas, &res)
+	return
+}
+
+// BaseGasPriceChangeRateMax is the max rate base gas prices can change per block
+func (k Keeper) BaseGasPriceChangeRateMax(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyBaseGasPriceChangeRateMax, &res)
+	return
+}
+
+// PaymentProcessorRegistrationFee is the fee paid to the treasury to register a payment processor
+func (k Keeper) PaymentProcessorRegistrationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyPaymentProcessorRegistrationFee, &res)
+	return
+}
+
 // GetParams returns the total set of treasury parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
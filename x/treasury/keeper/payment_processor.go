@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+// IsPaymentProcessor returns whether the address is registered as a payment processor
+func (k Keeper) IsPaymentProcessor(ctx sdk.Context, processor sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPaymentProcessorKey(processor))
+}
+
+// RegisterPaymentProcessor registers the address as a payment processor, which pays
+// the registration fee to the treasury module account
+func (k Keeper) RegisterPaymentProcessor(ctx sdk.Context, processor sdk.AccAddress) error {
+	if k.IsPaymentProcessor(ctx, processor) {
+		return sdkerrors.Wrap(types.ErrPaymentProcessorAlreadyRegistered, processor.String())
+	}
+
+	if fee := k.PaymentProcessorRegistrationFee(ctx); !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, processor, types.ModuleName, fee); err != nil {
+			return sdkerrors.Wrap(err, "failed to pay the registration fee")
+		}
+	}
+
+	k.SetPaymentProcessorTaxProceeds(ctx, processor, sdk.Coins{})
+	return nil
+}
+
+// GetPaymentProcessorTaxProceeds returns the tax paid by the payment processor in the current epoch
+func (k Keeper) GetPaymentProcessorTaxProceeds(ctx sdk.Context, processor sdk.AccAddress) (sdk.Coins, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPaymentProcessorKey(processor))
+	if bz == nil {
+		return nil, sdkerrors.Wrap(types.ErrPaymentProcessorNotFound, processor.String())
+	}
+
+	taxProceeds := types.EpochTaxProceeds{}
+	k.cdc.MustUnmarshal(bz, &taxProceeds)
+	return taxProceeds.TaxProceeds, nil
+}
+
+// SetPaymentProcessorTaxProceeds stores the tax paid by the payment processor in the current epoch,
+// and indexes the processor as active in the epoch while its tax is not zero
+func (k Keeper) SetPaymentProcessorTaxProceeds(ctx sdk.Context, processor sdk.AccAddress, taxProceeds sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&types.EpochTaxProceeds{TaxProceeds: taxProceeds})
+	store.Set(types.GetPaymentProcessorKey(processor), bz)
+
+	if taxProceeds.IsZero() {
+		store.Delete(types.GetActivePaymentProcessorKey(processor))
+	} else {
+		store.Set(types.GetActivePaymentProcessorKey(processor), []byte{})
+	}
+}
+
+// IteratePaymentProcessors iterates all registered payment processors with their epoch tax proceeds
+func (k Keeper) IteratePaymentProcessors(ctx sdk.Context, handler func(processor sdk.AccAddress, taxProceeds sdk.Coins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PaymentProcessorKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// skip the length prefix
+		processor := sdk.AccAddress(iter.Key()[len(types.PaymentProcessorKey)+1:])
+
+		var taxProceeds types.EpochTaxProceeds
+		k.cdc.MustUnmarshal(iter.Value(), &taxProceeds)
+
+		if handler(processor, taxProceeds.TaxProceeds) {
+			break
+		}
+	}
+}
+
+// IterateActivePaymentProcessors iterates the payment processors with tax paid in the current epoch
+func (k Keeper) IterateActivePaymentProcessors(ctx sdk.Context, handler func(processor sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ActivePaymentProcessorKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// skip the length prefix
+		processor := sdk.AccAddress(iter.Key()[len(types.ActivePaymentProcessorKey)+1:])
+
+		if handler(processor) {
+			break
+		}
+	}
+}
+
+// RecordPaymentProcessorTaxProceeds adds tax paid by the payer in this epoch,
+// only when the payer is a registered payment processor
+func (k Keeper) RecordPaymentProcessorTaxProceeds(ctx sdk.Context, payer sdk.AccAddress, delta sdk.Coins) {
+	if delta.IsZero() {
+		return
+	}
+
+	proceeds, err := k.GetPaymentProcessorTaxProceeds(ctx, payer)
+	if err != nil {
+		return
+	}
+
+	k.SetPaymentProcessorTaxProceeds(ctx, payer, proceeds.Add(delta...))
+}
+
+// SettlePaymentProcessorRebates refunds the tiered portion of the epoch tax paid
+// by each payment processor active in the epoch from the treasury module account,
+// and resets the processors' epoch tax proceeds
+func (k Keeper) SettlePaymentProcessorRebates(ctx sdk.Context) {
+	tiers := k.PaymentProcessorRebateTiers(ctx)
+	capDenom := k.TaxPolicy(ctx).Cap.Denom
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	var processors []sdk.AccAddress
+	var rebates []sdk.Coins
+	k.IterateActivePaymentProcessors(ctx, func(processor sdk.AccAddress) bool {
+		taxProceeds, err := k.GetPaymentProcessorTaxProceeds(ctx, processor)
+		if err != nil {
+			panic(err)
+		}
+
+		processors = append(processors, processor)
+		alignedAmt := k.alignCoins(ctx, sdk.NewDecCoinsFromCoins(taxProceeds...), capDenom).TruncateInt()
+		rate := tiers.RebateRate(alignedAmt)
+
+		rebate := sdk.Coins{}
+		if rate.IsPositive() {
+			rebate, _ = sdk.NewDecCoinsFromCoins(taxProceeds...).MulDecTruncate(rate).TruncateDecimal()
+		}
+
+		rebates = append(rebates, rebate)
+		return false
+	})
+
+	for i, processor := range processors {
+		k.SetPaymentProcessorTaxProceeds(ctx, processor, sdk.Coins{})
+
+		// Rebates are bounded by the funds left in the treasury module account
+		rebate := minCoins(rebates[i], k.bankKeeper.GetAllBalances(ctx, moduleAddr))
+		if rebate.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, processor, rebate); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypePaymentProcessorRebate,
+				sdk.NewAttribute(types.AttributeKeyProcessor, processor.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, rebate.String()),
+			),
+		)
+	}
+}
+
+// minCoins returns the per denom minimum of the given coins
+func minCoins(coinsA sdk.Coins, coinsB sdk.Coins) sdk.Coins {
+	res := sdk.Coins{}
+	for _, coin := range coinsA {
+		amt := sdk.MinInt(coin.Amount, coinsB.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			res = res.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/treasury/types"
+)
+
+func TestRegisterPaymentProcessor(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.False(t, input.TreasuryKeeper.IsPaymentProcessor(input.Ctx, Addrs[0]))
+	_, err := input.TreasuryKeeper.GetPaymentProcessorTaxProceeds(input.Ctx, Addrs[0])
+	require.Error(t, err)
+
+	// the registration fee is paid to the treasury
+	fee := input.TreasuryKeeper.PaymentProcessorRegistrationFee(input.Ctx)
+	require.False(t, fee.IsZero())
+	balance := input.BankKeeper.GetAllBalances(input.Ctx, Addrs[0])
+	treasuryAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	require.NoError(t, input.TreasuryKeeper.RegisterPaymentProcessor(input.Ctx, Addrs[0]))
+	require.True(t, input.TreasuryKeeper.IsPaymentProcessor(input.Ctx, Addrs[0]))
+	require.Equal(t, balance.Sub(fee), input.BankKeeper.GetAllBalances(input.Ctx, Addrs[0]))
+	require.Equal(t, fee, input.BankKeeper.GetAllBalances(input.Ctx, treasuryAddr))
+
+	// cannot register twice
+	require.Error(t, input.TreasuryKeeper.RegisterPaymentProcessor(input.Ctx, Addrs[0]))
+
+	taxProceeds, err := input.TreasuryKeeper.GetPaymentProcessorTaxProceeds(input.Ctx, Addrs[0])
+	require.NoError(t, err)
+	require.True(t, taxProceeds.IsZero())
+
+	// an account without the fee cannot register
+	unfunded := sdk.AccAddress([]byte("unfunded-processor__"))
+	require.Error(t, input.TreasuryKeeper.RegisterPaymentProcessor(input.Ctx, unfunded))
+	require.False(t, input.TreasuryKeeper.IsPaymentProcessor(input.Ctx, unfunded))
+}
+
+func TestRecordPaymentProcessorTaxProceeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.NoError(t, input.TreasuryKeeper.RegisterPaymentProcessor(input.Ctx, Addrs[0]))
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 100))
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[0], taxes)
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[0], taxes)
+
+	// unregistered payers are not tracked
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[1], taxes)
+	require.False(t, input.TreasuryKeeper.IsPaymentProcessor(input.Ctx, Addrs[1]))
+
+	taxProceeds, err := input.TreasuryKeeper.GetPaymentProcessorTaxProceeds(input.Ctx, Addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, taxes.Add(taxes...), taxProceeds)
+
+	count := 0
+	input.TreasuryKeeper.IteratePaymentProcessors(input.Ctx, func(processor sdk.AccAddress, taxProceeds sdk.Coins) bool {
+		require.Equal(t, Addrs[0], processor)
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+
+	// only the processors with epoch tax are active
+	require.NoError(t, input.TreasuryKeeper.RegisterPaymentProcessor(input.Ctx, Addrs[2]))
+
+	var active []sdk.AccAddress
+	input.TreasuryKeeper.IterateActivePaymentProcessors(input.Ctx, func(processor sdk.AccAddress) bool {
+		active = append(active, processor)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{Addrs[0]}, active)
+}
+
+func TestSettlePaymentProcessorRebates(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.PaymentProcessorRebateTiers = types.RebateTiers{
+		{Threshold: sdk.NewInt(1000), Rate: sdk.NewDecWithPrec(1, 1)},
+		{Threshold: sdk.NewInt(10000), Rate: sdk.NewDecWithPrec(5, 1)},
+	}
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	treasuryAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.NoError(t, FundAccount(input, treasuryAddr, sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 5200))))
+
+	for _, addr := range Addrs[:3] {
+		require.NoError(t, input.TreasuryKeeper.RegisterPaymentProcessor(input.Ctx, addr))
+	}
+
+	// below the first tier
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 999)))
+	// first tier
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[1], sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 2000)))
+	// second tier
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[2], sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 10000)))
+
+	balances := make([]sdk.Coins, 3)
+	for i, addr := range Addrs[:3] {
+		balances[i] = input.BankKeeper.GetAllBalances(input.Ctx, addr)
+	}
+
+	input.TreasuryKeeper.SettlePaymentProcessorRebates(input.Ctx)
+
+	require.Equal(t, balances[0], input.BankKeeper.GetAllBalances(input.Ctx, Addrs[0]))
+	require.Equal(t, balances[1].Add(sdk.NewInt64Coin(core.MicroBSDRDenom, 200)), input.BankKeeper.GetAllBalances(input.Ctx, Addrs[1]))
+
+	require.Equal(t, balances[2].Add(sdk.NewInt64Coin(core.MicroBSDRDenom, 5000)), input.BankKeeper.GetAllBalances(input.Ctx, Addrs[2]))
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, treasuryAddr).AmountOf(core.MicroBSDRDenom).IsZero())
+
+	// epoch tax proceeds are reset
+	input.TreasuryKeeper.IteratePaymentProcessors(input.Ctx, func(processor sdk.AccAddress, taxProceeds sdk.Coins) bool {
+		require.True(t, taxProceeds.IsZero())
+		return false
+	})
+	input.TreasuryKeeper.IterateActivePaymentProcessors(input.Ctx, func(processor sdk.AccAddress) bool {
+		require.Failf(t, "active processor after the settlement", "%s", processor)
+		return false
+	})
+
+	// rebate is bounded by the treasury balance
+	require.NoError(t, FundAccount(input, treasuryAddr, sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 1000))))
+	input.TreasuryKeeper.RecordPaymentProcessorTaxProceeds(input.Ctx, Addrs[2], sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 10000)))
+	balance := input.BankKeeper.GetAllBalances(input.Ctx, Addrs[2])
+
+	input.TreasuryKeeper.SettlePaymentProcessorRebates(input.Ctx)
+	require.Equal(t, balance.Add(sdk.NewInt64Coin(core.MicroBSDRDenom, 1000)), input.BankKeeper.GetAllBalances(input.Ctx, Addrs[2]))
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, treasuryAddr).AmountOf(core.MicroBSDRDenom).IsZero())
+}
@@ -86,6 +86,32 @@ func (q querier) TaxProceeds(c context.Context, req *types.QueryTaxProceedsReque
 	return &types.QueryTaxProceedsResponse{TaxProceeds: q.PeekEpochTaxProceeds(ctx)}, nil
 }
 
+// PaymentProcessor returns the tax paid by a registered payment processor in the current epoch
+func (q querier) PaymentProcessor(c context.Context, req *types.QueryPaymentProcessorRequest) (*types.QueryPaymentProcessorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	processor, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	taxProceeds, err := q.GetPaymentProcessorTaxProceeds(ctx, processor)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPaymentProcessorResponse{TaxProceeds: taxProceeds}, nil
+}
+
+// GasPrices returns the current on-chain base gas prices
+func (q querier) GasPrices(c context.Context, req *types.QueryGasPricesRequest) (*types.QueryGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryGasPricesResponse{GasPrices: q.GetBaseGasPrices(ctx)}, nil
+}
+
 // Indicators return the current trl informations
 func (q querier) Indicators(c context.Context, req *types.QueryIndicatorsRequest) (*types.QueryIndicatorsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -15,6 +15,7 @@ import (
 // - Merge Epoch genesis data to EpochState from x/treasury genesis state.
 // - Update RewardWeight to one to burn all seigniorage
 // - Update Params.RewardPolicy so that RewardWeight does not change.
+// - Set default base gas price params.
 // - Re-encode in v0.5 GenesisState.
 func Migrate(
 	treasuryGenState v04treasury.GenesisState,
@@ -47,7 +48,7 @@ func Migrate(
 			Epoch:             uint64(i - cumulativeEpochs),
 			TaxReward:         treasuryGenState.TRs[i],
 			SeigniorageReward: treasuryGenState.SRs[i],
-			TotalStakedBiq:   treasuryGenState.TSLs[i],
+			TotalStakedBiq:    treasuryGenState.TSLs[i],
 		}
 	}
 
@@ -71,11 +72,15 @@ func Migrate(
 				Cap:           treasuryGenState.Params.RewardPolicy.Cap,
 				ChangeRateMax: sdk.ZeroDec(),
 			},
-			MiningIncrement:         treasuryGenState.Params.MiningIncrement,
-			SeigniorageBurdenTarget: treasuryGenState.Params.SeigniorageBurdenTarget,
-			WindowShort:             uint64(treasuryGenState.Params.WindowShort),
-			WindowLong:              uint64(treasuryGenState.Params.WindowLong),
-			WindowProbation:         uint64(treasuryGenState.Params.WindowProbation),
+			MiningIncrement:                 treasuryGenState.Params.MiningIncrement,
+			SeigniorageBurdenTarget:         treasuryGenState.Params.SeigniorageBurdenTarget,
+			WindowShort:                     uint64(treasuryGenState.Params.WindowShort),
+			WindowLong:                      uint64(treasuryGenState.Params.WindowLong),
+			WindowProbation:                 uint64(treasuryGenState.Params.WindowProbation),
+			MinBaseGasPrices:                v05treasury.DefaultMinBaseGasPrices,
+			TargetBlockGas:                  v05treasury.DefaultTargetBlockGas,
+			BaseGasPriceChangeRateMax:       v05treasury.DefaultBaseGasPriceChangeRateMax,
+			PaymentProcessorRegistrationFee: v05treasury.DefaultPaymentProcessorRegistrationFee,
 		},
 	}
 }
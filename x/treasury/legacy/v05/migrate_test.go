@@ -129,8 +129,18 @@ func TestMigrate(t *testing.T) {
 			"total_staked_biq": "300"
 		}
 	],
+	"base_gas_prices": [],
 	"params": {
+		"base_gas_price_change_rate_max": "0.125000000000000000",
+		"min_base_gas_prices": [],
 		"mining_increment": "1.070000000000000000",
+		"payment_processor_rebate_tiers": [],
+		"payment_processor_registration_fee": [
+			{
+				"amount": "100000000",
+				"denom": "ubiq"
+			}
+		],
 		"reward_policy": {
 			"cap": {
 				"amount": "0",
@@ -141,6 +151,7 @@ func TestMigrate(t *testing.T) {
 			"rate_min": "0.000000000000000000"
 		},
 		"seigniorage_burden_target": "0.670000000000000000",
+		"target_block_gas": "10000000",
 		"tax_policy": {
 			"cap": {
 				"amount": "1000000",
@@ -154,6 +165,7 @@ func TestMigrate(t *testing.T) {
 		"window_probation": "18",
 		"window_short": "4"
 	},
+	"payment_processors": [],
 	"reward_weight": "1.000000000000000000",
 	"tax_caps": [
 		{
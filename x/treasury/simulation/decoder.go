@@ -56,6 +56,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &TotalStakedBiqA)
 			cdc.MustUnmarshal(kvB.Value, &TotalStakedBiqB)
 			return fmt.Sprintf("%v\n%v", TotalStakedBiqA, TotalStakedBiqB)
+		case bytes.Equal(kvA.Key[:1], types.PaymentProcessorKey):
+			var taxProceedsA, taxProceedsB types.EpochTaxProceeds
+			cdc.MustUnmarshal(kvA.Value, &taxProceedsA)
+			cdc.MustUnmarshal(kvB.Value, &taxProceedsB)
+			return fmt.Sprintf("%v\n%v", taxProceedsA.TaxProceeds, taxProceedsB.TaxProceeds)
+		case bytes.Equal(kvA.Key[:1], types.BaseGasPricesKey):
+			var gasPricesA, gasPricesB types.BaseGasPrices
+			cdc.MustUnmarshal(kvA.Value, &gasPricesA)
+			cdc.MustUnmarshal(kvB.Value, &gasPricesB)
+			return fmt.Sprintf("%v\n%v", gasPricesA.GasPrices, gasPricesB.GasPrices)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -117,13 +117,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	treasuryGenesis := types.NewGenesisState(
 		types.Params{
-			TaxPolicy:               taxPolicy,
-			RewardPolicy:            rewardPolicy,
-			SeigniorageBurdenTarget: seigniorageBurdenTarget,
-			MiningIncrement:         miningIncrement,
-			WindowShort:             windowShort,
-			WindowLong:              windowLong,
-			WindowProbation:         windowProbation,
+			TaxPolicy:                       taxPolicy,
+			RewardPolicy:                    rewardPolicy,
+			SeigniorageBurdenTarget:         seigniorageBurdenTarget,
+			MiningIncrement:                 miningIncrement,
+			WindowShort:                     windowShort,
+			WindowLong:                      windowLong,
+			WindowProbation:                 windowProbation,
+			PaymentProcessorRebateTiers:     types.DefaultPaymentProcessorRebateTiers,
+			MinBaseGasPrices:                types.DefaultMinBaseGasPrices,
+			TargetBlockGas:                  types.DefaultTargetBlockGas,
+			BaseGasPriceChangeRateMax:       types.DefaultBaseGasPriceChangeRateMax,
+			PaymentProcessorRegistrationFee: types.DefaultPaymentProcessorRegistrationFee,
 		},
 		taxPolicy.RateMin,
 		rewardPolicy.RateMin,
@@ -131,6 +136,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		sdk.Coins{},
 		sdk.Coins{},
 		[]types.EpochState{},
+		[]types.PaymentProcessor{},
+		sdk.DecCoins{},
 	)
 
 	bz, err := json.MarshalIndent(&treasuryGenesis.Params, "", " ")
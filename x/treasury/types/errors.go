@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Treasury errors
+var (
+	ErrPaymentProcessorAlreadyRegistered = sdkerrors.Register(ModuleName, 2, "payment processor already registered")
+	ErrPaymentProcessorNotFound          = sdkerrors.Register(ModuleName, 3, "payment processor not found")
+)
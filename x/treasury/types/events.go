@@ -2,13 +2,17 @@ package types
 
 // Treasury module event types
 const (
-	EventTypePolicyUpdate       = "policy_update"
-	EventTypeTaxRateUpdate      = "tax_rate_update"
-	EventTypeRewardWeightUpdate = "reward_weight_update"
+	EventTypePolicyUpdate             = "policy_update"
+	EventTypeTaxRateUpdate            = "tax_rate_update"
+	EventTypeRewardWeightUpdate       = "reward_weight_update"
+	EventTypeRegisterPaymentProcessor = "register_payment_processor"
+	EventTypePaymentProcessorRebate   = "payment_processor_rebate"
 
 	AttributeKeyTaxRate      = "tax_rate"
 	AttributeKeyRewardWeight = "reward_weight"
 	AttributeKeyTaxCap       = "tax_cap"
+	AttributeKeyProcessor    = "processor"
+	AttributeKeyAmount       = "amount"
 
 	AttributeValueCategory = ModuleName
 )
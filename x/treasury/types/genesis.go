@@ -11,7 +11,7 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, taxRate sdk.Dec, rewardWeight sdk.Dec,
 	taxCaps []TaxCap, taxProceeds sdk.Coins, epochInitialIssuance sdk.Coins,
-	epochStates []EpochState) *GenesisState {
+	epochStates []EpochState, paymentProcessors []PaymentProcessor, baseGasPrices sdk.DecCoins) *GenesisState {
 	return &GenesisState{
 		Params:               params,
 		TaxRate:              taxRate,
@@ -20,6 +20,8 @@ func NewGenesisState(params Params, taxRate sdk.Dec, rewardWeight sdk.Dec,
 		TaxProceeds:          taxProceeds,
 		EpochInitialIssuance: epochInitialIssuance,
 		EpochStates:          epochStates,
+		PaymentProcessors:    paymentProcessors,
+		BaseGasPrices:        baseGasPrices,
 	}
 }
 
@@ -33,6 +35,8 @@ func DefaultGenesisState() *GenesisState {
 		TaxProceeds:          sdk.Coins{},
 		EpochInitialIssuance: sdk.Coins{},
 		EpochStates:          []EpochState{},
+		PaymentProcessors:    []PaymentProcessor{},
+		BaseGasPrices:        sdk.DecCoins{},
 	}
 }
 
@@ -48,6 +52,16 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("reward_weight must less than WeightMax(%s) and bigger than RateMin(%s)", data.Params.RewardPolicy.RateMax, data.Params.RewardPolicy.RateMin)
 	}
 
+	for _, processor := range data.PaymentProcessors {
+		if _, err := sdk.AccAddressFromBech32(processor.Address); err != nil {
+			return err
+		}
+	}
+
+	if !data.BaseGasPrices.Empty() && !data.BaseGasPrices.IsValid() {
+		return fmt.Errorf("base_gas_prices are invalid: %s", data.BaseGasPrices)
+	}
+
 	return data.Params.Validate()
 }
 
@@ -27,13 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the oracle module's genesis state.
 type GenesisState struct {
-	Params               Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TaxRate              github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
-	RewardWeight         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
-	TaxCaps              []TaxCap                                 `protobuf:"bytes,4,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
-	TaxProceeds          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
-	EpochInitialIssuance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_initial_issuance,json=epochInitialIssuance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_initial_issuance"`
-	EpochStates          []EpochState                             `protobuf:"bytes,7,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
+	Params               Params                                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TaxRate              github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	RewardWeight         github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	TaxCaps              []TaxCap                                    `protobuf:"bytes,4,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
+	TaxProceeds          github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,5,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
+	EpochInitialIssuance github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,6,rep,name=epoch_initial_issuance,json=epochInitialIssuance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_initial_issuance"`
+	EpochStates          []EpochState                                `protobuf:"bytes,7,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
+	PaymentProcessors    []PaymentProcessor                          `protobuf:"bytes,8,rep,name=payment_processors,json=paymentProcessors,proto3" json:"payment_processors"`
+	BaseGasPrices        github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=base_gas_prices,json=baseGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_gas_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +106,20 @@ func (m *GenesisState) GetEpochStates() []EpochState {
 	return nil
 }
 
+func (m *GenesisState) GetPaymentProcessors() []PaymentProcessor {
+	if m != nil {
+		return m.PaymentProcessors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBaseGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseGasPrices
+	}
+	return nil
+}
+
 // TaxCap is the max tax amount can be charged for the given denom
 type TaxCap struct {
 	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -155,7 +171,7 @@ type EpochState struct {
 	Epoch             uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	TaxReward         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_reward,json=taxReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_reward"`
 	SeigniorageReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_reward,json=seigniorageReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_reward"`
-	TotalStakedBiq   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_staked_biq,json=totalStakedBiq,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked_biq"`
+	TotalStakedBiq    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_staked_biq,json=totalStakedBiq,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked_biq"`
 }
 
 func (m *EpochState) Reset()         { *m = EpochState{} }
@@ -198,53 +214,112 @@ func (m *EpochState) GetEpoch() uint64 {
 	return 0
 }
 
+// PaymentProcessor is the record for each registered payment processor
+// and the tax it has paid during the current epoch
+type PaymentProcessor struct {
+	Address     string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
+}
+
+func (m *PaymentProcessor) Reset()         { *m = PaymentProcessor{} }
+func (m *PaymentProcessor) String() string { return proto.CompactTextString(m) }
+func (*PaymentProcessor) ProtoMessage()    {}
+func (*PaymentProcessor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c440a3f50aabab34, []int{3}
+}
+func (m *PaymentProcessor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentProcessor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentProcessor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentProcessor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentProcessor.Merge(m, src)
+}
+func (m *PaymentProcessor) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentProcessor) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentProcessor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentProcessor proto.InternalMessageInfo
+
+func (m *PaymentProcessor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PaymentProcessor) GetTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxProceeds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "iq.treasury.v1beta1.GenesisState")
 	proto.RegisterType((*TaxCap)(nil), "iq.treasury.v1beta1.TaxCap")
 	proto.RegisterType((*EpochState)(nil), "iq.treasury.v1beta1.EpochState")
+	proto.RegisterType((*PaymentProcessor)(nil), "iq.treasury.v1beta1.PaymentProcessor")
 }
 
-func init() {
-	proto.RegisterFile("iq/treasury/v1beta1/genesis.proto", fileDescriptor_c440a3f50aabab34)
-}
+func init() { proto.RegisterFile("iq/treasury/v1beta1/genesis.proto", fileDescriptor_c440a3f50aabab34) }
 
 var fileDescriptor_c440a3f50aabab34 = []byte{
-	// 558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x6d, 0x9a, 0xfc, 0x33, 0xc9, 0x1f, 0xe9, 0x10, 0xca, 0xda, 0xc3, 0x26, 0x04,
-	0x95, 0x1c, 0xec, 0xae, 0xd5, 0xab, 0x20, 0x24, 0x6a, 0x09, 0x2a, 0x94, 0x8d, 0x20, 0x14, 0x24,
-	0xbc, 0xd9, 0xbc, 0x6c, 0x86, 0x26, 0x33, 0xcb, 0xcc, 0xc4, 0x26, 0x47, 0xbf, 0x81, 0x9f, 0x43,
-	0xfc, 0x20, 0x3d, 0xf6, 0x28, 0x1e, 0xaa, 0x24, 0x77, 0x3f, 0x83, 0xcc, 0xcc, 0x36, 0xed, 0xc1,
-	0x8a, 0x04, 0x4f, 0xc9, 0xee, 0x3e, 0xf3, 0x7b, 0x9e, 0x7d, 0xdf, 0x87, 0x25, 0xf7, 0x34, 0x4a,
-	0x09, 0x91, 0x96, 0x08, 0x6a, 0x26, 0x17, 0xd1, 0x87, 0xc3, 0x21, 0x6a, 0x38, 0x8c, 0x52, 0xe4,
-	0xa8, 0x98, 0x0a, 0x33, 0x29, 0xb4, 0xa0, 0x7b, 0x56, 0x15, 0x5e, 0xa9, 0xc2, 0x5c, 0xb5, 0x5f,
-	0x4f, 0x45, 0x2a, 0xac, 0x24, 0x32, 0xff, 0x9c, 0x7a, 0xff, 0xfe, 0x2d, 0xcc, 0xf5, 0x71, 0x27,
-	0x0b, 0x12, 0xa1, 0xa6, 0x42, 0x45, 0x43, 0x50, 0xb8, 0xd6, 0x24, 0x82, 0x71, 0xf7, 0xbc, 0xf5,
-	0xb3, 0x48, 0x6a, 0x47, 0x2e, 0x46, 0x5f, 0x83, 0x46, 0xfa, 0x94, 0x94, 0x32, 0x90, 0x30, 0x55,
-	0xbe, 0xd7, 0xf4, 0xda, 0xd5, 0xc7, 0x41, 0xf8, 0xfb, 0x58, 0xe1, 0xb1, 0x55, 0x75, 0x8a, 0xe7,
-	0x97, 0x8d, 0x42, 0x9c, 0x9f, 0xa1, 0x3d, 0xf2, 0x9f, 0x86, 0xf9, 0x40, 0x82, 0x46, 0x7f, 0xab,
-	0xe9, 0xb5, 0x2b, 0x9d, 0xd0, 0x3c, 0xff, 0x76, 0xd9, 0x78, 0x90, 0x32, 0x3d, 0x9e, 0x0d, 0xc3,
-	0x44, 0x4c, 0xa3, 0x3c, 0x93, 0xfb, 0x39, 0x50, 0xa3, 0xd3, 0x48, 0x2f, 0x32, 0x54, 0xe1, 0x73,
-	0x4c, 0xe2, 0xb2, 0x86, 0x79, 0x6c, 0x82, 0xf4, 0xc9, 0xff, 0x12, 0xcf, 0x40, 0x8e, 0x06, 0x67,
-	0xc8, 0xd2, 0xb1, 0xf6, 0xb7, 0x37, 0xe2, 0xd5, 0x1c, 0xe4, 0x9d, 0x65, 0xd0, 0x67, 0x2e, 0x5f,
-	0x02, 0x99, 0xf2, 0x8b, 0xcd, 0xed, 0x3f, 0xbd, 0xdf, 0x5b, 0x98, 0x77, 0x21, 0xcb, 0xdf, 0xcf,
-	0xa4, 0xea, 0x42, 0xa6, 0x28, 0x27, 0x35, 0x03, 0xc8, 0xa4, 0x48, 0x10, 0x47, 0xca, 0xdf, 0xb1,
-	0x90, 0xbb, 0xa1, 0xf3, 0x0e, 0xcd, 0x98, 0xd7, 0x84, 0xae, 0x60, 0xbc, 0xf3, 0xc8, 0x9c, 0xff,
-	0xfc, 0xbd, 0xd1, 0xfe, 0x8b, 0xbc, 0xe6, 0x80, 0x8a, 0xab, 0x1a, 0xe6, 0xc7, 0x39, 0x9f, 0x7e,
-	0xf4, 0xc8, 0x1e, 0x66, 0x22, 0x19, 0x0f, 0x18, 0x67, 0x9a, 0xc1, 0x64, 0xc0, 0x94, 0x9a, 0x01,
-	0x4f, 0xd0, 0x2f, 0xfd, 0x7b, 0xeb, 0xba, 0xb5, 0xea, 0x39, 0xa7, 0x5e, 0x6e, 0x44, 0x5f, 0x91,
-	0x9a, 0x8b, 0xa0, 0x4c, 0x43, 0x94, 0x5f, 0xb6, 0xc6, 0xad, 0xdb, 0x06, 0xf7, 0xc2, 0x68, 0x6d,
-	0x99, 0xf2, 0xe1, 0x55, 0x71, 0x7d, 0x47, 0xb5, 0x52, 0x52, 0x72, 0x93, 0xa5, 0x75, 0xb2, 0x33,
-	0x42, 0x2e, 0xa6, 0xb6, 0x68, 0x95, 0xd8, 0x5d, 0xd0, 0x23, 0x52, 0xce, 0x37, 0xb4, 0x41, 0x81,
-	0x7a, 0x5c, 0xc7, 0x25, 0xb7, 0xaa, 0xd6, 0x97, 0x2d, 0x42, 0xae, 0xa3, 0x18, 0x37, 0x1b, 0xc3,
-	0xba, 0x15, 0x63, 0x77, 0x41, 0xdf, 0x10, 0x62, 0xfb, 0x6a, 0x3b, 0xb2, 0x61, 0x63, 0x2b, 0xa6,
-	0xb1, 0x16, 0x40, 0xdf, 0x13, 0xaa, 0x90, 0xa5, 0x9c, 0x09, 0x09, 0x29, 0x5e, 0x61, 0x37, 0x2b,
-	0xee, 0xee, 0x0d, 0x52, 0x8e, 0x3f, 0x21, 0xbb, 0x5a, 0x68, 0x98, 0x98, 0x45, 0x9c, 0xe2, 0x68,
-	0x30, 0x99, 0x71, 0xf0, 0x8b, 0x1b, 0x4d, 0xe9, 0x8e, 0x05, 0xf5, 0x2d, 0xe7, 0xf5, 0x8c, 0x43,
-	0xe7, 0xe5, 0xf9, 0x32, 0xf0, 0x2e, 0x96, 0x81, 0xf7, 0x63, 0x19, 0x78, 0x9f, 0x56, 0x41, 0xe1,
-	0x62, 0x15, 0x14, 0xbe, 0xae, 0x82, 0xc2, 0xc9, 0xc3, 0x1b, 0x48, 0xbb, 0xf2, 0x83, 0xa9, 0xe0,
-	0xb8, 0x88, 0x12, 0x21, 0x31, 0x9a, 0x5f, 0x7f, 0x81, 0x2c, 0x7c, 0x58, 0xb2, 0xdf, 0x95, 0x27,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x0b, 0xe8, 0x53, 0xf4, 0x04, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xd1, 0x4e, 0x13, 0x41,
+	0x14, 0x86, 0xbb, 0x50, 0x5a, 0x3a, 0x14, 0x85, 0x91, 0x98, 0x15, 0xcd, 0x16, 0x9b, 0x68, 0x48,
+	0x0c, 0xbb, 0x22, 0x57, 0x26, 0x5e, 0x15, 0x08, 0xf6, 0xc2, 0xa4, 0x59, 0x4c, 0x34, 0x24, 0x66,
+	0x33, 0xbb, 0x7b, 0xb2, 0x4c, 0xa0, 0x3b, 0xdb, 0x3d, 0x83, 0xb4, 0x97, 0xbe, 0x81, 0x0f, 0xe0,
+	0x13, 0xf0, 0x0c, 0x3e, 0x00, 0x97, 0x5c, 0x1a, 0x2f, 0xd0, 0xc0, 0x8b, 0x98, 0x99, 0xd9, 0x16,
+	0x82, 0x25, 0x21, 0x8d, 0x5e, 0xc1, 0xec, 0x9c, 0xf3, 0xfd, 0x67, 0xff, 0xfe, 0x67, 0xc9, 0x53,
+	0xde, 0xf3, 0x64, 0x0e, 0x0c, 0x8f, 0xf2, 0x81, 0xf7, 0x79, 0x3d, 0x04, 0xc9, 0xd6, 0xbd, 0x04,
+	0x52, 0x40, 0x8e, 0x6e, 0x96, 0x0b, 0x29, 0xe8, 0x03, 0xde, 0x73, 0x87, 0x25, 0x6e, 0x51, 0xb2,
+	0xbc, 0x94, 0x88, 0x44, 0xe8, 0x7b, 0x4f, 0xfd, 0x67, 0x4a, 0x97, 0x9b, 0xe3, 0x68, 0xa3, 0x5e,
+	0x53, 0xe3, 0x44, 0x02, 0xbb, 0x02, 0xbd, 0x90, 0x21, 0x8c, 0x6a, 0x22, 0xc1, 0x53, 0x73, 0xdf,
+	0xfc, 0x5e, 0x21, 0xf5, 0x1d, 0x33, 0xc0, 0xae, 0x64, 0x12, 0xe8, 0x6b, 0x52, 0xc9, 0x58, 0xce,
+	0xba, 0x68, 0x5b, 0x2b, 0xd6, 0xea, 0xdc, 0xab, 0xc7, 0xee, 0x98, 0x81, 0xdc, 0x8e, 0x2e, 0x69,
+	0x95, 0x4f, 0xcf, 0x1b, 0x25, 0xbf, 0x68, 0xa0, 0x6d, 0x32, 0x2b, 0x59, 0x3f, 0xc8, 0x99, 0x04,
+	0x7b, 0x6a, 0xc5, 0x5a, 0xad, 0xb5, 0x5c, 0x75, 0xff, 0xf3, 0xbc, 0xf1, 0x3c, 0xe1, 0x72, 0xff,
+	0x28, 0x74, 0x23, 0xd1, 0xf5, 0x8a, 0x81, 0xcc, 0x9f, 0x35, 0x8c, 0x0f, 0x3c, 0x39, 0xc8, 0x00,
+	0xdd, 0x2d, 0x88, 0xfc, 0xaa, 0x64, 0x7d, 0x5f, 0x4d, 0xb1, 0x4b, 0xe6, 0x73, 0x38, 0x66, 0x79,
+	0x1c, 0x1c, 0x03, 0x4f, 0xf6, 0xa5, 0x3d, 0x3d, 0x11, 0xaf, 0x6e, 0x20, 0x1f, 0x34, 0x83, 0xbe,
+	0x31, 0xf3, 0x45, 0x2c, 0x43, 0xbb, 0xbc, 0x32, 0x7d, 0xeb, 0xcb, 0xbd, 0x67, 0xfd, 0x4d, 0x96,
+	0x15, 0x2f, 0xa7, 0x46, 0xda, 0x64, 0x19, 0xd2, 0x94, 0xd4, 0x55, 0x77, 0x96, 0x8b, 0x08, 0x20,
+	0x46, 0x7b, 0x46, 0x13, 0x1e, 0xb9, 0x46, 0xd8, 0x55, 0x06, 0x8f, 0x08, 0x9b, 0x82, 0xa7, 0xad,
+	0x97, 0xaa, 0xff, 0xe4, 0x57, 0x63, 0xf5, 0x0e, 0xc3, 0xaa, 0x06, 0xf4, 0xe7, 0x24, 0xeb, 0x77,
+	0x0a, 0x3e, 0xfd, 0x62, 0x91, 0x87, 0x90, 0x89, 0x68, 0x3f, 0xe0, 0x29, 0x97, 0x9c, 0x1d, 0x06,
+	0x1c, 0xf1, 0x88, 0xa5, 0x11, 0xd8, 0x95, 0x7f, 0x2f, 0xbd, 0xa4, 0xa5, 0xda, 0x46, 0xa9, 0x5d,
+	0x08, 0xd1, 0xb7, 0xa4, 0x6e, 0x46, 0x40, 0x95, 0x0d, 0xb4, 0xab, 0x5a, 0xb8, 0x31, 0xd6, 0xb5,
+	0x6d, 0x55, 0xa8, 0x33, 0x54, 0x38, 0x37, 0x07, 0xa3, 0x27, 0x48, 0xf7, 0x08, 0xcd, 0xd8, 0xa0,
+	0x0b, 0xa9, 0x34, 0x0e, 0x22, 0x8a, 0x1c, 0xed, 0x59, 0xcd, 0x7b, 0x76, 0x4b, 0xc4, 0x74, 0x79,
+	0x67, 0x58, 0x5d, 0x50, 0x17, 0xb3, 0x1b, 0xcf, 0x91, 0x0e, 0xc8, 0x7d, 0x65, 0x41, 0x90, 0x30,
+	0x0c, 0xb2, 0x9c, 0x47, 0x80, 0x76, 0x4d, 0x83, 0x9f, 0x8c, 0x75, 0x68, 0x0b, 0x22, 0x6d, 0xd2,
+	0x46, 0x61, 0xd2, 0x8b, 0xbb, 0x85, 0xc9, 0xf8, 0x34, 0xaf, 0x50, 0x3b, 0x0c, 0x3b, 0x5a, 0xa7,
+	0x99, 0x90, 0x8a, 0x49, 0x0b, 0x5d, 0x22, 0x33, 0x31, 0xa4, 0xa2, 0xab, 0xd7, 0xa6, 0xe6, 0x9b,
+	0x03, 0xdd, 0x21, 0xd5, 0x22, 0x72, 0x13, 0x6c, 0x44, 0x3b, 0x95, 0x7e, 0xc5, 0xc4, 0xaf, 0x79,
+	0x32, 0x45, 0xc8, 0x95, 0xc3, 0x4a, 0x4d, 0xbb, 0xab, 0xd5, 0xca, 0xbe, 0x39, 0xd0, 0x77, 0x84,
+	0xe8, 0x05, 0xd4, 0xa1, 0x9f, 0x70, 0x05, 0x6b, 0x6a, 0x05, 0x35, 0x80, 0x7e, 0x22, 0x14, 0x81,
+	0x27, 0x29, 0x17, 0x39, 0x4b, 0x60, 0x88, 0x9d, 0x6c, 0x13, 0x17, 0xaf, 0x91, 0x0a, 0xfc, 0x47,
+	0xb2, 0x20, 0x85, 0x64, 0x87, 0x2a, 0x5c, 0x07, 0x10, 0x07, 0x21, 0xef, 0xd9, 0xe5, 0x89, 0x4c,
+	0xba, 0xa7, 0x39, 0xbb, 0x1a, 0xd3, 0xe2, 0xbd, 0xe6, 0x37, 0x8b, 0x2c, 0xdc, 0x8c, 0x0f, 0xb5,
+	0x49, 0x95, 0xc5, 0x71, 0x0e, 0x88, 0xc5, 0x4f, 0x34, 0x3c, 0xfe, 0xb5, 0xd9, 0x53, 0xff, 0x77,
+	0xb3, 0x5b, 0xdb, 0xa7, 0x17, 0x8e, 0x75, 0x76, 0xe1, 0x58, 0xbf, 0x2f, 0x1c, 0xeb, 0xeb, 0xa5,
+	0x53, 0x3a, 0xbb, 0x74, 0x4a, 0x3f, 0x2e, 0x9d, 0xd2, 0xde, 0xf5, 0x28, 0x86, 0x5c, 0x1e, 0x43,
+	0x88, 0x1e, 0xef, 0xad, 0x45, 0x22, 0x07, 0xaf, 0x7f, 0xf5, 0xad, 0xd7, 0xe4, 0xb0, 0xa2, 0xbf,
+	0xe0, 0x1b, 0x7f, 0x06, 0x00, 0x85, 0x73, 0xd5, 0x27, 0x55, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -267,6 +342,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BaseGasPrices) > 0 {
+		for iNdEx := len(m.BaseGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PaymentProcessors) > 0 {
+		for iNdEx := len(m.PaymentProcessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentProcessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.EpochStates) > 0 {
 		for iNdEx := len(m.EpochStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -454,6 +557,50 @@ func (m *EpochState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PaymentProcessor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentProcessor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -501,6 +648,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PaymentProcessors) > 0 {
+		for _, e := range m.PaymentProcessors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BaseGasPrices) > 0 {
+		for _, e := range m.BaseGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -537,6 +696,25 @@ func (m *EpochState) Size() (n int) {
 	return n
 }
 
+func (m *PaymentProcessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -809,6 +987,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProcessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentProcessors = append(m.PaymentProcessors, PaymentProcessor{})
+			if err := m.PaymentProcessors[len(m.PaymentProcessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseGasPrices = append(m.BaseGasPrices, types.DecCoin{})
+			if err := m.BaseGasPrices[len(m.BaseGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1117,6 +1363,122 @@ func (m *EpochState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PaymentProcessor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentProcessor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
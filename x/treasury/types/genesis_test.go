@@ -36,16 +36,24 @@ func TestGenesisValidation(t *testing.T) {
 			Epoch:             0,
 			TaxReward:         dummyDec,
 			SeigniorageReward: dummyDec,
-			TotalStakedBiq:   dummyInt,
+			TotalStakedBiq:    dummyInt,
 		},
 		{
 			Epoch:             1,
 			TaxReward:         dummyDec,
 			SeigniorageReward: dummyDec,
-			TotalStakedBiq:   dummyInt,
+			TotalStakedBiq:    dummyInt,
 		},
 	}
 
 	// Valid
 	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - invalid payment processor address
+	genState.PaymentProcessors = []PaymentProcessor{{Address: "invalid", TaxProceeds: sdk.Coins{}}}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.PaymentProcessors = []PaymentProcessor{{Address: sdk.AccAddress([]byte("addr1_______________")).String(), TaxProceeds: sdk.Coins{}}}
+	require.NoError(t, ValidateGenesis(genState))
 }
@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -42,14 +45,23 @@ const BurnModuleName = "burn"
 // - 0x08<epoch_Bytes>: sdk.Int
 //
 // - 0x09: int64
+//
+// - 0x0A<processor_Bytes>: sdk.Coins
+//
+// - 0x0B: sdk.DecCoins
+//
+// - 0x0C<processor_Bytes>: []byte{}
 var (
 	// Keys for store prefixes
-	TaxRateKey              = []byte{0x01} // a key for a tax-rate
-	RewardWeightKey         = []byte{0x02} // a key for a reward-weight
-	TaxCapKey               = []byte{0x03} // prefix for each key to a tax-cap
-	TaxProceedsKey          = []byte{0x04} // a key for a tax-proceeds
-	EpochInitialIssuanceKey = []byte{0x05} // a key for a initial epoch issuance
-	CumulativeHeightKey     = []byte{0x09} // a key for a cumulated height
+	TaxRateKey                = []byte{0x01} // a key for a tax-rate
+	RewardWeightKey           = []byte{0x02} // a key for a reward-weight
+	TaxCapKey                 = []byte{0x03} // prefix for each key to a tax-cap
+	TaxProceedsKey            = []byte{0x04} // a key for a tax-proceeds
+	EpochInitialIssuanceKey   = []byte{0x05} // a key for a initial epoch issuance
+	CumulativeHeightKey       = []byte{0x09} // a key for a cumulated height
+	PaymentProcessorKey       = []byte{0x0A} // prefix for each key to a payment processor tax-proceeds
+	BaseGasPricesKey          = []byte{0x0B} // a key for a base gas prices
+	ActivePaymentProcessorKey = []byte{0x0C} // prefix for each key to a payment processor with epoch tax-proceeds
 
 	// Keys for store prefixes of internal purpose variables
 	TRKey  = []byte{0x06} // prefix for each key to a TR
@@ -77,6 +89,16 @@ func GetTSLKey(epoch int64) []byte {
 	return GetSubkeyByEpoch(TSLKey, epoch)
 }
 
+// GetPaymentProcessorKey - stored by *processor address*
+func GetPaymentProcessorKey(processor sdk.AccAddress) []byte {
+	return append(PaymentProcessorKey, address.MustLengthPrefix(processor)...)
+}
+
+// GetActivePaymentProcessorKey - stored by *processor address*
+func GetActivePaymentProcessorKey(processor sdk.AccAddress) []byte {
+	return append(ActivePaymentProcessorKey, address.MustLengthPrefix(processor)...)
+}
+
 // GetSubkeyByEpoch - stored by *epoch*
 func GetSubkeyByEpoch(prefix []byte, epoch int64) []byte {
 	b := make([]byte, 8)
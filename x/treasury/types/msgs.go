@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgRegisterPaymentProcessor{}
+)
+
+// treasury message types
+const (
+	TypeMsgRegisterPaymentProcessor = "register_payment_processor"
+)
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// NewMsgRegisterPaymentProcessor creates a MsgRegisterPaymentProcessor instance
+func NewMsgRegisterPaymentProcessor(processor sdk.AccAddress) *MsgRegisterPaymentProcessor {
+	return &MsgRegisterPaymentProcessor{
+		Processor: processor.String(),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRegisterPaymentProcessor) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRegisterPaymentProcessor) Type() string { return TypeMsgRegisterPaymentProcessor }
+
+// GetSignBytes Implements Msg
+func (msg MsgRegisterPaymentProcessor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgRegisterPaymentProcessor) GetSigners() []sdk.AccAddress {
+	processor, err := sdk.AccAddressFromBech32(msg.Processor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{processor}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgRegisterPaymentProcessor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Processor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid processor address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgRegisterPaymentProcessor(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	tests := []struct {
+		processor   sdk.AccAddress
+		expectedErr string
+	}{
+		{addrs[0], ""},
+		{sdk.AccAddress{}, "Invalid processor address (empty address string is not allowed): invalid address"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRegisterPaymentProcessor(tc.processor)
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
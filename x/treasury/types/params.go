@@ -13,22 +13,27 @@ import (
 
 // Parameter keys
 var (
-	KeyTaxPolicy               = []byte("TaxPolicy")
-	KeyRewardPolicy            = []byte("RewardPolicy")
-	KeySeigniorageBurdenTarget = []byte("SeigniorageBurdenTarget")
-	KeyMiningIncrement         = []byte("MiningIncrement")
-	KeyWindowShort             = []byte("WindowShort")
-	KeyWindowLong              = []byte("WindowLong")
-	KeyWindowProbation         = []byte("WindowProbation")
+	KeyTaxPolicy                       = []byte("TaxPolicy")
+	KeyRewardPolicy                    = []byte("RewardPolicy")
+	KeySeigniorageBurdenTarget         = []byte("SeigniorageBurdenTarget")
+	KeyMiningIncrement                 = []byte("MiningIncrement")
+	KeyWindowShort                     = []byte("WindowShort")
+	KeyWindowLong                      = []byte("WindowLong")
+	KeyWindowProbation                 = []byte("WindowProbation")
+	KeyPaymentProcessorRebateTiers     = []byte("PaymentProcessorRebateTiers")
+	KeyMinBaseGasPrices                = []byte("MinBaseGasPrices")
+	KeyTargetBlockGas                  = []byte("TargetBlockGas")
+	KeyBaseGasPriceChangeRateMax       = []byte("BaseGasPriceChangeRateMax")
+	KeyPaymentProcessorRegistrationFee = []byte("PaymentProcessorRegistrationFee")
 )
 
 // Default parameter values
 var (
 	DefaultTaxPolicy = PolicyConstraints{
-		RateMin:       sdk.NewDecWithPrec(5, 4),                                             // 0.05%
-		RateMax:       sdk.NewDecWithPrec(1, 2),                                             // 1%
+		RateMin:       sdk.NewDecWithPrec(5, 4),                                              // 0.05%
+		RateMax:       sdk.NewDecWithPrec(1, 2),                                              // 1%
 		Cap:           sdk.NewCoin(core.MicroBSDRDenom, sdk.OneInt().MulRaw(core.MicroUnit)), // 1 SDR Tax cap
-		ChangeRateMax: sdk.NewDecWithPrec(25, 5),                                            // 0.025%
+		ChangeRateMax: sdk.NewDecWithPrec(25, 5),                                             // 0.025%
 	}
 	DefaultRewardPolicy = PolicyConstraints{
 		RateMin:       sdk.NewDecWithPrec(5, 2),             // 5%
@@ -36,13 +41,20 @@ var (
 		ChangeRateMax: sdk.NewDecWithPrec(25, 3),            // 2.5%
 		Cap:           sdk.NewCoin("unused", sdk.ZeroInt()), // UNUSED
 	}
-	DefaultSeigniorageBurdenTarget = sdk.NewDecWithPrec(67, 2)  // 67%
-	DefaultMiningIncrement         = sdk.NewDecWithPrec(107, 2) // 1.07 mining increment; exponential growth
-	DefaultWindowShort             = uint64(4)                  // a month
-	DefaultWindowLong              = uint64(52)                 // a year
-	DefaultWindowProbation         = uint64(12)                 // 3 month
-	DefaultTaxRate                 = sdk.NewDecWithPrec(1, 3)   // 0.1%
-	DefaultRewardWeight            = sdk.NewDecWithPrec(5, 2)   // 5%
+	DefaultSeigniorageBurdenTarget     = sdk.NewDecWithPrec(67, 2)  // 67%
+	DefaultMiningIncrement             = sdk.NewDecWithPrec(107, 2) // 1.07 mining increment; exponential growth
+	DefaultWindowShort                 = uint64(4)                  // a month
+	DefaultWindowLong                  = uint64(52)                 // a year
+	DefaultWindowProbation             = uint64(12)                 // 3 month
+	DefaultTaxRate                     = sdk.NewDecWithPrec(1, 3)   // 0.1%
+	DefaultRewardWeight                = sdk.NewDecWithPrec(5, 2)   // 5%
+	DefaultPaymentProcessorRebateTiers = RebateTiers(nil)           // no rebates
+	DefaultMinBaseGasPrices            = sdk.DecCoins(nil)          // no base fee
+	DefaultTargetBlockGas              = uint64(10_000_000)         // half of the default block gas limit
+	DefaultBaseGasPriceChangeRateMax   = sdk.NewDecWithPrec(125, 3) // 12.5%
+
+	// the registration fee funds the rebates and bounds the number of registered processors
+	DefaultPaymentProcessorRegistrationFee = sdk.NewCoins(sdk.NewCoin(core.MicroBiqDenom, sdk.NewInt(100).MulRaw(core.MicroUnit))) // 100 BIQ
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -50,13 +62,18 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default treasury module parameters
 func DefaultParams() Params {
 	return Params{
-		TaxPolicy:               DefaultTaxPolicy,
-		RewardPolicy:            DefaultRewardPolicy,
-		SeigniorageBurdenTarget: DefaultSeigniorageBurdenTarget,
-		MiningIncrement:         DefaultMiningIncrement,
-		WindowShort:             DefaultWindowShort,
-		WindowLong:              DefaultWindowLong,
-		WindowProbation:         DefaultWindowProbation,
+		TaxPolicy:                       DefaultTaxPolicy,
+		RewardPolicy:                    DefaultRewardPolicy,
+		SeigniorageBurdenTarget:         DefaultSeigniorageBurdenTarget,
+		MiningIncrement:                 DefaultMiningIncrement,
+		WindowShort:                     DefaultWindowShort,
+		WindowLong:                      DefaultWindowLong,
+		WindowProbation:                 DefaultWindowProbation,
+		PaymentProcessorRebateTiers:     DefaultPaymentProcessorRebateTiers,
+		MinBaseGasPrices:                DefaultMinBaseGasPrices,
+		TargetBlockGas:                  DefaultTargetBlockGas,
+		BaseGasPriceChangeRateMax:       DefaultBaseGasPriceChangeRateMax,
+		PaymentProcessorRegistrationFee: DefaultPaymentProcessorRegistrationFee,
 	}
 }
 
@@ -83,6 +100,11 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWindowShort, &p.WindowShort, validateWindowShort),
 		paramstypes.NewParamSetPair(KeyWindowLong, &p.WindowLong, validateWindowLong),
 		paramstypes.NewParamSetPair(KeyWindowProbation, &p.WindowProbation, validateWindowProbation),
+		paramstypes.NewParamSetPair(KeyPaymentProcessorRebateTiers, &p.PaymentProcessorRebateTiers, validatePaymentProcessorRebateTiers),
+		paramstypes.NewParamSetPair(KeyMinBaseGasPrices, &p.MinBaseGasPrices, validateMinBaseGasPrices),
+		paramstypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramstypes.NewParamSetPair(KeyBaseGasPriceChangeRateMax, &p.BaseGasPriceChangeRateMax, validateBaseGasPriceChangeRateMax),
+		paramstypes.NewParamSetPair(KeyPaymentProcessorRegistrationFee, &p.PaymentProcessorRegistrationFee, validatePaymentProcessorRegistrationFee),
 	}
 }
 
@@ -130,6 +152,26 @@ func (p Params) Validate() error {
 		return fmt.Errorf("treasury parameter WindowLong must be bigger than WindowShort: (%d, %d)", p.WindowLong, p.WindowShort)
 	}
 
+	if err := validatePaymentProcessorRebateTiers(p.PaymentProcessorRebateTiers); err != nil {
+		return fmt.Errorf("treasury parameter PaymentProcessorRebateTiers is invalid: %s", err)
+	}
+
+	if err := validateMinBaseGasPrices(p.MinBaseGasPrices); err != nil {
+		return fmt.Errorf("treasury parameter MinBaseGasPrices is invalid: %s", err)
+	}
+
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("treasury parameter TargetBlockGas must be positive")
+	}
+
+	if p.BaseGasPriceChangeRateMax.IsNegative() || p.BaseGasPriceChangeRateMax.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter BaseGasPriceChangeRateMax must be between 0 and 1: %s", p.BaseGasPriceChangeRateMax)
+	}
+
+	if err := validatePaymentProcessorRegistrationFee(p.PaymentProcessorRegistrationFee); err != nil {
+		return fmt.Errorf("treasury parameter PaymentProcessorRegistrationFee is invalid: %s", err)
+	}
+
 	return nil
 }
 
@@ -231,3 +273,78 @@ func validateWindowProbation(i interface{}) error {
 
 	return nil
 }
+
+func validatePaymentProcessorRebateTiers(i interface{}) error {
+	v, ok := i.(RebateTiers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, tier := range v {
+		if tier.Threshold.IsNil() || tier.Threshold.IsNegative() {
+			return fmt.Errorf("rebate tier threshold must be positive: %s", tier)
+		}
+
+		if tier.Rate.IsNil() || tier.Rate.IsNegative() || tier.Rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("rebate tier rate must be between 0 and 1: %s", tier)
+		}
+
+		if idx > 0 && tier.Threshold.LTE(v[idx-1].Threshold) {
+			return fmt.Errorf("rebate tier thresholds must be strictly increasing: %s", tier)
+		}
+	}
+
+	return nil
+}
+
+func validateMinBaseGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.Empty() && !v.IsValid() {
+		return fmt.Errorf("min base gas prices are invalid: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseGasPriceChangeRateMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("base gas price change rate max must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validatePaymentProcessorRegistrationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.Empty() && !v.IsValid() {
+		return fmt.Errorf("payment processor registration fee is invalid: %s", v)
+	}
+
+	return nil
+}
@@ -52,6 +52,50 @@ func TestParams(t *testing.T) {
 	params.RewardPolicy.RateMin = sdk.NewDec(-1)
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.PaymentProcessorRebateTiers = RebateTiers{{Threshold: sdk.NewInt(-1), Rate: sdk.NewDecWithPrec(1, 1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.PaymentProcessorRebateTiers = RebateTiers{{Threshold: sdk.NewInt(100), Rate: sdk.NewDec(2)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.PaymentProcessorRebateTiers = RebateTiers{
+		{Threshold: sdk.NewInt(100), Rate: sdk.NewDecWithPrec(1, 1)},
+		{Threshold: sdk.NewInt(100), Rate: sdk.NewDecWithPrec(2, 1)},
+	}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.PaymentProcessorRegistrationFee = sdk.Coins{{Denom: "ubiq", Amount: sdk.NewInt(-1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinBaseGasPrices = sdk.DecCoins{{Denom: "ubsdr", Amount: sdk.NewDec(-1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.BaseGasPriceChangeRateMax = sdk.NewDecWithPrec(15, 1)
+	require.Error(t, params.Validate())
+
 	require.NotNil(t, params.ParamSetPairs())
 	require.NotNil(t, params.String())
 }
+
+func TestRebateRate(t *testing.T) {
+	tiers := RebateTiers{
+		{Threshold: sdk.NewInt(100), Rate: sdk.NewDecWithPrec(1, 1)},
+		{Threshold: sdk.NewInt(1000), Rate: sdk.NewDecWithPrec(2, 1)},
+	}
+
+	require.Equal(t, sdk.ZeroDec(), tiers.RebateRate(sdk.NewInt(99)))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), tiers.RebateRate(sdk.NewInt(100)))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), tiers.RebateRate(sdk.NewInt(999)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), tiers.RebateRate(sdk.NewInt(1000)))
+	require.Equal(t, sdk.ZeroDec(), RebateTiers{}.RebateRate(sdk.NewInt(1000)))
+}
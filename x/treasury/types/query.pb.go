@@ -627,6 +627,174 @@ func (m *QueryIndicatorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryIndicatorsResponse proto.InternalMessageInfo
 
+// QueryPaymentProcessorRequest is the request type for the Query/PaymentProcessor RPC method.
+type QueryPaymentProcessorRequest struct {
+	// address defines the payment processor address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPaymentProcessorRequest) Reset()         { *m = QueryPaymentProcessorRequest{} }
+func (m *QueryPaymentProcessorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentProcessorRequest) ProtoMessage()    {}
+func (*QueryPaymentProcessorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{15}
+}
+func (m *QueryPaymentProcessorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentProcessorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentProcessorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentProcessorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentProcessorRequest.Merge(m, src)
+}
+func (m *QueryPaymentProcessorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentProcessorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentProcessorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentProcessorRequest proto.InternalMessageInfo
+
+// QueryPaymentProcessorResponse is response type for the
+// Query/PaymentProcessor RPC method.
+type QueryPaymentProcessorResponse struct {
+	TaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
+}
+
+func (m *QueryPaymentProcessorResponse) Reset()         { *m = QueryPaymentProcessorResponse{} }
+func (m *QueryPaymentProcessorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentProcessorResponse) ProtoMessage()    {}
+func (*QueryPaymentProcessorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{16}
+}
+func (m *QueryPaymentProcessorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentProcessorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentProcessorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentProcessorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentProcessorResponse.Merge(m, src)
+}
+func (m *QueryPaymentProcessorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentProcessorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentProcessorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentProcessorResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentProcessorResponse) GetTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxProceeds
+	}
+	return nil
+}
+
+// QueryGasPricesRequest is the request type for the Query/GasPrices RPC method.
+type QueryGasPricesRequest struct {
+}
+
+func (m *QueryGasPricesRequest) Reset()         { *m = QueryGasPricesRequest{} }
+func (m *QueryGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPricesRequest) ProtoMessage()    {}
+func (*QueryGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{17}
+}
+func (m *QueryGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPricesRequest.Merge(m, src)
+}
+func (m *QueryGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPricesRequest proto.InternalMessageInfo
+
+// QueryGasPricesResponse is response type for the
+// Query/GasPrices RPC method.
+type QueryGasPricesResponse struct {
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+}
+
+func (m *QueryGasPricesResponse) Reset()         { *m = QueryGasPricesResponse{} }
+func (m *QueryGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPricesResponse) ProtoMessage()    {}
+func (*QueryGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{18}
+}
+func (m *QueryGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPricesResponse.Merge(m, src)
+}
+func (m *QueryGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryGasPricesResponse) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -635,7 +803,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{15}
+	return fileDescriptor_699c8c29293c9a9b, []int{19}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +842,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_699c8c29293c9a9b, []int{16}
+	return fileDescriptor_699c8c29293c9a9b, []int{20}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,73 +894,84 @@ func init() {
 	proto.RegisterType((*QuerySeigniorageProceedsResponse)(nil), "iq.treasury.v1beta1.QuerySeigniorageProceedsResponse")
 	proto.RegisterType((*QueryIndicatorsRequest)(nil), "iq.treasury.v1beta1.QueryIndicatorsRequest")
 	proto.RegisterType((*QueryIndicatorsResponse)(nil), "iq.treasury.v1beta1.QueryIndicatorsResponse")
+	proto.RegisterType((*QueryPaymentProcessorRequest)(nil), "iq.treasury.v1beta1.QueryPaymentProcessorRequest")
+	proto.RegisterType((*QueryPaymentProcessorResponse)(nil), "iq.treasury.v1beta1.QueryPaymentProcessorResponse")
+	proto.RegisterType((*QueryGasPricesRequest)(nil), "iq.treasury.v1beta1.QueryGasPricesRequest")
+	proto.RegisterType((*QueryGasPricesResponse)(nil), "iq.treasury.v1beta1.QueryGasPricesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "iq.treasury.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "iq.treasury.v1beta1.QueryParamsResponse")
 }
 
-func init() {
-	proto.RegisterFile("iq/treasury/v1beta1/query.proto", fileDescriptor_699c8c29293c9a9b)
-}
+func init() { proto.RegisterFile("iq/treasury/v1beta1/query.proto", fileDescriptor_699c8c29293c9a9b) }
 
 var fileDescriptor_699c8c29293c9a9b = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x85, 0x3a, 0xc9, 0x24, 0x5c, 0x26, 0xa6, 0x38, 0x16, 0xda, 0x35, 0xa3, 0x36,
-	0x58, 0x89, 0xb3, 0xeb, 0x84, 0x4a, 0x40, 0xc5, 0xc9, 0x45, 0x20, 0x4b, 0x45, 0x6a, 0x37, 0x96,
-	0x2a, 0x38, 0x60, 0x8d, 0xd7, 0xa3, 0xcd, 0x8a, 0x78, 0x67, 0x3b, 0x3b, 0xa6, 0xb1, 0x10, 0x17,
-	0x24, 0x24, 0xe0, 0x80, 0x90, 0x7a, 0xe2, 0x82, 0x2a, 0x8e, 0xfc, 0x11, 0x9c, 0x73, 0xac, 0xc4,
-	0x05, 0x71, 0x08, 0xc8, 0xe1, 0xc0, 0x89, 0xbf, 0x01, 0xcd, 0xec, 0xac, 0x77, 0xdd, 0x7a, 0xed,
-	0x75, 0x38, 0x65, 0x33, 0xef, 0xd7, 0x67, 0xde, 0x9b, 0xf7, 0x4d, 0x20, 0x16, 0x94, 0x73, 0x62,
-	0x0b, 0x4e, 0x49, 0x34, 0xe2, 0x63, 0xfb, 0xf3, 0xc3, 0x3e, 0x15, 0xe4, 0xd0, 0x7e, 0x34, 0xa2,
-	0x7c, 0x6c, 0x85, 0x9c, 0x09, 0x86, 0x6e, 0x28, 0x1f, 0x2b, 0xf1, 0xb1, 0xb4, 0x4f, 0xad, 0xe2,
-	0x31, 0x8f, 0x29, 0x17, 0x5b, 0x7e, 0xc5, 0xde, 0xb5, 0xd7, 0x3d, 0xc6, 0xbc, 0x53, 0x6a, 0x93,
-	0xd0, 0xb7, 0x49, 0x10, 0x30, 0x41, 0x84, 0xcf, 0x82, 0x48, 0x5b, 0x6f, 0xe5, 0xd4, 0x9b, 0x26,
-	0x8f, 0xdd, 0x0c, 0x97, 0x45, 0x43, 0x16, 0xd9, 0x7d, 0x12, 0xd1, 0xa9, 0x8f, 0xcb, 0xfc, 0x20,
-	0xb6, 0xe3, 0x57, 0xe1, 0xf6, 0x03, 0x49, 0xd8, 0x25, 0x67, 0x0e, 0x11, 0xd4, 0xa1, 0x8f, 0x46,
-	0x34, 0x12, 0x98, 0xc0, 0xca, 0xec, 0x71, 0x14, 0xb2, 0x20, 0xa2, 0xa8, 0x03, 0xd7, 0x05, 0x39,
-	0xeb, 0x71, 0x22, 0x68, 0x15, 0xd4, 0x41, 0x63, 0xa3, 0x6d, 0x9d, 0x5f, 0x98, 0xa5, 0x3f, 0x2e,
-	0xcc, 0x5d, 0xcf, 0x17, 0x27, 0xa3, 0xbe, 0xe5, 0xb2, 0xa1, 0xad, 0x6b, 0xc6, 0x3f, 0x0e, 0xa2,
-	0xc1, 0x67, 0xb6, 0x18, 0x87, 0x34, 0xb2, 0xde, 0xa7, 0xae, 0xb3, 0x26, 0xe2, 0x94, 0xf8, 0x36,
-	0x44, 0x49, 0x89, 0xbb, 0x24, 0xd4, 0x85, 0x51, 0x05, 0x5e, 0x1f, 0xd0, 0x80, 0x0d, 0xe3, 0xec,
-	0x4e, 0xfc, 0xcb, 0x9d, 0xf5, 0x6f, 0x9e, 0x9a, 0xa5, 0x7f, 0x9e, 0x9a, 0x25, 0xfc, 0x69, 0xca,
-	0xab, 0xa2, 0x34, 0xd7, 0x87, 0x50, 0xe6, 0xed, 0xb9, 0x24, 0xbc, 0x02, 0x56, 0x27, 0x10, 0x4e,
-	0x59, 0xa8, 0x84, 0xd8, 0x9c, 0xc9, 0x1f, 0x69, 0xac, 0x0c, 0xc0, 0x18, 0x56, 0x67, 0x1d, 0x62,
-	0x82, 0x8e, 0xa0, 0xc3, 0xf9, 0xf0, 0x59, 0xb6, 0x6b, 0xff, 0x8b, 0xcd, 0x4f, 0x87, 0x92, 0x2d,
-	0x8d, 0x1e, 0xc4, 0x43, 0x71, 0x49, 0x18, 0x55, 0x41, 0xfd, 0xa5, 0xc6, 0xe6, 0x51, 0xcb, 0x9a,
-	0xff, 0xd2, 0xac, 0x3c, 0xf4, 0xf6, 0xcb, 0x92, 0x49, 0x0d, 0x47, 0x9a, 0x70, 0x4d, 0xdf, 0xd2,
-	0xa1, 0x8f, 0x09, 0x1f, 0x3c, 0xa4, 0xbe, 0x77, 0x22, 0x92, 0xb7, 0x11, 0xc2, 0x9d, 0x39, 0x36,
-	0xcd, 0x72, 0x0c, 0x5f, 0xe1, 0xea, 0xbc, 0xf7, 0x58, 0x19, 0xae, 0xf8, 0x4a, 0xb6, 0x78, 0x26,
-	0x39, 0xde, 0x81, 0xaf, 0x25, 0xe0, 0xf7, 0x39, 0x73, 0x29, 0x1d, 0x24, 0x83, 0xc1, 0xdf, 0x81,
-	0x74, 0x1e, 0xa9, 0x4d, 0xc3, 0x04, 0x70, 0x4b, 0x36, 0x26, 0xd4, 0xe7, 0xba, 0x39, 0x3b, 0x56,
-	0x5c, 0xd2, 0x92, 0x3b, 0x31, 0xed, 0xcc, 0x5d, 0xe6, 0x07, 0xed, 0x96, 0xc4, 0xfc, 0xe5, 0x4f,
-	0xb3, 0x51, 0x00, 0x53, 0x06, 0x44, 0xce, 0xa6, 0x48, 0xeb, 0xe2, 0x37, 0xa0, 0xa9, 0x58, 0x8e,
-	0xa9, 0xef, 0x05, 0x3e, 0xe3, 0xc4, 0xa3, 0xcf, 0xf3, 0x7e, 0x0d, 0x60, 0x3d, 0xdf, 0x47, 0x73,
-	0x13, 0x58, 0x89, 0x52, 0x73, 0x96, 0xff, 0x2a, 0xcf, 0x67, 0x3b, 0x7a, 0xb1, 0x14, 0xae, 0xc2,
-	0x1b, 0x0a, 0xa3, 0x13, 0x0c, 0x7c, 0x97, 0x08, 0xc6, 0xa7, 0x84, 0xe7, 0x40, 0x77, 0x3b, 0x6b,
-	0xd2, 0x60, 0x5d, 0xb8, 0x2e, 0xf8, 0x69, 0x6f, 0x4c, 0x09, 0xd7, 0x30, 0xef, 0xae, 0x36, 0xd8,
-	0xc9, 0x85, 0xb9, 0xd6, 0x75, 0xee, 0x7d, 0x4c, 0x09, 0x77, 0xd6, 0x04, 0x3f, 0x95, 0x1f, 0xe8,
-	0x21, 0xdc, 0x90, 0x59, 0x87, 0x2c, 0x10, 0x27, 0x7a, 0x45, 0xee, 0xac, 0x9c, 0x76, 0xbd, 0xeb,
-	0xdc, 0xfb, 0x48, 0x66, 0x70, 0x24, 0xa2, 0xfa, 0xc2, 0x15, 0x2d, 0x31, 0xf7, 0x09, 0x27, 0xc3,
-	0xe9, 0x05, 0x8f, 0xf5, 0x8a, 0x27, 0xa7, 0xfa, 0x6e, 0xef, 0xc1, 0x72, 0xa8, 0x4e, 0xd4, 0xcd,
-	0x36, 0x8f, 0x8c, 0xbc, 0x1d, 0x8a, 0xe3, 0xf4, 0xc6, 0xe8, 0x98, 0xa3, 0x7f, 0x37, 0xe0, 0x75,
-	0x95, 0x15, 0x7d, 0x0f, 0xe0, 0x9a, 0x96, 0x4d, 0xb4, 0xbf, 0x6c, 0x0f, 0x33, 0x9a, 0x5b, 0x6b,
-	0x16, 0x73, 0x8e, 0x71, 0x71, 0xe3, 0xab, 0xdf, 0xfe, 0x7e, 0x72, 0x0d, 0xa3, 0xba, 0x9d, 0xf7,
-	0x87, 0x40, 0xeb, 0x34, 0x7a, 0x02, 0x60, 0x39, 0x5e, 0x79, 0xb4, 0x57, 0x40, 0x17, 0x12, 0x9c,
-	0xfd, 0x42, 0xbe, 0x9a, 0xa6, 0xa5, 0x68, 0xf6, 0x50, 0x63, 0x11, 0x8d, 0x14, 0x28, 0xfb, 0x0b,
-	0x25, 0x8a, 0x5f, 0x26, 0x6d, 0x92, 0x6a, 0x83, 0xf6, 0x8b, 0xc9, 0x55, 0xc1, 0x36, 0x65, 0xb5,
-	0xad, 0x58, 0x9b, 0x24, 0x18, 0xfa, 0x19, 0xc0, 0xad, 0xac, 0xa4, 0xa1, 0xc5, 0x22, 0x3a, 0x47,
-	0x19, 0x6b, 0x87, 0x2b, 0x44, 0x68, 0xbe, 0x03, 0xc5, 0xf7, 0x26, 0xba, 0x95, 0xc7, 0x37, 0xa3,
-	0xa6, 0xe8, 0x57, 0x00, 0xb7, 0xe7, 0x28, 0x07, 0x7a, 0x7b, 0x61, 0xe5, 0x7c, 0x3d, 0xaa, 0xbd,
-	0xb3, 0x7a, 0xa0, 0x26, 0xbf, 0xad, 0xc8, 0x2d, 0xd4, 0xcc, 0x23, 0x9f, 0x27, 0x61, 0xe8, 0x27,
-	0x00, 0x37, 0x33, 0x52, 0x8d, 0xec, 0x65, 0xd3, 0x7c, 0x1e, 0xb8, 0x55, 0x3c, 0x40, 0x83, 0x36,
-	0x15, 0xe8, 0x2e, 0xba, 0xb9, 0xe8, 0x09, 0x4c, 0x01, 0x7f, 0x04, 0x10, 0xa6, 0xca, 0x87, 0xac,
-	0x85, 0xe5, 0x5e, 0x50, 0xcf, 0x9a, 0x5d, 0xd8, 0x5f, 0xd3, 0xed, 0x29, 0xba, 0x9b, 0x08, 0xe7,
-	0xd1, 0xf9, 0x29, 0xcc, 0xb7, 0x00, 0x96, 0x63, 0xf5, 0x59, 0xb2, 0xc9, 0x33, 0x82, 0xb7, 0x64,
-	0x93, 0x67, 0x65, 0x10, 0xef, 0x2a, 0x9e, 0x3a, 0x32, 0xf2, 0x78, 0x62, 0xc1, 0x6b, 0x7f, 0x70,
-	0x3e, 0x31, 0xc0, 0xb3, 0x89, 0x01, 0xfe, 0x9a, 0x18, 0xe0, 0x87, 0x4b, 0xa3, 0xf4, 0xec, 0xd2,
-	0x28, 0xfd, 0x7e, 0x69, 0x94, 0x3e, 0x69, 0x66, 0x34, 0x5b, 0xe5, 0x38, 0x18, 0xb2, 0x80, 0x8e,
-	0x6d, 0x97, 0x71, 0x6a, 0x9f, 0xa5, 0x09, 0x95, 0x7a, 0xf7, 0xcb, 0xea, 0xff, 0xd0, 0xb7, 0xfe,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x47, 0x4b, 0x04, 0xb0, 0x40, 0x0b, 0x00, 0x00,
+	// 1054 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xd7, 0x85, 0xe6, 0xc7, 0x4b, 0x90, 0xd0, 0xec, 0xb6, 0xd9, 0xb8, 0xc9, 0x3a, 0x71,
+	0x29, 0xd9, 0x34, 0x8d, 0x4d, 0x92, 0x72, 0x48, 0x8f, 0x69, 0x51, 0x15, 0xa9, 0xa0, 0xe0, 0x46,
+	0xaa, 0xe0, 0xc0, 0x6a, 0xd6, 0x3b, 0x38, 0x16, 0x59, 0x8f, 0x77, 0x66, 0x42, 0xb2, 0xaa, 0x2a,
+	0x21, 0xa4, 0x4a, 0xa8, 0x12, 0xa2, 0x12, 0xe2, 0xc0, 0xad, 0x67, 0xce, 0xf0, 0x3f, 0xe4, 0x58,
+	0x89, 0x0b, 0xe2, 0x10, 0x50, 0xc2, 0x81, 0x0b, 0xff, 0x03, 0xf2, 0x78, 0xbc, 0xeb, 0xdd, 0xd8,
+	0x89, 0x13, 0x0e, 0x9c, 0xe2, 0x9d, 0xf7, 0xe6, 0xbd, 0x8f, 0xdf, 0x8c, 0xbf, 0x5f, 0x05, 0x0c,
+	0xbf, 0x63, 0x0b, 0x46, 0x30, 0xdf, 0x63, 0x5d, 0xfb, 0xcb, 0x95, 0x26, 0x11, 0x78, 0xc5, 0xee,
+	0xec, 0x11, 0xd6, 0xb5, 0x42, 0x46, 0x05, 0x45, 0x65, 0xbf, 0x63, 0x25, 0x09, 0x96, 0x4a, 0xd0,
+	0x2b, 0x1e, 0xf5, 0xa8, 0x8c, 0xdb, 0xd1, 0x53, 0x9c, 0xaa, 0xcf, 0x78, 0x94, 0x7a, 0xbb, 0xc4,
+	0xc6, 0xa1, 0x6f, 0xe3, 0x20, 0xa0, 0x02, 0x0b, 0x9f, 0x06, 0x5c, 0x45, 0xcd, 0xac, 0x4e, 0xbd,
+	0xca, 0x71, 0x4e, 0xcd, 0xa5, 0xbc, 0x4d, 0xb9, 0xdd, 0xc4, 0x9c, 0xf4, 0x72, 0x5c, 0xea, 0x07,
+	0x71, 0xdc, 0xbc, 0x06, 0xe5, 0x8f, 0x23, 0xb6, 0x6d, 0x7c, 0xe0, 0x60, 0x41, 0x1c, 0xd2, 0xd9,
+	0x23, 0x5c, 0x98, 0x18, 0x2a, 0x83, 0xcb, 0x3c, 0xa4, 0x01, 0x27, 0x68, 0x13, 0xc6, 0x04, 0x3e,
+	0x68, 0x30, 0x2c, 0x48, 0x55, 0x9b, 0xd3, 0xea, 0xe3, 0x1b, 0xd6, 0xe1, 0x91, 0x51, 0xfa, 0xfd,
+	0xc8, 0x78, 0xd7, 0xf3, 0xc5, 0xce, 0x5e, 0xd3, 0x72, 0x69, 0xdb, 0x56, 0x3d, 0xe3, 0x3f, 0xcb,
+	0xbc, 0xf5, 0x85, 0x2d, 0xba, 0x21, 0xe1, 0xd6, 0x03, 0xe2, 0x3a, 0xa3, 0x22, 0x2e, 0x69, 0xde,
+	0x05, 0x94, 0xb4, 0xb8, 0x8f, 0x43, 0xd5, 0x18, 0x55, 0xe0, 0x6a, 0x8b, 0x04, 0xb4, 0x1d, 0x57,
+	0x77, 0xe2, 0x1f, 0xf7, 0xc6, 0xbe, 0x79, 0x65, 0x94, 0xfe, 0x7e, 0x65, 0x94, 0xcc, 0xcf, 0xa0,
+	0x3c, 0xb0, 0x4b, 0x71, 0x3d, 0x84, 0xa8, 0x6e, 0xc3, 0xc5, 0xe1, 0x25, 0xb0, 0x36, 0x03, 0xe1,
+	0x8c, 0x08, 0x59, 0xd0, 0x34, 0x06, 0xea, 0x73, 0x85, 0x95, 0x02, 0xe8, 0x42, 0x75, 0x30, 0x21,
+	0x26, 0xd8, 0x14, 0xa4, 0x9d, 0x0d, 0x9f, 0x66, 0xbb, 0xf2, 0x9f, 0xd8, 0x3e, 0x87, 0x4a, 0x56,
+	0x6b, 0xf4, 0x51, 0x7c, 0x28, 0x2e, 0x0e, 0x79, 0x55, 0x9b, 0x7b, 0xa3, 0x3e, 0xb1, 0xba, 0x6c,
+	0x65, 0xdc, 0x31, 0x2b, 0x8f, 0x7b, 0xe3, 0xcd, 0x08, 0x48, 0x9e, 0x4c, 0x14, 0x32, 0x75, 0xf5,
+	0x8a, 0x0e, 0xd9, 0xc7, 0xac, 0xf5, 0x84, 0xf8, 0xde, 0x8e, 0x48, 0x2e, 0x46, 0x08, 0xd3, 0x19,
+	0x31, 0x05, 0xf2, 0x18, 0xde, 0x62, 0x72, 0xbd, 0xb1, 0x2f, 0x03, 0x97, 0xbc, 0x22, 0x93, 0x2c,
+	0x55, 0xdc, 0x9c, 0x86, 0xa9, 0x04, 0x7c, 0x8b, 0x51, 0x97, 0x90, 0x56, 0x72, 0x2a, 0xe6, 0x0b,
+	0x0d, 0xaa, 0xa7, 0x63, 0x0a, 0x26, 0x80, 0xc9, 0x68, 0x2a, 0xa1, 0x5a, 0x57, 0x93, 0x99, 0xb6,
+	0xe2, 0x96, 0x56, 0xf4, 0x41, 0xf4, 0x26, 0x73, 0x9f, 0xfa, 0xc1, 0xc6, 0x7b, 0x11, 0xe6, 0x4f,
+	0x7f, 0x18, 0xf5, 0x02, 0x98, 0xd1, 0x06, 0xee, 0x4c, 0x88, 0x7e, 0x5f, 0x73, 0x1e, 0x0c, 0xc9,
+	0xf2, 0x98, 0xf8, 0x5e, 0xe0, 0x53, 0x86, 0x3d, 0x32, 0xcc, 0xfb, 0x5c, 0x83, 0xb9, 0xfc, 0x1c,
+	0xc5, 0x8d, 0xa1, 0xc2, 0xfb, 0xe1, 0x34, 0xff, 0x65, 0xee, 0x4e, 0x99, 0x9f, 0x6e, 0x65, 0x56,
+	0xe1, 0xba, 0xc4, 0xd8, 0x0c, 0x5a, 0xbe, 0x8b, 0x05, 0x65, 0x3d, 0xc2, 0x43, 0x0d, 0xa6, 0x4e,
+	0x85, 0x14, 0xd8, 0x36, 0x8c, 0x09, 0xb6, 0xdb, 0xe8, 0x12, 0xcc, 0x14, 0xcc, 0xfa, 0xc5, 0x0e,
+	0xf6, 0xf8, 0xc8, 0x18, 0xdd, 0x76, 0x1e, 0x7d, 0x42, 0x30, 0x73, 0x46, 0x05, 0xdb, 0x8d, 0x1e,
+	0xd0, 0x13, 0x18, 0x8f, 0xaa, 0xb6, 0x69, 0x20, 0x76, 0xd4, 0xf7, 0x71, 0xef, 0xc2, 0x65, 0xc7,
+	0xb6, 0x9d, 0x47, 0x1f, 0x46, 0x15, 0x9c, 0x08, 0x51, 0x3e, 0x99, 0x1b, 0x30, 0x23, 0xdf, 0x64,
+	0x0b, 0x77, 0xdb, 0x24, 0x10, 0xf2, 0xe5, 0x39, 0xa7, 0x2c, 0x51, 0x9a, 0x2a, 0x8c, 0xe2, 0x56,
+	0x8b, 0x11, 0xae, 0x46, 0xeb, 0x24, 0x3f, 0x53, 0x1f, 0xfb, 0x77, 0x1a, 0xcc, 0xe6, 0x14, 0xf9,
+	0x9f, 0x6e, 0xd9, 0x14, 0x5c, 0x93, 0x40, 0x0f, 0x31, 0xdf, 0x62, 0xbe, 0x4b, 0xd2, 0xdf, 0xc2,
+	0xf5, 0xe1, 0x88, 0x62, 0x0c, 0x01, 0x3c, 0xcc, 0x1b, 0xa1, 0x5c, 0x55, 0x84, 0x33, 0x99, 0x84,
+	0x0f, 0x88, 0x2b, 0x21, 0xd7, 0x14, 0xe4, 0x52, 0xb1, 0x13, 0x88, 0x39, 0xc7, 0xbd, 0xa4, 0xb3,
+	0x59, 0x51, 0xda, 0xbe, 0x85, 0x19, 0x6e, 0xf7, 0x10, 0xb7, 0xa0, 0x3c, 0xb0, 0xaa, 0xf0, 0xd6,
+	0x61, 0x24, 0x94, 0x2b, 0xf2, 0x1c, 0x26, 0x56, 0x6f, 0x64, 0x8a, 0x57, 0xbc, 0x49, 0x49, 0x95,
+	0xda, 0xb0, 0xfa, 0xcf, 0x04, 0x5c, 0x95, 0x25, 0xd1, 0x73, 0x0d, 0x46, 0x95, 0x59, 0xa1, 0xfa,
+	0x99, 0xea, 0x97, 0xb2, 0x39, 0x7d, 0xb1, 0x40, 0x66, 0x4c, 0x69, 0xde, 0xfa, 0xfa, 0xd7, 0xbf,
+	0xbe, 0xbf, 0x62, 0xa0, 0x59, 0x3b, 0xd3, 0x75, 0x95, 0x29, 0xa2, 0x17, 0x1a, 0x8c, 0xc4, 0x12,
+	0x8b, 0x16, 0xce, 0x13, 0xe1, 0x84, 0xa2, 0x7e, 0x7e, 0xa2, 0x82, 0x58, 0x96, 0x10, 0x0b, 0xe8,
+	0x56, 0x2e, 0x44, 0x64, 0x02, 0xf6, 0x53, 0x69, 0x3c, 0xcf, 0x92, 0xa1, 0x44, 0xa2, 0x8e, 0xea,
+	0x05, 0x2c, 0xa1, 0xc8, 0x50, 0xd2, 0xe6, 0x51, 0x60, 0x28, 0x11, 0x0f, 0xfa, 0x51, 0x83, 0xc9,
+	0xb4, 0x61, 0xa0, 0x33, 0xfc, 0x29, 0xc3, 0x74, 0x74, 0xab, 0x68, 0xba, 0xc2, 0xba, 0x2d, 0xb1,
+	0xde, 0x41, 0x66, 0x26, 0xd6, 0x80, 0x45, 0xa1, 0x5f, 0x34, 0x28, 0x67, 0xc8, 0x31, 0xba, 0x9b,
+	0xdf, 0x33, 0x5f, 0xe1, 0xf5, 0xf7, 0x2f, 0xb8, 0x4b, 0x01, 0xaf, 0x48, 0xe0, 0x25, 0xb4, 0x98,
+	0x09, 0x9c, 0x65, 0x07, 0xe8, 0x07, 0x0d, 0x26, 0x52, 0xb6, 0x87, 0xee, 0x9c, 0x79, 0x6a, 0xc3,
+	0x9c, 0xcb, 0x05, 0xb3, 0x15, 0xdf, 0xa2, 0xe4, 0xbb, 0x89, 0xe6, 0x73, 0xcf, 0xb9, 0xc7, 0xf5,
+	0x52, 0x03, 0xe8, 0x9b, 0x07, 0x5a, 0xca, 0x6f, 0x74, 0xca, 0x7d, 0xf4, 0x3b, 0xc5, 0x92, 0x15,
+	0xd4, 0x82, 0x84, 0x9a, 0x47, 0x46, 0x26, 0x94, 0xdf, 0x67, 0xf8, 0x59, 0x83, 0xb7, 0x87, 0x05,
+	0x1c, 0xad, 0xe4, 0xf7, 0xca, 0x71, 0x0c, 0x7d, 0xf5, 0x22, 0x5b, 0x14, 0xe4, 0xba, 0x84, 0x5c,
+	0x43, 0x2b, 0x99, 0x90, 0x61, 0xbc, 0xad, 0x11, 0x26, 0xfb, 0xb8, 0xfd, 0x54, 0xb9, 0xd0, 0x33,
+	0xf4, 0xad, 0x06, 0xe3, 0x3d, 0x31, 0x47, 0xb7, 0xf3, 0x9b, 0x0f, 0x7b, 0x81, 0xbe, 0x54, 0x28,
+	0xb7, 0xd0, 0x18, 0xfb, 0xc6, 0x81, 0xbe, 0xd2, 0x60, 0x24, 0x56, 0xe1, 0xb3, 0xa4, 0x6d, 0x40,
+	0xf2, 0xf5, 0xfa, 0xf9, 0x89, 0x0a, 0xe3, 0xa6, 0xc4, 0x98, 0x45, 0x37, 0x72, 0x06, 0x25, 0xd5,
+	0xff, 0x83, 0xc3, 0xe3, 0x9a, 0xf6, 0xfa, 0xb8, 0xa6, 0xfd, 0x79, 0x5c, 0xd3, 0x5e, 0x9e, 0xd4,
+	0x4a, 0xaf, 0x4f, 0x6a, 0xa5, 0xdf, 0x4e, 0x6a, 0xa5, 0x4f, 0xd3, 0x4e, 0xd5, 0xf4, 0xc5, 0x3e,
+	0x69, 0x72, 0xdb, 0xef, 0x2c, 0xbb, 0x94, 0x11, 0xfb, 0xa0, 0x5f, 0x4f, 0x5a, 0x56, 0x73, 0x44,
+	0xfe, 0xef, 0xb3, 0xf6, 0xef, 0x00, 0xe0, 0xc6, 0x7a, 0xc5, 0xab, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -821,6 +1000,11 @@ type QueryClient interface {
 	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
 	// Indicators return the current trl informations
 	Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error)
+	// PaymentProcessor returns the tax paid by a registered payment processor
+	// during the current epoch
+	PaymentProcessor(ctx context.Context, in *QueryPaymentProcessorRequest, opts ...grpc.CallOption) (*QueryPaymentProcessorResponse, error)
+	// GasPrices returns the current on-chain base gas prices
+	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -896,6 +1080,24 @@ func (c *queryClient) Indicators(ctx context.Context, in *QueryIndicatorsRequest
 	return out, nil
 }
 
+func (c *queryClient) PaymentProcessor(ctx context.Context, in *QueryPaymentProcessorRequest, opts ...grpc.CallOption) (*QueryPaymentProcessorResponse, error) {
+	out := new(QueryPaymentProcessorResponse)
+	err := c.cc.Invoke(ctx, "/iq.treasury.v1beta1.Query/PaymentProcessor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error) {
+	out := new(QueryGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/iq.treasury.v1beta1.Query/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/iq.treasury.v1beta1.Query/Params", in, out, opts...)
@@ -921,6 +1123,11 @@ type QueryServer interface {
 	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
 	// Indicators return the current trl informations
 	Indicators(context.Context, *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error)
+	// PaymentProcessor returns the tax paid by a registered payment processor
+	// during the current epoch
+	PaymentProcessor(context.Context, *QueryPaymentProcessorRequest) (*QueryPaymentProcessorResponse, error)
+	// GasPrices returns the current on-chain base gas prices
+	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -950,6 +1157,12 @@ func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxP
 func (*UnimplementedQueryServer) Indicators(ctx context.Context, req *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Indicators not implemented")
 }
+func (*UnimplementedQueryServer) PaymentProcessor(ctx context.Context, req *QueryPaymentProcessorRequest) (*QueryPaymentProcessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentProcessor not implemented")
+}
+func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *QueryGasPricesRequest) (*QueryGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1084,6 +1297,42 @@ func _Query_Indicators_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PaymentProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentProcessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.treasury.v1beta1.Query/PaymentProcessor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentProcessor(ctx, req.(*QueryPaymentProcessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.treasury.v1beta1.Query/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrices(ctx, req.(*QueryGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1134,6 +1383,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Indicators",
 			Handler:    _Query_Indicators_Handler,
 		},
+		{
+			MethodName: "PaymentProcessor",
+			Handler:    _Query_PaymentProcessor_Handler,
+		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Query_GasPrices_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1600,6 +1857,133 @@ func (m *QueryIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPaymentProcessorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentProcessorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentProcessorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentProcessorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentProcessorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentProcessorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1836,13 +2220,65 @@ func (m *QueryIndicatorsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryPaymentProcessorRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentProcessorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -2982,6 +3418,306 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPaymentProcessorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentProcessorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentProcessorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentProcessorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentProcessorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentProcessorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
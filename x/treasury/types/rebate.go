@@ -0,0 +1,31 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// String implements fmt.Stringer interface
+func (rt RebateTier) String() string {
+	out, _ := yaml.Marshal(rt)
+	return string(out)
+}
+
+// RebateTiers is a slice of RebateTier sorted by ascending threshold
+type RebateTiers []RebateTier
+
+// RebateRate returns the rate of the highest tier whose threshold
+// is reached by the given amount, or zero when no tier applies
+func (tiers RebateTiers) RebateRate(amount sdk.Int) sdk.Dec {
+	rate := sdk.ZeroDec()
+	for _, tier := range tiers {
+		if amount.LT(tier.Threshold) {
+			break
+		}
+
+		rate = tier.Rate
+	}
+
+	return rate
+}
@@ -27,13 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the oracle module.
 type Params struct {
-	TaxPolicy               PolicyConstraints                      `protobuf:"bytes,1,opt,name=tax_policy,json=taxPolicy,proto3" json:"tax_policy" yaml:"tax_policy"`
-	RewardPolicy            PolicyConstraints                      `protobuf:"bytes,2,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy" yaml:"reward_policy"`
-	SeigniorageBurdenTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_burden_target,json=seigniorageBurdenTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_burden_target" yaml:"seigniorage_burden_target"`
-	MiningIncrement         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=mining_increment,json=miningIncrement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mining_increment" yaml:"mining_increment"`
-	WindowShort             uint64                                 `protobuf:"varint,5,opt,name=window_short,json=windowShort,proto3" json:"window_short,omitempty" yaml:"window_short"`
-	WindowLong              uint64                                 `protobuf:"varint,6,opt,name=window_long,json=windowLong,proto3" json:"window_long,omitempty" yaml:"window_long"`
-	WindowProbation         uint64                                 `protobuf:"varint,7,opt,name=window_probation,json=windowProbation,proto3" json:"window_probation,omitempty" yaml:"window_probation"`
+	TaxPolicy                       PolicyConstraints                           `protobuf:"bytes,1,opt,name=tax_policy,json=taxPolicy,proto3" json:"tax_policy" yaml:"tax_policy"`
+	RewardPolicy                    PolicyConstraints                           `protobuf:"bytes,2,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy" yaml:"reward_policy"`
+	SeigniorageBurdenTarget         github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,3,opt,name=seigniorage_burden_target,json=seigniorageBurdenTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_burden_target" yaml:"seigniorage_burden_target"`
+	MiningIncrement                 github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,4,opt,name=mining_increment,json=miningIncrement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mining_increment" yaml:"mining_increment"`
+	WindowShort                     uint64                                      `protobuf:"varint,5,opt,name=window_short,json=windowShort,proto3" json:"window_short,omitempty" yaml:"window_short"`
+	WindowLong                      uint64                                      `protobuf:"varint,6,opt,name=window_long,json=windowLong,proto3" json:"window_long,omitempty" yaml:"window_long"`
+	WindowProbation                 uint64                                      `protobuf:"varint,7,opt,name=window_probation,json=windowProbation,proto3" json:"window_probation,omitempty" yaml:"window_probation"`
+	PaymentProcessorRebateTiers     RebateTiers                                 `protobuf:"bytes,8,rep,name=payment_processor_rebate_tiers,json=paymentProcessorRebateTiers,proto3,castrepeated=RebateTiers" json:"payment_processor_rebate_tiers" yaml:"payment_processor_rebate_tiers"`
+	MinBaseGasPrices                github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=min_base_gas_prices,json=minBaseGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_base_gas_prices" yaml:"min_base_gas_prices"`
+	TargetBlockGas                  uint64                                      `protobuf:"varint,10,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	BaseGasPriceChangeRateMax       github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,11,opt,name=base_gas_price_change_rate_max,json=baseGasPriceChangeRateMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_gas_price_change_rate_max" yaml:"base_gas_price_change_rate_max"`
+	PaymentProcessorRegistrationFee github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,12,rep,name=payment_processor_registration_fee,json=paymentProcessorRegistrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payment_processor_registration_fee" yaml:"payment_processor_registration_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -103,6 +108,34 @@ func (m *Params) GetWindowProbation() uint64 {
 	return 0
 }
 
+func (m *Params) GetPaymentProcessorRebateTiers() RebateTiers {
+	if m != nil {
+		return m.PaymentProcessorRebateTiers
+	}
+	return nil
+}
+
+func (m *Params) GetMinBaseGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinBaseGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetPaymentProcessorRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PaymentProcessorRegistrationFee
+	}
+	return nil
+}
+
 // PolicyConstraints - defines policy constraints can be applied in tax & reward policies
 type PolicyConstraints struct {
 	RateMin       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate_min,json=rateMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_min" yaml:"rate_min"`
@@ -150,6 +183,46 @@ func (m *PolicyConstraints) GetCap() types.Coin {
 	return types.Coin{}
 }
 
+// RebateTier defines the portion of the epoch tax paid by a registered
+// payment processor that is refunded once the processor's tax, aligned
+// to the tax policy cap denom, reaches the threshold
+type RebateTier struct {
+	Threshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold" yaml:"threshold"`
+	Rate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+}
+
+func (m *RebateTier) Reset()      { *m = RebateTier{} }
+func (*RebateTier) ProtoMessage() {}
+func (*RebateTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{2}
+}
+func (m *RebateTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebateTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebateTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebateTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebateTier.Merge(m, src)
+}
+func (m *RebateTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebateTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebateTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebateTier proto.InternalMessageInfo
+
 // EpochTaxProceeds represents the tax amount
 // collected at the current epoch
 type EpochTaxProceeds struct {
@@ -160,7 +233,7 @@ func (m *EpochTaxProceeds) Reset()         { *m = EpochTaxProceeds{} }
 func (m *EpochTaxProceeds) String() string { return proto.CompactTextString(m) }
 func (*EpochTaxProceeds) ProtoMessage()    {}
 func (*EpochTaxProceeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{2}
+	return fileDescriptor_353bb3a9c554268e, []int{3}
 }
 func (m *EpochTaxProceeds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +279,7 @@ func (m *EpochInitialIssuance) Reset()         { *m = EpochInitialIssuance{} }
 func (m *EpochInitialIssuance) String() string { return proto.CompactTextString(m) }
 func (*EpochInitialIssuance) ProtoMessage()    {}
 func (*EpochInitialIssuance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{3}
+	return fileDescriptor_353bb3a9c554268e, []int{4}
 }
 func (m *EpochInitialIssuance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,11 +315,59 @@ func (m *EpochInitialIssuance) GetIssuance() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+// BaseGasPrices represents the on-chain base gas prices
+// of the next block
+type BaseGasPrices struct {
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
+}
+
+func (m *BaseGasPrices) Reset()         { *m = BaseGasPrices{} }
+func (m *BaseGasPrices) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPrices) ProtoMessage()    {}
+func (*BaseGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{5}
+}
+func (m *BaseGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPrices.Merge(m, src)
+}
+func (m *BaseGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseGasPrices proto.InternalMessageInfo
+
+func (m *BaseGasPrices) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "iq.treasury.v1beta1.Params")
 	proto.RegisterType((*PolicyConstraints)(nil), "iq.treasury.v1beta1.PolicyConstraints")
+	proto.RegisterType((*RebateTier)(nil), "iq.treasury.v1beta1.RebateTier")
 	proto.RegisterType((*EpochTaxProceeds)(nil), "iq.treasury.v1beta1.EpochTaxProceeds")
 	proto.RegisterType((*EpochInitialIssuance)(nil), "iq.treasury.v1beta1.EpochInitialIssuance")
+	proto.RegisterType((*BaseGasPrices)(nil), "iq.treasury.v1beta1.BaseGasPrices")
 }
 
 func init() {
@@ -254,51 +375,70 @@ func init() {
 }
 
 var fileDescriptor_353bb3a9c554268e = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4b, 0x6f, 0x13, 0x3b,
-	0x14, 0xc7, 0x33, 0x37, 0x7d, 0x3a, 0xad, 0xd2, 0x4e, 0xab, 0x36, 0xed, 0xbd, 0xca, 0x44, 0x96,
-	0xee, 0x55, 0xae, 0x44, 0x67, 0xd4, 0xb2, 0x40, 0xea, 0x06, 0x91, 0x42, 0x21, 0x12, 0x48, 0xd1,
-	0xd0, 0x15, 0x42, 0x1a, 0x39, 0x13, 0x6b, 0x62, 0x91, 0xb1, 0x47, 0xb6, 0x43, 0x27, 0xec, 0xd9,
-	0x21, 0x84, 0x58, 0x21, 0x56, 0xdd, 0xc2, 0x27, 0xe9, 0xb2, 0x4b, 0xc4, 0x22, 0xa0, 0x76, 0xc3,
-	0x3a, 0x9f, 0x00, 0x8d, 0xed, 0x3c, 0x5a, 0x9e, 0x11, 0xab, 0xf8, 0xbc, 0x7e, 0xe7, 0xef, 0xf1,
-	0xb1, 0x03, 0xfe, 0x95, 0x98, 0x73, 0xe4, 0x49, 0x8e, 0x91, 0xe8, 0xf2, 0x9e, 0xf7, 0x74, 0xb7,
-	0x89, 0x25, 0xda, 0x1d, 0x39, 0xdc, 0x84, 0x33, 0xc9, 0xec, 0x0d, 0x95, 0xe6, 0x8e, 0xbc, 0x26,
-	0x6d, 0x7b, 0x3d, 0x62, 0x11, 0x53, 0x29, 0x5e, 0xb6, 0xd2, 0xd9, 0xdb, 0xe5, 0x90, 0x89, 0x98,
-	0x09, 0xaf, 0x89, 0x04, 0x1e, 0x11, 0x43, 0x46, 0xa8, 0x8e, 0xc3, 0x77, 0xb3, 0x60, 0xae, 0x81,
-	0x38, 0x8a, 0x85, 0x1d, 0x02, 0x20, 0x51, 0x1a, 0x24, 0xac, 0x43, 0xc2, 0x5e, 0xc9, 0xaa, 0x58,
-	0xd5, 0xc2, 0xde, 0xff, 0xee, 0xf7, 0xbb, 0xb9, 0x0d, 0x95, 0x75, 0xc0, 0xa8, 0x90, 0x1c, 0x11,
-	0x2a, 0x45, 0x6d, 0xeb, 0xb4, 0xef, 0xe4, 0x06, 0x7d, 0x67, 0xb5, 0x87, 0xe2, 0xce, 0x3e, 0x1c,
-	0xa3, 0xa0, 0xbf, 0x28, 0x51, 0xaa, 0x0b, 0xec, 0x0e, 0x58, 0xe6, 0xf8, 0x18, 0xf1, 0xd6, 0xb0,
-	0xcf, 0x5f, 0xd3, 0xf6, 0xf9, 0xc7, 0xf4, 0x59, 0xd7, 0x7d, 0x2e, 0xd1, 0xa0, 0xbf, 0xa4, 0x6d,
-	0xd3, 0xed, 0xa5, 0x05, 0xb6, 0x04, 0x26, 0x11, 0x25, 0x8c, 0xa3, 0x08, 0x07, 0xcd, 0x2e, 0x6f,
-	0x61, 0x1a, 0x48, 0xc4, 0x23, 0x2c, 0x4b, 0xf9, 0x8a, 0x55, 0x5d, 0xac, 0xf9, 0x19, 0xef, 0x63,
-	0xdf, 0xf9, 0x2f, 0x22, 0xb2, 0xdd, 0x6d, 0xba, 0x21, 0x8b, 0x3d, 0xf3, 0xd1, 0xf4, 0xcf, 0x8e,
-	0x68, 0x3d, 0xf1, 0x64, 0x2f, 0xc1, 0xc2, 0xbd, 0x8d, 0xc3, 0x41, 0xdf, 0xa9, 0xe8, 0xce, 0x3f,
-	0x04, 0x43, 0x7f, 0x73, 0x22, 0x56, 0x53, 0xa1, 0x23, 0x15, 0xb1, 0x25, 0x58, 0x89, 0x09, 0x25,
-	0x34, 0x0a, 0x08, 0x0d, 0x39, 0x8e, 0x31, 0x95, 0xa5, 0x19, 0x25, 0xa3, 0x3e, 0xb5, 0x8c, 0x4d,
-	0x2d, 0xe3, 0x2a, 0x0f, 0xfa, 0x45, 0xed, 0xaa, 0x0f, 0x3d, 0xf6, 0x3e, 0x58, 0x3a, 0x26, 0xb4,
-	0xc5, 0x8e, 0x03, 0xd1, 0x66, 0x5c, 0x96, 0x66, 0x2b, 0x56, 0x75, 0xa6, 0xb6, 0x39, 0xe8, 0x3b,
-	0x6b, 0x9a, 0x31, 0x19, 0x85, 0x7e, 0x41, 0x9b, 0x0f, 0x33, 0xcb, 0xbe, 0x01, 0x8c, 0x19, 0x74,
-	0x18, 0x8d, 0x4a, 0x73, 0xaa, 0x74, 0x63, 0xd0, 0x77, 0xec, 0x4b, 0xa5, 0x59, 0x10, 0xfa, 0x40,
-	0x5b, 0xf7, 0x19, 0x8d, 0xec, 0x43, 0xb0, 0x62, 0x62, 0x09, 0x67, 0x4d, 0x24, 0x09, 0xa3, 0xa5,
-	0x79, 0x55, 0xfd, 0xf7, 0x58, 0xfc, 0xd5, 0x0c, 0xe8, 0x17, 0xb5, 0xab, 0x31, 0xf4, 0xec, 0x2f,
-	0xbc, 0x39, 0x71, 0x72, 0x5f, 0x4e, 0x1c, 0x0b, 0xbe, 0xc8, 0x83, 0xd5, 0x6f, 0xe6, 0xc1, 0x7e,
-	0x0c, 0x16, 0x38, 0x92, 0x38, 0x88, 0x09, 0x55, 0x43, 0xbb, 0x58, 0xbb, 0x35, 0xf5, 0xa7, 0x2c,
-	0x9a, 0x59, 0x32, 0x1c, 0xe8, 0xcf, 0x67, 0xcb, 0x07, 0x84, 0x8e, 0xe9, 0x28, 0x55, 0xa3, 0xfa,
-	0xc7, 0x74, 0x94, 0x0e, 0xe9, 0x28, 0xb5, 0x6f, 0x82, 0x7c, 0x88, 0x12, 0x35, 0x88, 0x85, 0xbd,
-	0x2d, 0x57, 0xd7, 0xbb, 0xd9, 0x5d, 0x1d, 0x5d, 0x80, 0x03, 0x46, 0x68, 0xcd, 0x36, 0x33, 0x0f,
-	0x34, 0x29, 0x44, 0x09, 0xf4, 0xb3, 0x4a, 0x3b, 0x01, 0xc5, 0xb0, 0x8d, 0x68, 0x84, 0x83, 0x91,
-	0x4a, 0x3d, 0x4e, 0xf7, 0xa6, 0x56, 0xb9, 0x61, 0xd8, 0x97, 0x71, 0xd0, 0x5f, 0xd6, 0x1e, 0x5f,
-	0x4b, 0x9e, 0x38, 0x8e, 0xb7, 0x16, 0x58, 0xb9, 0x93, 0xb0, 0xb0, 0x7d, 0x84, 0xd2, 0x06, 0x67,
-	0x21, 0xc6, 0x2d, 0x61, 0x3f, 0xb7, 0xc0, 0x92, 0xba, 0xfa, 0xc6, 0x51, 0xb2, 0x2a, 0xf9, 0x9f,
-	0xef, 0xed, 0xae, 0xd9, 0xdb, 0xda, 0xc4, 0xbb, 0x61, 0x8a, 0xe1, 0xfb, 0x4f, 0x4e, 0xf5, 0x37,
-	0x36, 0x90, 0x71, 0x84, 0x5f, 0x90, 0x63, 0x1d, 0xf0, 0xb5, 0x05, 0xd6, 0x95, 0xb8, 0x3a, 0x25,
-	0x92, 0xa0, 0x4e, 0x5d, 0x88, 0x2e, 0xa2, 0x21, 0xb6, 0x9f, 0x81, 0x05, 0x62, 0xd6, 0xbf, 0xd6,
-	0x76, 0x60, 0xb4, 0x99, 0x13, 0x1c, 0x16, 0x4e, 0xa7, 0x6b, 0xd4, 0xaf, 0x76, 0x78, 0x7a, 0x5e,
-	0xb6, 0xce, 0xce, 0xcb, 0xd6, 0xe7, 0xf3, 0xb2, 0xf5, 0xea, 0xa2, 0x9c, 0x3b, 0xbb, 0x28, 0xe7,
-	0x3e, 0x5c, 0x94, 0x73, 0x8f, 0xae, 0x4d, 0xd0, 0xd4, 0x4b, 0xb8, 0x13, 0x33, 0x8a, 0x7b, 0x5e,
-	0xc8, 0x38, 0xf6, 0xd2, 0xf1, 0x7f, 0x82, 0xe2, 0x36, 0xe7, 0xd4, 0xdb, 0x7d, 0xfd, 0x6b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x83, 0xdf, 0x3e, 0x57, 0x32, 0x06, 0x00, 0x00,
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6b, 0x1b, 0x47,
+	0x14, 0xf6, 0xc4, 0xa9, 0x63, 0x8d, 0xec, 0xda, 0x19, 0x9b, 0x78, 0xed, 0x04, 0xad, 0x59, 0x68,
+	0x70, 0x29, 0x91, 0x48, 0x7a, 0x28, 0x98, 0x96, 0xd2, 0x75, 0x1c, 0xc7, 0xd0, 0x80, 0xb2, 0x35,
+	0x14, 0x4a, 0xcb, 0x66, 0x76, 0x35, 0x5d, 0x0d, 0x91, 0x66, 0xd6, 0x33, 0xe3, 0x5a, 0xea, 0x3d,
+	0xb7, 0x52, 0x4a, 0xa1, 0xb4, 0xe4, 0x50, 0x42, 0x8f, 0xfd, 0x3f, 0x0a, 0x81, 0x5e, 0x72, 0x2c,
+	0x3d, 0xa8, 0xc5, 0xbe, 0xf4, 0xac, 0xbf, 0xa0, 0xcc, 0x0f, 0x49, 0x2b, 0xc5, 0x71, 0x2c, 0xda,
+	0x93, 0x66, 0xde, 0x8f, 0xef, 0x7d, 0x33, 0xdf, 0x9b, 0xa7, 0x85, 0x01, 0x3d, 0xac, 0x29, 0x41,
+	0xb0, 0x3c, 0x12, 0xdd, 0xda, 0x57, 0xb7, 0x13, 0xa2, 0xf0, 0xed, 0xa1, 0xa1, 0x9a, 0x0b, 0xae,
+	0x38, 0x5a, 0xa1, 0x87, 0xd5, 0xa1, 0xc9, 0xc5, 0x6c, 0xac, 0x66, 0x3c, 0xe3, 0xc6, 0x5f, 0xd3,
+	0x2b, 0x1b, 0xba, 0x51, 0x49, 0xb9, 0x6c, 0x73, 0x59, 0x4b, 0xb0, 0x24, 0x43, 0xb8, 0x94, 0x53,
+	0x66, 0xfd, 0xc1, 0x0f, 0x65, 0x38, 0x57, 0xc7, 0x02, 0xb7, 0x25, 0x7a, 0x04, 0xa1, 0xc2, 0x9d,
+	0x38, 0xe7, 0x2d, 0x9a, 0x76, 0x3d, 0xb0, 0x09, 0xb6, 0xca, 0x77, 0x6e, 0x56, 0xcf, 0x28, 0x55,
+	0xad, 0x9b, 0x90, 0x1d, 0xce, 0xa4, 0x12, 0x98, 0x32, 0x25, 0xc3, 0xf5, 0xe7, 0x3d, 0x7f, 0xa6,
+	0xdf, 0xf3, 0xaf, 0x76, 0x71, 0xbb, 0xb5, 0x1d, 0x8c, 0x70, 0x82, 0xa8, 0xa4, 0x70, 0xc7, 0x26,
+	0x20, 0x0a, 0x17, 0x05, 0x39, 0xc6, 0xa2, 0x31, 0x28, 0x72, 0x69, 0xaa, 0x22, 0x37, 0x5c, 0x91,
+	0x55, 0x5b, 0x64, 0x0c, 0x2a, 0x88, 0x16, 0xec, 0xde, 0x95, 0xfa, 0x16, 0xc0, 0x75, 0x49, 0x68,
+	0xc6, 0x28, 0x17, 0x38, 0x23, 0x71, 0x72, 0x24, 0x1a, 0x84, 0xc5, 0x0a, 0x8b, 0x8c, 0x28, 0x6f,
+	0x76, 0x13, 0x6c, 0x95, 0xc2, 0x48, 0xe3, 0xfd, 0xd9, 0xf3, 0x6f, 0x66, 0x54, 0x35, 0x8f, 0x92,
+	0x6a, 0xca, 0xdb, 0x35, 0x77, 0x5d, 0xf6, 0xe7, 0x96, 0x6c, 0x3c, 0xae, 0xa9, 0x6e, 0x4e, 0x64,
+	0xf5, 0x2e, 0x49, 0xfb, 0x3d, 0x7f, 0xd3, 0x56, 0x7e, 0x25, 0x70, 0x10, 0xad, 0x15, 0x7c, 0xa1,
+	0x71, 0x1d, 0x18, 0x0f, 0x52, 0x70, 0xb9, 0x4d, 0x19, 0x65, 0x59, 0x4c, 0x59, 0x2a, 0x48, 0x9b,
+	0x30, 0xe5, 0x5d, 0x36, 0x34, 0xf6, 0xa7, 0xa6, 0xb1, 0x66, 0x69, 0x4c, 0xe2, 0x05, 0xd1, 0x92,
+	0x35, 0xed, 0x0f, 0x2c, 0x68, 0x1b, 0x2e, 0x1c, 0x53, 0xd6, 0xe0, 0xc7, 0xb1, 0x6c, 0x72, 0xa1,
+	0xbc, 0x37, 0x36, 0xc1, 0xd6, 0xe5, 0x70, 0xad, 0xdf, 0xf3, 0x57, 0x2c, 0x46, 0xd1, 0x1b, 0x44,
+	0x65, 0xbb, 0xfd, 0x44, 0xef, 0xd0, 0x7b, 0xd0, 0x6d, 0xe3, 0x16, 0x67, 0x99, 0x37, 0x67, 0x52,
+	0xaf, 0xf5, 0x7b, 0x3e, 0x1a, 0x4b, 0xd5, 0xce, 0x20, 0x82, 0x76, 0xf7, 0x31, 0x67, 0x19, 0xba,
+	0x07, 0x97, 0x9d, 0x2f, 0x17, 0x3c, 0xc1, 0x8a, 0x72, 0xe6, 0x5d, 0x31, 0xd9, 0xd7, 0x47, 0xe4,
+	0x27, 0x23, 0x82, 0x68, 0xc9, 0x9a, 0xea, 0x03, 0x0b, 0xfa, 0x05, 0xc0, 0x4a, 0x8e, 0xbb, 0xfa,
+	0x20, 0x3a, 0x2e, 0x25, 0x52, 0x72, 0x11, 0x0b, 0x92, 0x60, 0x45, 0x62, 0x45, 0x89, 0x90, 0xde,
+	0xfc, 0xe6, 0xec, 0x56, 0xf9, 0x8e, 0x7f, 0x66, 0x03, 0x45, 0x26, 0xf0, 0x80, 0x12, 0x11, 0xbe,
+	0xef, 0x3a, 0xe7, 0x2d, 0x5b, 0xfb, 0x7c, 0xd0, 0xe0, 0xd7, 0xbf, 0xfc, 0xf2, 0x28, 0x59, 0x46,
+	0xd7, 0x5d, 0x7c, 0x7d, 0x10, 0x5e, 0x70, 0xa2, 0x9f, 0x01, 0x5c, 0x69, 0x53, 0x16, 0xeb, 0x07,
+	0x16, 0x67, 0x58, 0xc6, 0xb9, 0xa0, 0x29, 0x91, 0x5e, 0xc9, 0x30, 0xbb, 0x51, 0xb5, 0x12, 0x56,
+	0xb5, 0x7b, 0xc8, 0xec, 0x2e, 0x49, 0x77, 0x38, 0x65, 0xe1, 0x43, 0x47, 0x6b, 0x63, 0xa8, 0xe7,
+	0x24, 0x8c, 0xe6, 0xf2, 0xce, 0xc5, 0xfa, 0x42, 0x23, 0xca, 0x48, 0x37, 0x59, 0x88, 0x25, 0xd9,
+	0xc3, 0xb2, 0x6e, 0x10, 0xd0, 0x2e, 0x5c, 0xb6, 0xcd, 0x19, 0x27, 0x2d, 0x9e, 0x3e, 0xd6, 0xe0,
+	0x1e, 0x9c, 0x54, 0x63, 0x32, 0x22, 0x88, 0xde, 0xb4, 0xa6, 0x50, 0x5b, 0xf6, 0xb0, 0x44, 0x4f,
+	0x01, 0xac, 0x8c, 0x93, 0x8b, 0xd3, 0x26, 0x66, 0x19, 0x89, 0x85, 0xbe, 0xb9, 0x36, 0xee, 0x78,
+	0x65, 0xd3, 0xce, 0x9f, 0x4e, 0xdd, 0xce, 0x4e, 0x95, 0xf3, 0xd1, 0x83, 0x68, 0x3d, 0x29, 0x9c,
+	0x6b, 0xc7, 0x78, 0x23, 0xac, 0xc8, 0x03, 0xdc, 0x41, 0xbf, 0x01, 0x78, 0xa6, 0xa8, 0x19, 0xd5,
+	0xc3, 0x43, 0x37, 0x53, 0xfc, 0x25, 0x21, 0xde, 0x82, 0xd1, 0x64, 0xfd, 0x4c, 0x4d, 0x8c, 0x20,
+	0x5f, 0x38, 0x41, 0xde, 0x7e, 0x75, 0x9f, 0x8c, 0x43, 0x6a, 0x7d, 0xb6, 0x2e, 0x70, 0x50, 0x2b,
+	0x8e, 0xff, 0x72, 0x23, 0x8d, 0xe0, 0xee, 0x11, 0xb2, 0x3d, 0xff, 0xd3, 0x33, 0x7f, 0xe6, 0x9f,
+	0x67, 0x3e, 0x08, 0xbe, 0x99, 0x85, 0x57, 0x5f, 0x9a, 0x80, 0xe8, 0x73, 0x38, 0x6f, 0xef, 0x83,
+	0x32, 0x33, 0xa0, 0x4b, 0xe1, 0x47, 0x53, 0xdf, 0xf6, 0x92, 0x9b, 0x9e, 0x0e, 0x27, 0x88, 0xae,
+	0xe8, 0xe5, 0x03, 0xca, 0x46, 0xe8, 0xb8, 0xe3, 0x5d, 0xfa, 0x3f, 0xd0, 0x71, 0x67, 0x80, 0x8e,
+	0x3b, 0xe8, 0x43, 0x38, 0x9b, 0xe2, 0xdc, 0x8c, 0xde, 0x73, 0x35, 0x40, 0x4e, 0x03, 0x68, 0x91,
+	0x52, 0x9c, 0x07, 0x91, 0xce, 0x44, 0x39, 0x5c, 0x9a, 0xec, 0x38, 0x3b, 0x40, 0xef, 0x4f, 0xcd,
+	0xf2, 0x9a, 0xc3, 0x9e, 0x6c, 0xb1, 0xc5, 0xb4, 0xd8, 0x56, 0x05, 0x39, 0x7e, 0x07, 0x10, 0x8e,
+	0x5e, 0x3d, 0x7a, 0x04, 0x4b, 0xaa, 0x29, 0x88, 0x6c, 0xf2, 0x56, 0xc3, 0x09, 0x11, 0x4e, 0x41,
+	0x62, 0x9f, 0xa9, 0x7e, 0xcf, 0x5f, 0x76, 0x4f, 0x6f, 0x00, 0xa4, 0xff, 0x2a, 0x07, 0x6b, 0xf4,
+	0x10, 0x5e, 0xd6, 0xb4, 0x9c, 0x0e, 0x1f, 0x4c, 0x7d, 0xc2, 0xf2, 0x48, 0x87, 0x20, 0x32, 0x50,
+	0x85, 0xd3, 0x3c, 0x05, 0x70, 0x79, 0x37, 0xe7, 0x69, 0xf3, 0x00, 0x77, 0x4c, 0x2f, 0x92, 0x86,
+	0x44, 0x4f, 0x00, 0x5c, 0x30, 0xff, 0xdb, 0xce, 0xe0, 0x81, 0xd7, 0xbd, 0x96, 0x3d, 0xa7, 0xd4,
+	0x4a, 0xe1, 0x4f, 0xdf, 0x25, 0x4f, 0xf7, 0x2e, 0xca, 0x6a, 0xc4, 0x23, 0xf8, 0x1e, 0xc0, 0x55,
+	0x43, 0x6e, 0x9f, 0x51, 0x45, 0x71, 0x6b, 0x5f, 0xca, 0x23, 0xcc, 0x52, 0x82, 0xbe, 0x86, 0xf3,
+	0xd4, 0xad, 0x5f, 0xcf, 0x6d, 0xc7, 0x71, 0x73, 0xfd, 0x38, 0x48, 0x9c, 0x8e, 0xd7, 0xb0, 0x5e,
+	0xf0, 0x23, 0x80, 0x8b, 0xe3, 0x63, 0xf5, 0x09, 0x80, 0xb0, 0x30, 0xee, 0xc1, 0x05, 0xc6, 0xfd,
+	0xfd, 0xf1, 0x8f, 0xa4, 0xff, 0x30, 0xe5, 0x4b, 0xd9, 0x80, 0x47, 0xb8, 0xfb, 0xfc, 0xa4, 0x02,
+	0x5e, 0x9c, 0x54, 0xc0, 0xdf, 0x27, 0x15, 0xf0, 0xdd, 0x69, 0x65, 0xe6, 0xc5, 0x69, 0x65, 0xe6,
+	0x8f, 0xd3, 0xca, 0xcc, 0x67, 0x45, 0xc4, 0x84, 0xaa, 0x63, 0x92, 0xc8, 0x1a, 0x3d, 0xbc, 0x95,
+	0x72, 0x41, 0x6a, 0x9d, 0xd1, 0x37, 0xa6, 0x81, 0x4e, 0xe6, 0xcc, 0xe7, 0xe0, 0xbb, 0xff, 0x0e,
+	0x00, 0xaf, 0xd5, 0xb0, 0x3e, 0x7f, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -341,6 +481,36 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WindowProbation != that1.WindowProbation {
 		return false
 	}
+	if len(this.PaymentProcessorRebateTiers) != len(that1.PaymentProcessorRebateTiers) {
+		return false
+	}
+	for i := range this.PaymentProcessorRebateTiers {
+		if !this.PaymentProcessorRebateTiers[i].Equal(&that1.PaymentProcessorRebateTiers[i]) {
+			return false
+		}
+	}
+	if len(this.MinBaseGasPrices) != len(that1.MinBaseGasPrices) {
+		return false
+	}
+	for i := range this.MinBaseGasPrices {
+		if !this.MinBaseGasPrices[i].Equal(&that1.MinBaseGasPrices[i]) {
+			return false
+		}
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if !this.BaseGasPriceChangeRateMax.Equal(that1.BaseGasPriceChangeRateMax) {
+		return false
+	}
+	if len(this.PaymentProcessorRegistrationFee) != len(that1.PaymentProcessorRegistrationFee) {
+		return false
+	}
+	for i := range this.PaymentProcessorRegistrationFee {
+		if !this.PaymentProcessorRegistrationFee[i].Equal(&that1.PaymentProcessorRegistrationFee[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PolicyConstraints) Equal(that interface{}) bool {
@@ -376,6 +546,33 @@ func (this *PolicyConstraints) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RebateTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RebateTier)
+	if !ok {
+		that2, ok := that.(RebateTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -396,6 +593,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PaymentProcessorRegistrationFee) > 0 {
+		for iNdEx := len(m.PaymentProcessorRegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentProcessorRegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.BaseGasPriceChangeRateMax.Size()
+		i -= size
+		if _, err := m.BaseGasPriceChangeRateMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MinBaseGasPrices) > 0 {
+		for iNdEx := len(m.MinBaseGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBaseGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PaymentProcessorRebateTiers) > 0 {
+		for iNdEx := len(m.PaymentProcessorRebateTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentProcessorRebateTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.WindowProbation != 0 {
 		i = encodeVarintTreasury(dAtA, i, uint64(m.WindowProbation))
 		i--
@@ -517,6 +771,49 @@ func (m *PolicyConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RebateTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebateTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebateTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EpochTaxProceeds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -591,6 +888,43 @@ func (m *EpochInitialIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BaseGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTreasury(v)
 	base := offset
@@ -625,6 +959,29 @@ func (m *Params) Size() (n int) {
 	if m.WindowProbation != 0 {
 		n += 1 + sovTreasury(uint64(m.WindowProbation))
 	}
+	if len(m.PaymentProcessorRebateTiers) > 0 {
+		for _, e := range m.PaymentProcessorRebateTiers {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if len(m.MinBaseGasPrices) > 0 {
+		for _, e := range m.MinBaseGasPrices {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovTreasury(uint64(m.TargetBlockGas))
+	}
+	l = m.BaseGasPriceChangeRateMax.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if len(m.PaymentProcessorRegistrationFee) > 0 {
+		for _, e := range m.PaymentProcessorRegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -645,6 +1002,19 @@ func (m *PolicyConstraints) Size() (n int) {
 	return n
 }
 
+func (m *RebateTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
 func (m *EpochTaxProceeds) Size() (n int) {
 	if m == nil {
 		return 0
@@ -675,6 +1045,21 @@ func (m *EpochInitialIssuance) Size() (n int) {
 	return n
 }
 
+func (m *BaseGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTreasury(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -901,8 +1286,163 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProcessorRebateTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentProcessorRebateTiers = append(m.PaymentProcessorRebateTiers, RebateTier{})
+			if err := m.PaymentProcessorRebateTiers[len(m.PaymentProcessorRebateTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBaseGasPrices = append(m.MinBaseGasPrices, types.DecCoin{})
+			if err := m.MinBaseGasPrices[len(m.MinBaseGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceChangeRateMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPriceChangeRateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProcessorRegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentProcessorRegistrationFee = append(m.PaymentProcessorRegistrationFee, types.Coin{})
+			if err := m.PaymentProcessorRegistrationFee[len(m.PaymentProcessorRegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTreasury(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -1107,6 +1647,124 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RebateTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebateTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebateTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1275,6 +1933,90 @@ func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BaseGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTreasury(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: iq/treasury/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterPaymentProcessor represents a message to register the sender
+// as a payment processor.
+type MsgRegisterPaymentProcessor struct {
+	Processor string `protobuf:"bytes,1,opt,name=processor,proto3" json:"processor,omitempty" yaml:"processor"`
+}
+
+func (m *MsgRegisterPaymentProcessor) Reset()         { *m = MsgRegisterPaymentProcessor{} }
+func (m *MsgRegisterPaymentProcessor) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPaymentProcessor) ProtoMessage()    {}
+func (*MsgRegisterPaymentProcessor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d8bdd56fabe0eb, []int{0}
+}
+func (m *MsgRegisterPaymentProcessor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPaymentProcessor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPaymentProcessor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPaymentProcessor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPaymentProcessor.Merge(m, src)
+}
+func (m *MsgRegisterPaymentProcessor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPaymentProcessor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPaymentProcessor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPaymentProcessor proto.InternalMessageInfo
+
+// MsgRegisterPaymentProcessorResponse defines the Msg/RegisterPaymentProcessor response type.
+type MsgRegisterPaymentProcessorResponse struct {
+}
+
+func (m *MsgRegisterPaymentProcessorResponse) Reset()         { *m = MsgRegisterPaymentProcessorResponse{} }
+func (m *MsgRegisterPaymentProcessorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPaymentProcessorResponse) ProtoMessage()    {}
+func (*MsgRegisterPaymentProcessorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d8bdd56fabe0eb, []int{1}
+}
+func (m *MsgRegisterPaymentProcessorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPaymentProcessorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPaymentProcessorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPaymentProcessorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPaymentProcessorResponse.Merge(m, src)
+}
+func (m *MsgRegisterPaymentProcessorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPaymentProcessorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPaymentProcessorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPaymentProcessorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterPaymentProcessor)(nil), "iq.treasury.v1beta1.MsgRegisterPaymentProcessor")
+	proto.RegisterType((*MsgRegisterPaymentProcessorResponse)(nil), "iq.treasury.v1beta1.MsgRegisterPaymentProcessorResponse")
+}
+
+func init() { proto.RegisterFile("iq/treasury/v1beta1/tx.proto", fileDescriptor_55d8bdd56fabe0eb) }
+
+var fileDescriptor_55d8bdd56fabe0eb = []byte{
+	// 260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2c, 0xd4, 0x2f,
+	0x29, 0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
+	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xce, 0x2c, 0xd4, 0x83, 0xc9, 0xea,
+	0x41, 0x65, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xf2, 0xfa, 0x20, 0x16, 0x44, 0xa9, 0x52,
+	0x34, 0x97, 0xb4, 0x6f, 0x71, 0x7a, 0x50, 0x6a, 0x7a, 0x66, 0x71, 0x49, 0x6a, 0x51, 0x40, 0x62,
+	0x65, 0x6e, 0x6a, 0x5e, 0x49, 0x40, 0x51, 0x7e, 0x72, 0x6a, 0x71, 0x71, 0x7e, 0x91, 0x90, 0x11,
+	0x17, 0x67, 0x01, 0x8c, 0x23, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf2, 0xe9, 0x9e, 0xbc,
+	0x40, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x5c, 0x4a, 0x29, 0x08, 0xa1, 0xcc, 0x8a, 0xa3, 0x63,
+	0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x0c, 0x4a, 0xaa, 0x5c, 0xca, 0x78, 0x0c, 0x0f, 0x4a, 0x2d,
+	0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0xea, 0x63, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x6a, 0x63,
+	0xe4, 0x92, 0xc0, 0xe9, 0x12, 0x03, 0x3d, 0x2c, 0x9e, 0xd2, 0xc3, 0x63, 0xbc, 0x94, 0x05, 0xa9,
+	0x3a, 0x60, 0x0e, 0x72, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
+	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
+	0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa4, 0xcc, 0x92, 0xf2,
+	0xd4, 0xa4, 0x62, 0xfd, 0xcc, 0x42, 0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0xfd, 0x0a, 0x44, 0x8c, 0x94,
+	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x83, 0xd8, 0x18, 0x30, 0x00, 0xed, 0xf8, 0xac, 0x85,
+	0xad, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterPaymentProcessor defines a method for registering an account
+	// as a payment processor eligible for epoch tax rebates.
+	RegisterPaymentProcessor(ctx context.Context, in *MsgRegisterPaymentProcessor, opts ...grpc.CallOption) (*MsgRegisterPaymentProcessorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterPaymentProcessor(ctx context.Context, in *MsgRegisterPaymentProcessor, opts ...grpc.CallOption) (*MsgRegisterPaymentProcessorResponse, error) {
+	out := new(MsgRegisterPaymentProcessorResponse)
+	err := c.cc.Invoke(ctx, "/iq.treasury.v1beta1.Msg/RegisterPaymentProcessor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterPaymentProcessor defines a method for registering an account
+	// as a payment processor eligible for epoch tax rebates.
+	RegisterPaymentProcessor(context.Context, *MsgRegisterPaymentProcessor) (*MsgRegisterPaymentProcessorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterPaymentProcessor(ctx context.Context, req *MsgRegisterPaymentProcessor) (*MsgRegisterPaymentProcessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPaymentProcessor not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterPaymentProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPaymentProcessor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPaymentProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.treasury.v1beta1.Msg/RegisterPaymentProcessor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPaymentProcessor(ctx, req.(*MsgRegisterPaymentProcessor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iq.treasury.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPaymentProcessor",
+			Handler:    _Msg_RegisterPaymentProcessor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iq/treasury/v1beta1/tx.proto",
+}
+
+func (m *MsgRegisterPaymentProcessor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPaymentProcessor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPaymentProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Processor) > 0 {
+		i -= len(m.Processor)
+		copy(dAtA[i:], m.Processor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Processor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPaymentProcessorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPaymentProcessorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPaymentProcessorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPaymentProcessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Processor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPaymentProcessorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterPaymentProcessor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPaymentProcessor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPaymentProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Processor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPaymentProcessorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPaymentProcessorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPaymentProcessorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -32,8 +32,11 @@ func (WasmQuerier) Query(_ sdk.Context, _ wasmvmtypes.QueryRequest) ([]byte, err
 
 // CosmosQuery contains various treasury queries
 type CosmosQuery struct {
-	TaxRate *struct{}                `json:"tax_rate,omitempty"`
-	TaxCap  *types.QueryTaxCapParams `json:"tax_cap,omitempty"`
+	TaxRate             *struct{}                `json:"tax_rate,omitempty"`
+	TaxCap              *types.QueryTaxCapParams `json:"tax_cap,omitempty"`
+	RewardWeight        *struct{}                `json:"reward_weight,omitempty"`
+	TaxProceeds         *struct{}                `json:"tax_proceeds,omitempty"`
+	SeigniorageProceeds *struct{}                `json:"seigniorage_proceeds,omitempty"`
 }
 
 // TaxRateQueryResponse - tax rate query response for wasm module
@@ -48,6 +51,23 @@ type TaxCapQueryResponse struct {
 	Cap string `json:"cap"`
 }
 
+// RewardWeightQueryResponse - reward weight query response for wasm module
+type RewardWeightQueryResponse struct {
+	// decimal string, eg "0.05"
+	Weight string `json:"weight"`
+}
+
+// TaxProceedsQueryResponse - tax proceeds of the current epoch query response for wasm module
+type TaxProceedsQueryResponse struct {
+	TaxProceeds wasmvmtypes.Coins `json:"tax_proceeds"`
+}
+
+// SeigniorageProceedsQueryResponse - seigniorage proceeds of the current epoch query response for wasm module
+type SeigniorageProceedsQueryResponse struct {
+	// uint128 string of ubiq, eg "1000000"
+	Amount string `json:"amount"`
+}
+
 // QueryCustom implements custom query interface
 func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 	var query CosmosQuery
@@ -65,6 +85,15 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 	} else if query.TaxCap != nil {
 		cap := querier.keeper.GetTaxCap(ctx, query.TaxCap.Denom)
 		bz, err = json.Marshal(TaxCapQueryResponse{Cap: cap.String()})
+	} else if query.RewardWeight != nil {
+		weight := querier.keeper.GetRewardWeight(ctx)
+		bz, err = json.Marshal(RewardWeightQueryResponse{Weight: weight.String()})
+	} else if query.TaxProceeds != nil {
+		proceeds := querier.keeper.PeekEpochTaxProceeds(ctx)
+		bz, err = json.Marshal(TaxProceedsQueryResponse{TaxProceeds: wasm.EncodeSdkCoins(proceeds)})
+	} else if query.SeigniorageProceeds != nil {
+		seigniorage := querier.keeper.PeekEpochSeigniorage(ctx)
+		bz, err = json.Marshal(SeigniorageProceedsQueryResponse{Amount: seigniorage.String()})
 	} else {
 		return nil, sdkerrors.ErrInvalidRequest
 	}
@@ -70,3 +70,72 @@ func TestQueryTaxCap(t *testing.T) {
 	require.NoError(t, json.Unmarshal(res, &taxCapResponse))
 	require.Equal(t, cap.String(), taxCapResponse.Cap)
 }
+
+func TestQueryRewardWeight(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	weight := sdk.NewDecWithPrec(5, 2) // 5%
+	input.TreasuryKeeper.SetRewardWeight(input.Ctx, weight)
+
+	querier := NewWasmQuerier(input.TreasuryKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		RewardWeight: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var rewardWeightResponse RewardWeightQueryResponse
+	require.NoError(t, json.Unmarshal(res, &rewardWeightResponse))
+	require.Equal(t, weight.String(), rewardWeightResponse.Weight)
+}
+
+func TestQueryTaxProceeds(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	proceeds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 1000), sdk.NewInt64Coin(core.MicroBUSDDenom, 2000))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, proceeds)
+
+	querier := NewWasmQuerier(input.TreasuryKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		TaxProceeds: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var taxProceedsResponse TaxProceedsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &taxProceedsResponse))
+	require.Equal(t, len(proceeds), len(taxProceedsResponse.TaxProceeds))
+	for i, coin := range proceeds {
+		require.Equal(t, coin.Denom, taxProceedsResponse.TaxProceeds[i].Denom)
+		require.Equal(t, coin.Amount.String(), taxProceedsResponse.TaxProceeds[i].Amount)
+	}
+}
+
+func TestQuerySeigniorageProceeds(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	// the issuance at the epoch start is larger than the current supply by the seigniorage
+	supply := input.BankKeeper.GetSupply(input.Ctx, core.MicroBiqDenom)
+	seigniorage := sdk.NewInt(1000)
+	input.TreasuryKeeper.SetEpochInitialIssuance(input.Ctx, sdk.NewCoins(sdk.NewCoin(core.MicroBiqDenom, supply.Amount.Add(seigniorage))))
+
+	querier := NewWasmQuerier(input.TreasuryKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		SeigniorageProceeds: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var seigniorageResponse SeigniorageProceedsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &seigniorageResponse))
+	require.Equal(t, seigniorage.String(), seigniorageResponse.Amount)
+}
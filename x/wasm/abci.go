@@ -0,0 +1,19 @@
+package wasm
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/keeper"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// EndBlocker executes the due contract cron jobs and charges the contract storage rent
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ExecuteCronJobs(ctx)
+	k.ChargeStorageRent(ctx)
+}
@@ -0,0 +1,60 @@
+// Package bindings describes the custom queries the contracts can send to the
+// iq modules, and generates the JSON schema of the queries and their responses.
+//
+// The schema and the test vectors in the schema directory are checked by the tests,
+// and regenerated with `make wasm-bindings-gen` when the queries change.
+package bindings
+
+import (
+	marketwasm "github.com/bitwebs/iq-core/x/market/wasm"
+	oraclewasm "github.com/bitwebs/iq-core/x/oracle/wasm"
+	treasurywasm "github.com/bitwebs/iq-core/x/treasury/wasm"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// QueryRoute describes the custom queries served by a module querier
+type QueryRoute struct {
+	// Route is the route of the custom query, eg "treasury"
+	Route string
+	// Query is the CosmosQuery of the module; each of its fields is a query variant
+	Query interface{}
+	// Responses maps the JSON names of the query variants to their responses
+	Responses map[string]interface{}
+}
+
+// QueryRoutes returns the custom queries of the iq modules
+func QueryRoutes() []QueryRoute {
+	return []QueryRoute{
+		{
+			Route: types.WasmQueryRouteTreasury,
+			Query: treasurywasm.CosmosQuery{},
+			Responses: map[string]interface{}{
+				"tax_rate":             treasurywasm.TaxRateQueryResponse{},
+				"tax_cap":              treasurywasm.TaxCapQueryResponse{},
+				"reward_weight":        treasurywasm.RewardWeightQueryResponse{},
+				"tax_proceeds":         treasurywasm.TaxProceedsQueryResponse{},
+				"seigniorage_proceeds": treasurywasm.SeigniorageProceedsQueryResponse{},
+			},
+		},
+		{
+			Route: types.WasmQueryRouteOracle,
+			Query: oraclewasm.CosmosQuery{},
+			Responses: map[string]interface{}{
+				"exchange_rates": oraclewasm.ExchangeRatesQueryResponse{},
+				"tobin_tax":      oraclewasm.TobinTaxQueryResponse{},
+				"tobin_taxes":    oraclewasm.TobinTaxesQueryResponse{},
+				"vote_targets":   oraclewasm.VoteTargetsQueryResponse{},
+				"params":         oraclewasm.ParamsQueryResponse{},
+			},
+		},
+		{
+			Route: types.WasmQueryRouteMarket,
+			Query: marketwasm.CosmosQuery{},
+			Responses: map[string]interface{}{
+				"swap":          marketwasm.SwapQueryResponse{},
+				"iq_pool_delta": marketwasm.IqPoolDeltaQueryResponse{},
+				"params":        marketwasm.ParamsQueryResponse{},
+			},
+		},
+	}
+}
@@ -0,0 +1,215 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaDraft is the JSON schema draft of the generated schema
+const SchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// module types are defined once in the schema definitions, prefixed with the module name
+const modulePkgPrefix = "github.com/bitwebs/iq-core/x/"
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// Schema is a JSON schema node
+type Schema map[string]interface{}
+
+// QuerySchema returns the JSON schema of the custom queries of the iq modules;
+// the root schema is the custom query sent by the contracts, and each query variant
+// refers to its response in the definitions
+func QuerySchema() (Schema, error) {
+	g := schemaGenerator{definitions: map[string]Schema{}, types: map[string]reflect.Type{}}
+
+	routes := []interface{}{}
+	for _, route := range QueryRoutes() {
+		queryName := strings.Title(route.Route) + "Query"
+		querySchema, err := g.querySchema(route)
+		if err != nil {
+			return nil, err
+		}
+
+		g.definitions[queryName] = querySchema
+		routes = append(routes, Schema{
+			"type":                 "object",
+			"required":             []string{"route", "query_data"},
+			"additionalProperties": false,
+			"properties": Schema{
+				"route":      Schema{"type": "string", "enum": []string{route.Route}},
+				"query_data": Schema{"$ref": "#/definitions/" + queryName},
+			},
+		})
+	}
+
+	return Schema{
+		"$schema":     SchemaDraft,
+		"title":       "IqQuery",
+		"description": "The custom query sent by the contracts to the iq modules",
+		"oneOf":       routes,
+		"definitions": g.definitions,
+	}, nil
+}
+
+type schemaGenerator struct {
+	definitions map[string]Schema
+	types       map[string]reflect.Type
+}
+
+// querySchema returns the externally tagged enum schema of the CosmosQuery of the route,
+// where each variant is an object with the single property of the variant name
+func (g *schemaGenerator) querySchema(route QueryRoute) (Schema, error) {
+	queryType := reflect.TypeOf(route.Query)
+
+	variants := []interface{}{}
+	for i := 0; i < queryType.NumField(); i++ {
+		field := queryType.Field(i)
+		name, _ := jsonName(field)
+		if name == "" {
+			continue
+		}
+
+		response, ok := route.Responses[name]
+		if !ok {
+			return nil, fmt.Errorf("no response of %s query variant %s", route.Route, name)
+		}
+
+		responseSchema, err := g.typeSchema(reflect.TypeOf(response))
+		if err != nil {
+			return nil, err
+		}
+
+		variantSchema, err := g.typeSchema(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, Schema{
+			"type":                 "object",
+			"required":             []string{name},
+			"additionalProperties": false,
+			"properties":           Schema{name: variantSchema},
+			"x-response":           responseSchema,
+		})
+	}
+
+	if len(variants) != len(route.Responses) {
+		return nil, fmt.Errorf("responses of %s do not match the query variants", route.Route)
+	}
+
+	return Schema{"oneOf": variants}, nil
+}
+
+// typeSchema returns the schema of the JSON encoding of the type
+func (g *schemaGenerator) typeSchema(t reflect.Type) (Schema, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// custom marshalers of the sdk numbers, eg sdk.Int and sdk.Dec, encode them as strings
+	if t.Kind() == reflect.Struct && t.Implements(jsonMarshalerType) {
+		return Schema{"type": "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}, nil
+	case reflect.Bool:
+		return Schema{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Schema{"type": "integer", "format": t.Kind().String()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer", "format": t.Kind().String(), "minimum": 0}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return Schema{"type": "array", "items": items}, nil
+	case reflect.Struct:
+		return g.structSchema(t)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s in the query schema", t)
+}
+
+// structSchema returns the object schema of the struct; the module types are
+// added to the definitions and referred to, and the other types are inlined
+func (g *schemaGenerator) structSchema(t reflect.Type) (Schema, error) {
+	definitionName := ""
+	if strings.HasPrefix(t.PkgPath(), modulePkgPrefix) && t.Name() != "" {
+		module := strings.SplitN(strings.TrimPrefix(t.PkgPath(), modulePkgPrefix), "/", 2)[0]
+		definitionName = strings.Title(module) + t.Name()
+
+		ref := Schema{"$ref": "#/definitions/" + definitionName}
+		if definedType, ok := g.types[definitionName]; ok {
+			if definedType != t {
+				return nil, fmt.Errorf("duplicated definition %s of %s and %s", definitionName, definedType, t)
+			}
+
+			return ref, nil
+		}
+
+		g.types[definitionName] = t
+	}
+
+	properties := Schema{}
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty := jsonName(field)
+		if name == "" {
+			continue
+		}
+
+		fieldSchema, err := g.typeSchema(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		properties[name] = fieldSchema
+		if !omitEmpty {
+			required = append(required, name)
+		}
+	}
+
+	sort.Strings(required)
+	schema := Schema{"type": "object", "required": required, "properties": properties}
+	if definitionName == "" {
+		return schema, nil
+	}
+
+	g.definitions[definitionName] = schema
+	return Schema{"$ref": "#/definitions/" + definitionName}, nil
+}
+
+// jsonName returns the JSON name of the exported field, and whether it is omitted when empty
+func jsonName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "", false
+	}
+
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+
+	omitEmpty := false
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+
+	return name, omitEmpty
+}
@@ -0,0 +1,67 @@
+package bindings
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the generated schema and test vectors")
+
+var (
+	schemaFile  = filepath.Join("schema", "iq_query.json")
+	vectorsFile = filepath.Join("schema", "query_vectors.json")
+)
+
+// checkGenerated compares the generated JSON with the committed file,
+// or rewrites the file with the -update flag
+func checkGenerated(t *testing.T, file string, generated interface{}) {
+	bz, err := json.MarshalIndent(generated, "", "  ")
+	require.NoError(t, err)
+	bz = append(bz, '\n')
+
+	if *update {
+		require.NoError(t, ioutil.WriteFile(file, bz, 0o644))
+		return
+	}
+
+	committed, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	require.Equal(t, string(committed), string(bz), "%s is outdated; run `make wasm-bindings-gen`", file)
+}
+
+func TestQuerySchema(t *testing.T) {
+	schema, err := QuerySchema()
+	require.NoError(t, err)
+
+	definitions := schema["definitions"].(map[string]Schema)
+	for _, route := range QueryRoutes() {
+		require.Contains(t, definitions, strings.Title(route.Route)+"Query")
+	}
+
+	// the module types are defined once, the others are inlined
+	require.Contains(t, definitions, "TreasuryTaxRateQueryResponse")
+	require.Contains(t, definitions, "OracleParamsQueryResponse")
+	require.Contains(t, definitions, "MarketParamsQueryResponse")
+	require.Contains(t, definitions, "MarketQuerySwapParams")
+	require.Contains(t, definitions, "OracleTobinTaxItem")
+	require.NotContains(t, definitions, "Coin")
+
+	checkGenerated(t, schemaFile, schema)
+}
+
+func TestQuerySchemaMissingResponse(t *testing.T) {
+	g := schemaGenerator{definitions: map[string]Schema{}, types: map[string]reflect.Type{}}
+
+	route := QueryRoutes()[0]
+	delete(route.Responses, "tax_rate")
+
+	_, err := g.querySchema(route)
+	require.Error(t, err)
+}
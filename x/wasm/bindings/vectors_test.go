@@ -0,0 +1,121 @@
+package bindings
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	markettypes "github.com/bitwebs/iq-core/x/market/types"
+	marketwasm "github.com/bitwebs/iq-core/x/market/wasm"
+	oraclewasm "github.com/bitwebs/iq-core/x/oracle/wasm"
+	treasurytypes "github.com/bitwebs/iq-core/x/treasury/types"
+	treasurywasm "github.com/bitwebs/iq-core/x/treasury/wasm"
+	"github.com/bitwebs/iq-core/x/wasm/config"
+	"github.com/bitwebs/iq-core/x/wasm/keeper"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// queryVector is a custom query and its response, shared with the contract bindings
+// to check their encoding against the chain
+type queryVector struct {
+	Name     string          `json:"name"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+func TestQueryVectors(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	ctx := input.Ctx
+
+	input.TreasuryKeeper.SetTaxRate(ctx, sdk.NewDecWithPrec(7, 3))
+	input.TreasuryKeeper.SetTaxCap(ctx, core.MicroBSDRDenom, sdk.NewInt(1_000_000))
+	input.TreasuryKeeper.SetRewardWeight(ctx, sdk.NewDecWithPrec(5, 2))
+	input.TreasuryKeeper.SetEpochTaxProceeds(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 1000), sdk.NewInt64Coin(core.MicroBUSDDenom, 2000)))
+	supply := input.BankKeeper.GetSupply(ctx, core.MicroBiqDenom)
+	input.TreasuryKeeper.SetEpochInitialIssuance(ctx, sdk.NewCoins(supply.AddAmount(sdk.NewInt(123_456))))
+
+	input.OracleKeeper.ClearTobinTaxes(ctx)
+	input.OracleKeeper.SetTobinTax(ctx, core.MicroBKRWDenom, sdk.NewDecWithPrec(2, 3))
+	input.OracleKeeper.SetTobinTax(ctx, core.MicroBSDRDenom, sdk.NewDecWithPrec(25, 4))
+	input.OracleKeeper.SetBiqExchangeRate(ctx, core.MicroBKRWDenom, sdk.NewDec(1700))
+	input.OracleKeeper.SetBiqExchangeRate(ctx, core.MicroBSDRDenom, sdk.NewDecWithPrec(19, 1))
+
+	input.MarketKeeper.SetIqPoolDelta(ctx, sdk.NewDecWithPrec(-12345, 1))
+
+	querier := types.NewWasmQuerier()
+	querier.Queriers[types.WasmQueryRouteTreasury] = treasurywasm.NewWasmQuerier(input.TreasuryKeeper)
+	querier.Queriers[types.WasmQueryRouteOracle] = oraclewasm.NewWasmQuerier(input.OracleKeeper)
+	querier.Queriers[types.WasmQueryRouteMarket] = marketwasm.NewWasmQuerier(input.MarketKeeper)
+	querier = querier.WithCtx(ctx)
+
+	requests := []struct {
+		route string
+		query interface{}
+	}{
+		{types.WasmQueryRouteTreasury, treasurywasm.CosmosQuery{TaxRate: &struct{}{}}},
+		{types.WasmQueryRouteTreasury, treasurywasm.CosmosQuery{TaxCap: &treasurytypes.QueryTaxCapParams{Denom: core.MicroBSDRDenom}}},
+		{types.WasmQueryRouteTreasury, treasurywasm.CosmosQuery{RewardWeight: &struct{}{}}},
+		{types.WasmQueryRouteTreasury, treasurywasm.CosmosQuery{TaxProceeds: &struct{}{}}},
+		{types.WasmQueryRouteTreasury, treasurywasm.CosmosQuery{SeigniorageProceeds: &struct{}{}}},
+		{types.WasmQueryRouteOracle, oraclewasm.CosmosQuery{ExchangeRates: &oraclewasm.ExchangeRateQueryParams{
+			BaseDenom:   core.MicroBSDRDenom,
+			QuoteDenoms: []string{core.MicroBKRWDenom},
+		}}},
+		{types.WasmQueryRouteOracle, oraclewasm.CosmosQuery{TobinTax: &oraclewasm.TobinTaxQueryParams{Denom: core.MicroBKRWDenom}}},
+		{types.WasmQueryRouteOracle, oraclewasm.CosmosQuery{TobinTaxes: &struct{}{}}},
+		{types.WasmQueryRouteOracle, oraclewasm.CosmosQuery{VoteTargets: &struct{}{}}},
+		{types.WasmQueryRouteOracle, oraclewasm.CosmosQuery{Params: &struct{}{}}},
+		{types.WasmQueryRouteMarket, marketwasm.CosmosQuery{Swap: &markettypes.QuerySwapParams{
+			OfferCoin: sdk.NewInt64Coin(core.MicroBSDRDenom, 1_000_000),
+			AskDenom:  core.MicroBKRWDenom,
+		}}},
+		{types.WasmQueryRouteMarket, marketwasm.CosmosQuery{IqPoolDelta: &struct{}{}}},
+		{types.WasmQueryRouteMarket, marketwasm.CosmosQuery{Params: &struct{}{}}},
+	}
+
+	vectors := []queryVector{}
+	covered := map[string]bool{}
+	for _, req := range requests {
+		queryData, err := json.Marshal(req.query)
+		require.NoError(t, err)
+
+		request, err := json.Marshal(types.WasmCustomQuery{Route: req.route, QueryData: queryData})
+		require.NoError(t, err)
+
+		res, err := querier.Query(wasmvmtypes.QueryRequest{Custom: request}, config.DefaultContractQueryGasLimit*types.GasMultiplier)
+		require.NoError(t, err, string(request))
+
+		name := req.route + "/" + variantName(req.query)
+		covered[name] = true
+		vectors = append(vectors, queryVector{Name: name, Request: request, Response: res})
+	}
+
+	// every query variant must have a test vector
+	for _, route := range QueryRoutes() {
+		for variant := range route.Responses {
+			require.True(t, covered[route.Route+"/"+variant], "no test vector of %s/%s", route.Route, variant)
+		}
+	}
+
+	checkGenerated(t, vectorsFile, vectors)
+}
+
+// variantName returns the JSON name of the variant set in the CosmosQuery
+func variantName(query interface{}) string {
+	v := reflect.ValueOf(query)
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Field(i).IsNil() {
+			name, _ := jsonName(v.Type().Field(i))
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,412 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+const (
+	flagRunAs = "run-as"
+)
+
+// ProposalStoreCodeCmd will submit a proposal to upload a wasm binary
+func ProposalStoreCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wasm-store [wasm-file] --run-as [creator-addr] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to upload a wasm binary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			wasmBytes, err := parseWasmFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			runAs, err := cmd.Flags().GetString(flagRunAs)
+			if err != nil {
+				return err
+			}
+
+			instantiatePermission, err := parseAccessConfigFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.StoreCodeProposal{
+					Title:                 title,
+					Description:           description,
+					RunAs:                 runAs,
+					WASMByteCode:          wasmBytes,
+					InstantiatePermission: instantiatePermission,
+				}
+			})
+		},
+	}
+
+	cmd.Flags().String(flagRunAs, "", "the address that is stored as code creator")
+	addInstantiatePermissionFlags(cmd)
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalInstantiateContractCmd will submit a proposal to instantiate a contract
+func ProposalInstantiateContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-contract [code-id-int64] [json-encoded-args] [coins] --run-as [address] --admin [address] --label [text] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to instantiate a wasm contract",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			initMsgBz := []byte(args[1])
+
+			var coins sdk.Coins
+			if len(args) == 3 {
+				coins, err = sdk.ParseCoinsNormalized(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			runAs, err := cmd.Flags().GetString(flagRunAs)
+			if err != nil {
+				return err
+			}
+
+			admin, err := cmd.Flags().GetString(flagAdmin)
+			if err != nil {
+				return err
+			}
+
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.InstantiateContractProposal{
+					Title:       title,
+					Description: description,
+					RunAs:       runAs,
+					Admin:       admin,
+					CodeID:      codeID,
+					InitMsg:     initMsgBz,
+					InitCoins:   coins,
+					Label:       label,
+				}
+			})
+		},
+	}
+
+	cmd.Flags().String(flagRunAs, "", "the address that pays the init funds and is passed to the contract as sender")
+	cmd.Flags().String(flagAdmin, "", "the contract admin address which is previlaged to migrate contract")
+	cmd.Flags().String(flagLabel, "", "an optional label of the contract instance")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalMigrateContractCmd will submit a proposal to migrate a contract
+func ProposalMigrateContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-contract [contract-addr-bech32] [new-code-id] [json-encoded-args] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to migrate a contract to new code base",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newCodeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.MigrateContractProposal{
+					Title:       title,
+					Description: description,
+					Contract:    args[0],
+					NewCodeID:   newCodeID,
+					MigrateMsg:  []byte(args[2]),
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalUpdateContractAdminCmd will submit a proposal to set a new admin of a contract
+func ProposalUpdateContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-admin [contract-addr-bech32] [new-admin-addr-bech32] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to set a new admin of a contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.UpdateAdminProposal{
+					Title:       title,
+					Description: description,
+					Contract:    args[0],
+					NewAdmin:    args[1],
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalClearContractAdminCmd will submit a proposal to clear the admin of a contract
+func ProposalClearContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-contract-admin [contract-addr-bech32] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to clear the admin of a contract and make it immutable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.UpdateAdminProposal{
+					Title:       title,
+					Description: description,
+					Contract:    args[0],
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalPinCodesCmd will submit a proposal to pin codes in the wasmvm cache
+func ProposalPinCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin-codes [code-ids] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to pin the comma separated codes in the wasmvm cache",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIDs, err := parseCodeIDs(args[0])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.PinCodesProposal{
+					Title:       title,
+					Description: description,
+					CodeIDs:     codeIDs,
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalUnpinCodesCmd will submit a proposal to unpin codes from the wasmvm cache
+func ProposalUnpinCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin-codes [code-ids] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to unpin the comma separated codes from the wasmvm cache",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIDs, err := parseCodeIDs(args[0])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.UnpinCodesProposal{
+					Title:       title,
+					Description: description,
+					CodeIDs:     codeIDs,
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalSudoContractCmd will submit a proposal to call the sudo entry point of a contract
+func ProposalSudoContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sudo-contract [contract-addr-bech32] [json-encoded-args] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to call the sudo entry point of a contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.SudoContractProposal{
+					Title:       title,
+					Description: description,
+					Contract:    args[0],
+					Msg:         []byte(args[1]),
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalFreezeContractCmd will submit a proposal to freeze a contract
+func ProposalFreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-contract [contract-addr-bech32] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to freeze a contract, which fails its executions and migrations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.FreezeContractProposal{
+					Title:       title,
+					Description: description,
+					Contract:    args[0],
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// ProposalUnfreezeContractCmd will submit a proposal to unfreeze a frozen contract
+func ProposalUnfreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-contract [contract-addr-bech32] --title [text] --description [text] --deposit [coins]",
+		Short: "Submit a proposal to unfreeze a frozen contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return &types.UnfreezeContractProposal{
+					Title:       title,
+					Description: description,
+					Contract:    args[0],
+				}
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// submitProposal builds the proposal content from the title and description flags
+// and broadcasts a MsgSubmitProposal with the deposit flag
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func parseCodeIDs(arg string) ([]uint64, error) {
+	var codeIDs []uint64
+	for _, s := range strings.Split(arg, ",") {
+		codeID, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code id %s: %w", s, err)
+		}
+
+		codeIDs = append(codeIDs, codeID)
+	}
+
+	return codeIDs, nil
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,7 +19,15 @@ import (
 	"github.com/bitwebs/iq-core/x/wasm/types"
 )
 
-const flagRaw = "raw"
+const (
+	flagRaw      = "raw"
+	flagStartKey = "start-key"
+	flagEndKey   = "end-key"
+
+	flagEventType = "event-type"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+)
 
 // GetQueryCmd returns the cli query commands for wasm   module
 func GetQueryCmd() *cobra.Command {
@@ -34,8 +43,23 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryCodeInfo(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractStore(),
+		GetCmdGetContractStoreBatch(),
 		GetCmdGetRawStore(),
+		GetCmdGetAllContractState(),
 		GetCmdQueryParams(),
+		GetCmdPredictContractAddress(),
+		GetCmdListCode(),
+		GetCmdListPinnedCode(),
+		GetCmdListContractsByCode(),
+		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
+		GetCmdGetContractHistory(),
+		GetCmdGetContractStorageSize(),
+		GetCmdSimulateExecute(),
+		GetCmdQueryCronJob(),
+		GetCmdListCronJobs(),
+		GetCmdQueryGasSponsorship(),
+		GetCmdListContractEvents(),
 	)
 	return queryCmd
 }
@@ -140,6 +164,40 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStorageSize is for querying the bytes stored by a contract
+func GetCmdGetContractStorageSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-storage-size [bech32-address]",
+		Short: "Prints out the bytes stored by a contract given its address",
+		Long:  "Prints out the bytes stored by a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractStorageSize(context.Background(), &types.QueryContractStorageSizeRequest{
+				ContractAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractStore send query msg to a given contract
 func GetCmdGetContractStore() *cobra.Command {
 	cmd := &cobra.Command{
@@ -182,6 +240,57 @@ func GetCmdGetContractStore() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStoreBatch queries the contract stores with the batch of the query data
+func GetCmdGetContractStoreBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-store-batch [json-encoded-queries]",
+		Short: "Query contract stores with the batch of query data and prints the returned results",
+		Long: `Query contract stores with the batch of query data and prints the returned results.
+The queries are run at the same height and share the contract query gas limit, e.g.
+
+$ iqd query wasm contract-store-batch '[{"contract_address": "iq1...", "query_msg": {"config": {}}}]'
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var queries []struct {
+				ContractAddress string          `json:"contract_address"`
+				QueryMsg        json.RawMessage `json:"query_msg"`
+			}
+			if err := json.Unmarshal([]byte(args[0]), &queries); err != nil {
+				return err
+			}
+
+			req := &types.QueryContractStoreBatchRequest{}
+			for _, query := range queries {
+				if _, err := sdk.AccAddressFromBech32(query.ContractAddress); err != nil {
+					return err
+				}
+
+				req.Queries = append(req.Queries, types.QueryContractStoreRequest{
+					ContractAddress: query.ContractAddress,
+					QueryMsg:        query.QueryMsg,
+				})
+			}
+
+			res, err := queryClient.ContractStoreBatch(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetRawStore dumps full internal state of a given contract
 func GetCmdGetRawStore() *cobra.Command {
 	cmd := &cobra.Command{
@@ -224,6 +333,70 @@ func GetCmdGetRawStore() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetAllContractState prints out the raw store data of a contract within the key range
+func GetCmdGetAllContractState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-state-all [bech32-address]",
+		Short: "Prints out the raw store data of a contract within the key range",
+		Long:  "Prints out the raw store data of a contract within the key range given by the base64 encoded start and end keys",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startKey, err := readBase64Flag(cmd, flagStartKey)
+			if err != nil {
+				return err
+			}
+
+			endKey, err := readBase64Flag(cmd, flagEndKey)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllContractState(context.Background(), &types.QueryAllContractStateRequest{
+				ContractAddress: args[0],
+				StartKey:        startKey,
+				EndKey:          endKey,
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStartKey, "", "The base64 encoded inclusive start key of the range")
+	cmd.Flags().String(flagEndKey, "", "The base64 encoded exclusive end key of the range")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-state-all")
+	return cmd
+}
+
+func readBase64Flag(cmd *cobra.Command, flag string) ([]byte, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(value)
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -249,3 +422,505 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdPredictContractAddress is for predicting the address of a contract
+// instantiated with instantiate2
+func GetCmdPredictContractAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict-address [code-id] [creator] [hex-salt]",
+		Short: "Predict the address of a contract instantiated with instantiate2",
+		Long:  "Predict the address of a contract instantiated with instantiate2",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("salt must be a hex string: %w", err)
+			}
+
+			res, err := queryClient.PredictContractAddress(context.Background(), &types.QueryPredictContractAddressRequest{
+				CodeId:  codeID,
+				Creator: args[1],
+				Salt:    salt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListCode lists all the stored code infos
+func GetCmdListCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-code",
+		Short: "List all the stored code infos",
+		Long:  "List all the stored code infos",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Codes(context.Background(), &types.QueryCodesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-code")
+	return cmd
+}
+
+// GetCmdListPinnedCode lists the ids of the codes pinned in the VM cache
+func GetCmdListPinnedCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pinned-code",
+		Short: "List the ids of all pinned codes",
+		Long:  "List the ids of all codes pinned in the wasm VM in-memory cache",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PinnedCodes(context.Background(), &types.QueryPinnedCodesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-pinned-code")
+	return cmd
+}
+
+// GetCmdListContractsByCode lists the contracts instantiated from a code
+func GetCmdListContractsByCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contract-by-code [code-id]",
+		Short: "List the contracts instantiated from the code",
+		Long:  "List the contracts instantiated from the code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByCode(context.Background(), &types.QueryContractsByCodeRequest{
+				CodeId:     codeID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-contract-by-code")
+	return cmd
+}
+
+// GetCmdListContractsByCreator lists the contracts instantiated by a creator
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contract-by-creator [creator]",
+		Short: "List the contracts instantiated by the creator",
+		Long:  "List the contracts instantiated by the creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByCreator(context.Background(), &types.QueryContractsByCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-contract-by-creator")
+	return cmd
+}
+
+// GetCmdListContractsByAdmin lists the contracts administrated by an admin
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contract-by-admin [admin]",
+		Short: "List the contracts administrated by the admin",
+		Long:  "List the contracts administrated by the admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByAdmin(context.Background(), &types.QueryContractsByAdminRequest{
+				Admin:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-contract-by-admin")
+	return cmd
+}
+
+// GetCmdGetContractHistory prints the code and admin history of a contract
+func GetCmdGetContractHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-history [bech32-address]",
+		Short: "Prints out the code and admin history of a contract",
+		Long:  "Prints out the code and admin history of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractHistory(context.Background(), &types.QueryContractHistoryRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-history")
+	return cmd
+}
+
+// GetCmdSimulateExecute dry-runs the contract execution from the sender without signing
+func GetCmdSimulateExecute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-execute [contract-addr-bech32] [sender-addr-bech32] [json-encoded-args] [coins]",
+		Short: "Simulate the execution of the contract with its submessages and prints the data, events, gas used and balance changes",
+		Long:  "Simulate the execution of the contract with its submessages and prints the data, events, gas used and balance changes",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			execMsgBz := []byte(args[2])
+			if !json.Valid(execMsgBz) {
+				return errors.New("msg must be a json string format")
+			}
+
+			var coins sdk.Coins
+			if len(args) == 4 {
+				coins, err = sdk.ParseCoinsNormalized(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SimulateExecute(context.Background(), &types.QuerySimulateExecuteRequest{
+				ContractAddress: args[0],
+				Sender:          args[1],
+				ExecuteMsg:      execMsgBz,
+				Coins:           coins,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCronJob prints a scheduled contract cron job
+func GetCmdQueryCronJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-job [job-id]",
+		Short: "query a scheduled contract cron job",
+		Long:  "query a scheduled contract cron job with its next height and remaining gas budget",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			jobID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CronJob(context.Background(), &types.QueryCronJobRequest{JobId: jobID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListCronJobs lists the cron jobs scheduled by a contract
+func GetCmdListCronJobs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cron-jobs [bech32-address]",
+		Short: "List the cron jobs scheduled by the contract",
+		Long:  "List the cron jobs scheduled by the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CronJobs(context.Background(), &types.QueryCronJobsRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-cron-jobs")
+	return cmd
+}
+
+// GetCmdQueryGasSponsorship prints the gas sponsorship of a contract
+func GetCmdQueryGasSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-sponsorship [bech32-address]",
+		Short: "query the gas sponsorship of a contract",
+		Long:  "query the limits of the fees the contract pays for the txs executing it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasSponsorship(context.Background(), &types.QueryGasSponsorshipRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListContractEvents lists the indexed events emitted by a contract
+func GetCmdListContractEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-events [bech32-address]",
+		Short: "List the events emitted by the contract",
+		Long: "List the wasm and wasm-* events emitted by the contract within the height range, " +
+			"from the event index of the node; the node must enable the contract event indexing",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			eventType, err := cmd.Flags().GetString(flagEventType)
+			if err != nil {
+				return err
+			}
+
+			minHeight, err := cmd.Flags().GetInt64(flagMinHeight)
+			if err != nil {
+				return err
+			}
+
+			maxHeight, err := cmd.Flags().GetInt64(flagMaxHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractEvents(context.Background(), &types.QueryContractEventsRequest{
+				ContractAddress: args[0],
+				EventType:       eventType,
+				MinHeight:       minHeight,
+				MaxHeight:       maxHeight,
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagEventType, "", "The type of the events, such as wasm or wasm-transfer; all types when empty")
+	cmd.Flags().Int64(flagMinHeight, 0, "The lowest block height of the events")
+	cmd.Flags().Int64(flagMaxHeight, 0, "The highest block height of the events, no upper bound when zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-events")
+	return cmd
+}
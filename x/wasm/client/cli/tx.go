@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,11 @@ const (
 	flagAmount        = "amount"
 	flagAdmin         = "admin"
 	flagMigrateCodeID = "migrate-code-id"
+	flagLabel         = "label"
+
+	flagInstantiateByEverybody = "instantiate-everybody"
+	flagInstantiateNobody      = "instantiate-nobody"
+	flagInstantiateByAddresses = "instantiate-only-address"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,6 +45,7 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
@@ -58,6 +65,9 @@ $ iqd tx store ./path-to-binary
 
 Or to migrate columbus-4 code to columbus-5 code
 $ iqd tx store ./path-to-binary --migrate-code-id 3
+
+Or to allow only the given addresses to instantiate the code
+$ iqd tx store ./path-to-binary --instantiate-only-address iq...,iq...
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -71,35 +81,25 @@ $ iqd tx store ./path-to-binary --migrate-code-id 3
 				return fmt.Errorf("must specify flag --from")
 			}
 
-			// parse coins trying to be sent
-			wasmBytes, err := ioutil.ReadFile(args[0])
+			wasmBytes, err := parseWasmFile(args[0])
 			if err != nil {
 				return err
 			}
 
-			// limit the input size
-			if wasmLen := uint64(len(wasmBytes)); wasmLen > types.EnforcedMaxContractSize {
-				return fmt.Errorf("wasm code size exceeds the max size hard-cap (allowed:%d, actual: %d)",
-					types.EnforcedMaxContractSize, wasmLen)
-			}
-
-			// gzip the wasm file
-			if wasmUtils.IsWasm(wasmBytes) {
-				wasmBytes, err = wasmUtils.GzipIt(wasmBytes)
-				if err != nil {
-					return err
-				}
-			} else if !wasmUtils.IsGzip(wasmBytes) {
-				return fmt.Errorf("invalid input file. Use wasm binary or gzip")
-			}
-
 			var msg sdk.Msg
 			if codeID, err := cmd.Flags().GetUint64(flagMigrateCodeID); err != nil {
 				return err
 			} else if codeID != 0 {
 				msg = types.NewMsgMigrateCode(codeID, fromAddr, wasmBytes)
 			} else {
-				msg = types.NewMsgStoreCode(fromAddr, wasmBytes)
+				instantiatePermission, err := parseAccessConfigFlags(cmd)
+				if err != nil {
+					return err
+				}
+
+				storeMsg := types.NewMsgStoreCode(fromAddr, wasmBytes)
+				storeMsg.InstantiatePermission = instantiatePermission
+				msg = storeMsg
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
@@ -112,11 +112,82 @@ $ iqd tx store ./path-to-binary --migrate-code-id 3
 	}
 
 	cmd.Flags().Uint64(flagMigrateCodeID, 0, "specifies the code ID to be migrated")
+	addInstantiatePermissionFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseWasmFile reads the wasm binary and gzips it when it is not compressed yet
+func parseWasmFile(path string) ([]byte, error) {
+	wasmBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// limit the input size
+	if wasmLen := uint64(len(wasmBytes)); wasmLen > types.EnforcedMaxContractSize {
+		return nil, fmt.Errorf("wasm code size exceeds the max size hard-cap (allowed:%d, actual: %d)",
+			types.EnforcedMaxContractSize, wasmLen)
+	}
+
+	// gzip the wasm file
+	if wasmUtils.IsWasm(wasmBytes) {
+		return wasmUtils.GzipIt(wasmBytes)
+	} else if !wasmUtils.IsGzip(wasmBytes) {
+		return nil, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	}
+
+	return wasmBytes, nil
+}
+
+func addInstantiatePermissionFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagInstantiateByEverybody, false, "everybody can instantiate a contract from the code (default)")
+	cmd.Flags().Bool(flagInstantiateNobody, false, "nobody except the governance can instantiate a contract from the code")
+	cmd.Flags().StringSlice(flagInstantiateByAddresses, []string{}, "only these addresses can instantiate a contract from the code")
+}
+
+// parseAccessConfigFlags returns the instantiate permission of the flags;
+// nil means the default permission which allows everybody
+func parseAccessConfigFlags(cmd *cobra.Command) (*types.AccessConfig, error) {
+	everybody, err := cmd.Flags().GetBool(flagInstantiateByEverybody)
+	if err != nil {
+		return nil, err
+	}
+
+	nobody, err := cmd.Flags().GetBool(flagInstantiateNobody)
+	if err != nil {
+		return nil, err
+	}
+
+	onlyAddrs, err := cmd.Flags().GetStringSlice(flagInstantiateByAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []types.AccessConfig
+	if everybody {
+		configs = append(configs, types.AllowEverybody)
+	}
+
+	if nobody {
+		configs = append(configs, types.AllowNobody)
+	}
+
+	if len(onlyAddrs) != 0 {
+		configs = append(configs, types.AccessConfig{Permission: types.AccessTypeOnlyAddresses, Addresses: onlyAddrs})
+	}
+
+	switch len(configs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &configs[0], configs[0].ValidateBasic()
+	default:
+		return nil, fmt.Errorf("only one of the instantiate permission flags can be given")
+	}
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -151,6 +222,11 @@ $ iqd instantiate 1 '{"arbiter": "iq~~"}' "1000000ubiq"
 				return err
 			}
 
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
 			var adminAddr sdk.AccAddress
 			if len(admin) != 0 {
 				adminAddr, err = sdk.AccAddressFromBech32(admin)
@@ -185,7 +261,7 @@ $ iqd instantiate 1 '{"arbiter": "iq~~"}' "1000000ubiq"
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgInstantiateContract(fromAddr, adminAddr, codeID, initMsgBz, coins)
+			msg := types.NewMsgInstantiateContract(fromAddr, adminAddr, codeID, initMsgBz, coins, label)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -211,6 +287,118 @@ $ iqd instantiate 1 '{"arbiter": "iq~~"}' "1000000ubiq"
 	}
 
 	cmd.Flags().String(flagAdmin, "", "the contract admin address which is previlaged to migrate contract")
+	cmd.Flags().String(flagLabel, "", "an optional label of the contract instance")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code
+// at the address derived from the code hash, the sender and the salt.
+func InstantiateContract2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate2 [code-id-int64] [json-encoded-args] [hex-salt] [coins]",
+		Short: "Instantiate a wasm contract at a predictable address",
+		Long: `
+Instantiate a wasm contract of the code which has the given id at the address
+derived from the code hash, the sender and the hex encoded salt
+
+$ iqd instantiate2 1 '{"arbiter": "iq~~"}' 6d7973616c74
+
+You can also instantiate it with funds
+
+$ iqd instantiate2 1 '{"arbiter": "iq~~"}' 6d7973616c74 "1000000ubiq"
+`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Generate transaction factory for gas simulation
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			fromAddr := clientCtx.GetFromAddress()
+			if fromAddr.Empty() {
+				return fmt.Errorf("must specify flag --from")
+			}
+
+			admin, err := cmd.Flags().GetString(flagAdmin)
+			if err != nil {
+				return err
+			}
+
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			var adminAddr sdk.AccAddress
+			if len(admin) != 0 {
+				adminAddr, err = sdk.AccAddressFromBech32(admin)
+				if err != nil {
+					return err
+				}
+			}
+
+			// get the id of the code to instantiate
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			initMsgBz := []byte(args[1])
+			if !json.Valid(initMsgBz) {
+				return errors.New("msg must be a json string format")
+			}
+
+			// limit the input size
+			if initMsgLen := uint64(len(initMsgBz)); initMsgLen > types.EnforcedMaxContractMsgSize {
+				return fmt.Errorf("init msg size exceeds the max size hard-cap (allowed:%d, actual: %d)",
+					types.EnforcedMaxContractMsgSize, initMsgLen)
+			}
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("salt must be a hex string: %w", err)
+			}
+
+			var coins sdk.Coins
+			if len(args) == 4 {
+				coins, err = sdk.ParseCoinsNormalized(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgInstantiateContract2(fromAddr, adminAddr, codeID, initMsgBz, coins, salt, label)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if len(args) == 4 && !clientCtx.GenerateOnly && txf.Fees().IsZero() {
+				// estimate tax and gas
+				stdFee, err := feeutils.ComputeFeesWithCmd(clientCtx, cmd.Flags(), msg)
+
+				if err != nil {
+					return err
+				}
+
+				// override gas and fees
+				txf = txf.
+					WithFees(stdFee.Amount.String()).
+					WithGas(stdFee.Gas).
+					WithSimulateAndExecute(false).
+					WithGasPrices("")
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(flagAdmin, "", "the contract admin address which is previlaged to migrate contract")
+	cmd.Flags().String(flagLabel, "", "an optional label of the contract instance")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
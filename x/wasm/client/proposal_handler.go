@@ -0,0 +1,22 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/bitwebs/iq-core/x/wasm/client/cli"
+	"github.com/bitwebs/iq-core/x/wasm/client/rest"
+)
+
+// ProposalHandlers define the wasm cli and rest proposal handlers
+var (
+	StoreCodeProposalHandler           = govclient.NewProposalHandler(cli.ProposalStoreCodeCmd, rest.StoreCodeProposalHandler)
+	InstantiateContractProposalHandler = govclient.NewProposalHandler(cli.ProposalInstantiateContractCmd, rest.InstantiateContractProposalHandler)
+	MigrateContractProposalHandler     = govclient.NewProposalHandler(cli.ProposalMigrateContractCmd, rest.MigrateContractProposalHandler)
+	UpdateAdminProposalHandler         = govclient.NewProposalHandler(cli.ProposalUpdateContractAdminCmd, rest.UpdateAdminProposalHandler)
+	ClearAdminProposalHandler          = govclient.NewProposalHandler(cli.ProposalClearContractAdminCmd, rest.UpdateAdminProposalHandler)
+	PinCodesProposalHandler            = govclient.NewProposalHandler(cli.ProposalPinCodesCmd, rest.PinCodesProposalHandler)
+	UnpinCodesProposalHandler          = govclient.NewProposalHandler(cli.ProposalUnpinCodesCmd, rest.UnpinCodesProposalHandler)
+	SudoContractProposalHandler        = govclient.NewProposalHandler(cli.ProposalSudoContractCmd, rest.SudoContractProposalHandler)
+	FreezeContractProposalHandler      = govclient.NewProposalHandler(cli.ProposalFreezeContractCmd, rest.FreezeContractProposalHandler)
+	UnfreezeContractProposalHandler    = govclient.NewProposalHandler(cli.ProposalUnfreezeContractCmd, rest.UnfreezeContractProposalHandler)
+)
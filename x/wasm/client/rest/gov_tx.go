@@ -0,0 +1,308 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmUtils "github.com/bitwebs/iq-core/x/wasm/client/utils"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+type storeCodeProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	RunAs                 string              `json:"run_as" yaml:"run_as"`
+	WasmBytes             []byte              `json:"wasm_bytes" yaml:"wasm_bytes"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
+}
+
+type instantiateContractProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	RunAs     string    `json:"run_as" yaml:"run_as"`
+	Admin     string    `json:"admin" yaml:"admin"`
+	CodeID    uint64    `json:"code_id" yaml:"code_id"`
+	InitMsg   string    `json:"init_msg" yaml:"init_msg"`
+	InitCoins sdk.Coins `json:"init_coins" yaml:"init_coins"`
+	Label     string    `json:"label" yaml:"label"`
+}
+
+type migrateContractProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Contract   string `json:"contract" yaml:"contract"`
+	NewCodeID  uint64 `json:"new_code_id" yaml:"new_code_id"`
+	MigrateMsg string `json:"migrate_msg" yaml:"migrate_msg"`
+}
+
+type updateAdminProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+	NewAdmin string `json:"new_admin" yaml:"new_admin"`
+}
+
+type codeIDsProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	CodeIDs []uint64 `json:"code_ids" yaml:"code_ids"`
+}
+
+type sudoContractProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+	Msg      string `json:"msg" yaml:"msg"`
+}
+
+type contractProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+// StoreCodeProposalHandler returns the REST handler of the store code proposal
+func StoreCodeProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_store_code",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req storeCodeProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			wasmBytes := req.WasmBytes
+			if wasmUtils.IsWasm(wasmBytes) {
+				var err error
+				wasmBytes, err = wasmUtils.GzipIt(wasmBytes)
+				if rest.CheckBadRequestError(w, err) {
+					return
+				}
+			} else if !wasmUtils.IsGzip(wasmBytes) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.StoreCodeProposal{
+				Title:                 req.Title,
+				Description:           req.Description,
+				RunAs:                 req.RunAs,
+				WASMByteCode:          wasmBytes,
+				InstantiatePermission: req.InstantiatePermission,
+			})
+		},
+	}
+}
+
+// InstantiateContractProposalHandler returns the REST handler of the instantiate contract proposal
+func InstantiateContractProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_instantiate_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req instantiateContractProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.InstantiateContractProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				RunAs:       req.RunAs,
+				Admin:       req.Admin,
+				CodeID:      req.CodeID,
+				InitMsg:     []byte(req.InitMsg),
+				InitCoins:   req.InitCoins,
+				Label:       req.Label,
+			})
+		},
+	}
+}
+
+// MigrateContractProposalHandler returns the REST handler of the migrate contract proposal
+func MigrateContractProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_migrate_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req migrateContractProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.MigrateContractProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Contract:    req.Contract,
+				NewCodeID:   req.NewCodeID,
+				MigrateMsg:  []byte(req.MigrateMsg),
+			})
+		},
+	}
+}
+
+// UpdateAdminProposalHandler returns the REST handler of the update admin proposal;
+// an empty new admin clears the admin of the contract
+func UpdateAdminProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_update_admin",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req updateAdminProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.UpdateAdminProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Contract:    req.Contract,
+				NewAdmin:    req.NewAdmin,
+			})
+		},
+	}
+}
+
+// PinCodesProposalHandler returns the REST handler of the pin codes proposal
+func PinCodesProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_pin_codes",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req codeIDsProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.PinCodesProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				CodeIDs:     req.CodeIDs,
+			})
+		},
+	}
+}
+
+// UnpinCodesProposalHandler returns the REST handler of the unpin codes proposal
+func UnpinCodesProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_unpin_codes",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req codeIDsProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.UnpinCodesProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				CodeIDs:     req.CodeIDs,
+			})
+		},
+	}
+}
+
+// SudoContractProposalHandler returns the REST handler of the sudo contract proposal
+func SudoContractProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_sudo_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req sudoContractProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.SudoContractProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Contract:    req.Contract,
+				Msg:         []byte(req.Msg),
+			})
+		},
+	}
+}
+
+// FreezeContractProposalHandler returns the REST handler of the freeze contract proposal
+func FreezeContractProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_freeze_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req contractProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.FreezeContractProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Contract:    req.Contract,
+			})
+		},
+	}
+}
+
+// UnfreezeContractProposalHandler returns the REST handler of the unfreeze contract proposal
+func UnfreezeContractProposalHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_unfreeze_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req contractProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			writeProposalResponse(w, clientCtx, req.BaseReq, req.Deposit, &types.UnfreezeContractProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Contract:    req.Contract,
+			})
+		},
+	}
+}
+
+func writeProposalResponse(w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq, deposit sdk.Coins, content govtypes.Content) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	// build and sign the transaction, then broadcast to Tendermint
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -29,8 +29,9 @@ func registerTxRoutes(clientCtx client.Context, r *mux.Router) {
 }
 
 type storeCodeReq struct {
-	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
-	WasmBytes []byte       `json:"wasm_bytes"`
+	BaseReq               rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	WasmBytes             []byte              `json:"wasm_bytes"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
 }
 
 type migrateCodeReq struct {
@@ -43,6 +44,7 @@ type instantiateContractReq struct {
 	InitCoins sdk.Coins    `json:"init_coins" yaml:"init_coins"`
 	InitMsg   string       `json:"init_msg" yaml:"init_msg"`
 	Admin     string       `json:"admin" yaml:"admin"`
+	Label     string       `json:"label" yaml:"label"`
 }
 
 type executeContractReq struct {
@@ -103,6 +105,7 @@ func storeCodeHandlerFn(clientCtx client.Context) http.HandlerFunc {
 
 		// build and sign the transaction, then broadcast to Tendermint
 		msg := types.NewMsgStoreCode(fromAddr, wasmBytes)
+		msg.InstantiatePermission = req.InstantiatePermission
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -215,7 +218,7 @@ func instantiateContractHandlerFn(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgInstantiateContract(fromAddr, adminAddr, codeID, initMsgBz, req.InitCoins)
+		msg := types.NewMsgInstantiateContract(fromAddr, adminAddr, codeID, initMsgBz, req.InitCoins, req.Label)
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
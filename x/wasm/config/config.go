@@ -11,11 +11,17 @@ const (
 	DefaultContractQueryGasLimit   = uint64(3000000)
 	DefaultContractDebugMode       = false
 	DefaultContractMemoryCacheSize = uint32(100)
+	DefaultContractTracingMode     = false
+	DefaultContractTraceFile       = "data/wasm-trace.jsonl"
+	DefaultContractEventIndexing   = false
 )
 
 // DBDir used to store wasm data to
 var DBDir = "data/wasm"
 
+// EventIndexDBName is the name of the contract event index database in the data directory
+var EventIndexDBName = "wasm_events"
+
 // Config is the extra config required for wasm
 type Config struct {
 	// The maximum gas amount can be spent for contract query
@@ -28,6 +34,16 @@ type Config struct {
 
 	// The WASM VM memory cache size in MiB not bytes
 	ContractMemoryCacheSize uint32 `mapstructure:"contract-memory-cache-size"`
+
+	// The flag to specify whether record the traces of the contract calls or not
+	ContractTracingMode bool `mapstructure:"contract-tracing-mode"`
+
+	// The file the contract traces are written to as JSON lines,
+	// the relative path is resolved from the node home
+	ContractTraceFile string `mapstructure:"contract-trace-file"`
+
+	// The flag to specify whether index the events emitted by the contracts or not
+	ContractEventIndexing bool `mapstructure:"contract-event-indexing"`
 }
 
 // DefaultConfig returns the default settings for WasmConfig
@@ -36,6 +52,9 @@ func DefaultConfig() *Config {
 		ContractQueryGasLimit:   DefaultContractQueryGasLimit,
 		ContractDebugMode:       DefaultContractDebugMode,
 		ContractMemoryCacheSize: DefaultContractMemoryCacheSize,
+		ContractTracingMode:     DefaultContractTracingMode,
+		ContractTraceFile:       DefaultContractTraceFile,
+		ContractEventIndexing:   DefaultContractEventIndexing,
 	}
 }
 
@@ -45,6 +64,9 @@ func GetConfig(appOpts servertypes.AppOptions) *Config {
 		ContractQueryGasLimit:   cast.ToUint64(appOpts.Get("wasm.contract-query-gas-limit")),
 		ContractDebugMode:       cast.ToBool(appOpts.Get("wasm.contract-debug-mode")),
 		ContractMemoryCacheSize: cast.ToUint32(appOpts.Get("wasm.contract-memory-cache-size")),
+		ContractTracingMode:     cast.ToBool(appOpts.Get("wasm.contract-tracing-mode")),
+		ContractTraceFile:       cast.ToString(appOpts.Get("wasm.contract-trace-file")),
+		ContractEventIndexing:   cast.ToBool(appOpts.Get("wasm.contract-event-indexing")),
 	}
 }
 
@@ -61,4 +83,20 @@ contract-debug-mode = "{{ .WASMConfig.ContractDebugMode }}"
 
 # The WASM VM memory cache size in MiB not bytes
 contract-memory-cache-size = "{{ .WASMConfig.ContractMemoryCacheSize }}"
+
+# The flag to specify whether record the traces of the contract calls or not.
+# Each call of execute, instantiate, reply and smart query is written with
+# its wasmvm gas, store gas, submessage depth and store read/write counts.
+# This is a node local debugging feature, don't enable it on validators
+contract-tracing-mode = "{{ .WASMConfig.ContractTracingMode }}"
+
+# The file the contract traces are written to as JSON lines,
+# the relative path is resolved from the node home
+contract-trace-file = "{{ .WASMConfig.ContractTraceFile }}"
+
+# The flag to specify whether index the events emitted by the contracts or not.
+# The wasm and wasm-* events are indexed by the contract address and the event
+# type in a node local database, and served by the contract events query.
+# The index is not part of the consensus state and starts from the enabling height
+contract-event-indexing = "{{ .WASMConfig.ContractEventIndexing }}"
 `
@@ -32,7 +32,17 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 			}
 		}
 
+		if code.CodeInfo.InstantiateConfig.Permission == types.AccessTypeUnspecified {
+			code.CodeInfo.InstantiateConfig = types.AllowEverybody
+		}
+
 		keeper.SetCodeInfo(ctx, code.CodeInfo.CodeID, code.CodeInfo)
+
+		if code.Pinned {
+			if err := keeper.PinCode(ctx, code.CodeInfo.CodeID); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	for _, contract := range data.Contracts {
@@ -41,8 +51,38 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 			panic(err)
 		}
 
+		// the storage size is counted from the imported contract store
+		contract.ContractInfo.StorageSize = 0
 		keeper.SetContractInfo(ctx, contractAddr, contract.ContractInfo)
 		keeper.SetContractStore(ctx, contractAddr, contract.ContractStore)
+
+		// the genesis without contract history starts the history from the import
+		if len(contract.ContractHistory) == 0 {
+			creatorAddr, err := sdk.AccAddressFromBech32(contract.ContractInfo.Creator)
+			if err != nil {
+				panic(err)
+			}
+
+			keeper.AppendContractHistory(ctx, contractAddr, types.NewContractHistoryEntry(
+				types.ContractHistoryOperationTypeGenesis,
+				contract.ContractInfo.CodeID,
+				ctx.BlockHeight(),
+				creatorAddr,
+				contract.ContractInfo.Admin,
+				contract.ContractInfo.InitMsg,
+			))
+		} else {
+			keeper.AppendContractHistory(ctx, contractAddr, contract.ContractHistory...)
+		}
+	}
+
+	keeper.SetLastCronJobID(ctx, data.LastCronJobID)
+	for _, job := range data.CronJobs {
+		keeper.SetCronJob(ctx, job)
+	}
+
+	for _, sponsorship := range data.GasSponsorships {
+		keeper.SetGasSponsorship(ctx, sponsorship)
 	}
 }
 
@@ -78,6 +118,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		codes = append(codes, types.Code{
 			CodeInfo:  codeInfo,
 			CodeBytes: bytecode,
+			Pinned:    keeper.IsPinnedCode(ctx, i),
 		})
 	}
 
@@ -98,14 +139,28 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		}
 
 		contracts = append(contracts, types.Contract{
-			ContractInfo:  contract,
-			ContractStore: models,
+			ContractInfo:    contract,
+			ContractStore:   models,
+			ContractHistory: keeper.GetContractHistory(ctx, contractAddr),
 		})
 
 		return false
 	})
 
+	var cronJobs []types.CronJob
+	keeper.IterateCronJobs(ctx, func(job types.CronJob) bool {
+		cronJobs = append(cronJobs, job)
+		return false
+	})
+
+	// the sponsored spendings are not exported, the limits start over from the import
+	var gasSponsorships []types.GasSponsorship
+	keeper.IterateGasSponsorships(ctx, func(sponsorship types.GasSponsorship) bool {
+		gasSponsorships = append(gasSponsorships, sponsorship)
+		return false
+	})
+
 	params := keeper.GetParams(ctx)
 
-	return types.NewGenesisState(params, lastCodeID, lastInstanceID, codes, contracts)
+	return types.NewGenesisState(params, lastCodeID, lastInstanceID, codes, contracts, keeper.GetLastCronJobID(ctx), cronJobs, gasSponsorships)
 }
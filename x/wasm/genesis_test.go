@@ -46,7 +46,7 @@ func TestInitGenesis(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	initCmd := types.NewMsgInstantiateContract(creator, creator, 1, initMsgBz, deposit)
+	initCmd := types.NewMsgInstantiateContract(creator, creator, 1, initMsgBz, deposit, "")
 	res, err := h(input.Ctx, initCmd)
 	require.NoError(t, err)
 
@@ -77,7 +77,8 @@ func TestInitGenesis(t *testing.T) {
 	require.NoError(t, sdkErr)
 	require.Equal(t, testContract, bytecode)
 
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, creator, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, creator, initMsgBz, "")
+	expectedContractInfo.StorageSize = contractStorageSize(input.Ctx, input.WasmKeeper, contractAddr)
 	contractInfo, sdkErr := input.WasmKeeper.GetContractInfo(input.Ctx, contractAddr)
 	require.NoError(t, sdkErr)
 	require.Equal(t, expectedContractInfo, contractInfo)
@@ -96,8 +97,17 @@ func TestInitGenesis(t *testing.T) {
 
 	assertContractStore(t, models, expectedConfigState)
 
+	expectedHistory := []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeInit, 1, input.Ctx.BlockHeight(), creator, creator.String(), initMsgBz),
+	}
+	require.Equal(t, expectedHistory, input.WasmKeeper.GetContractHistory(input.Ctx, contractAddr))
+
+	require.NoError(t, input.WasmKeeper.PinCode(input.Ctx, 2))
+
 	// export into genstate
 	genState := wasm.ExportGenesis(input.Ctx, input.WasmKeeper)
+	require.False(t, genState.Codes[0].Pinned)
+	require.True(t, genState.Codes[1].Pinned)
 
 	// create new app to import genstate into
 	newInput := keeper.CreateTestInput(t)
@@ -121,4 +131,15 @@ func TestInitGenesis(t *testing.T) {
 	}
 
 	assertContractStore(t, models, expectedConfigState)
+	require.Equal(t, expectedHistory, newInput.WasmKeeper.GetContractHistory(newInput.Ctx, contractAddr))
+	require.False(t, newInput.WasmKeeper.IsPinnedCode(newInput.Ctx, 1))
+	require.True(t, newInput.WasmKeeper.IsPinnedCode(newInput.Ctx, 2))
+
+	// genesis without history starts the history from the import
+	genState.Contracts[0].ContractHistory = nil
+	newInput = keeper.CreateTestInput(t)
+	wasm.InitGenesis(newInput.Ctx, newInput.WasmKeeper, genState)
+	require.Equal(t, []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeGenesis, 1, newInput.Ctx.BlockHeight(), creator, creator.String(), initMsgBz),
+	}, newInput.WasmKeeper.GetContractHistory(newInput.Ctx, contractAddr))
 }
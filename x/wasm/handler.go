@@ -31,6 +31,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err = msgServer.MigrateCode(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgInstantiateContract:
 			res, err = msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgInstantiateContract2:
+			res, err = msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgExecuteContract:
 			res, err = msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgMigrateContract:
@@ -39,6 +41,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err = msgServer.UpdateContractAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgClearContractAdmin:
 			res, err = msgServer.ClearContractAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRegisterCronJob:
+			res, err = msgServer.RegisterCronJob(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgCancelCronJob:
+			res, err = msgServer.CancelCronJob(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSetGasSponsorship:
+			res, err = msgServer.SetGasSponsorship(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRemoveGasSponsorship:
+			res, err = msgServer.RemoveGasSponsorship(sdk.WrapSDKContext(ctx), msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm message type: %T", msg)
@@ -107,7 +107,7 @@ func TestHandleInstantiate(t *testing.T) {
 	require.NoError(t, err)
 
 	// create with no balance is also legal
-	initCmd := types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, 1, initMsgBz, nil)
+	initCmd := types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, 1, initMsgBz, nil, "")
 	res, err := h(input.Ctx, initCmd)
 	require.NoError(t, err)
 
@@ -127,7 +127,8 @@ func TestHandleInstantiate(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := input.WasmKeeper.GetContractInfo(input.Ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, initMsgBz, "")
+	expectedContractInfo.StorageSize = contractStorageSize(input.Ctx, input.WasmKeeper, contractAddr)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	iter := input.WasmKeeper.GetContractStoreIterator(input.Ctx, contractAddr)
@@ -173,7 +174,7 @@ func TestHandleExecute(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	initCmd := types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, 1, initMsgBz, deposit)
+	initCmd := types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, 1, initMsgBz, deposit, "")
 	res, err := h(input.Ctx, initCmd)
 	require.NoError(t, err)
 
@@ -193,7 +194,8 @@ func TestHandleExecute(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := input.WasmKeeper.GetContractInfo(input.Ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, initMsgBz, "")
+	expectedContractInfo.StorageSize = contractStorageSize(input.Ctx, input.WasmKeeper, contractAddr)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	// ensure bob doesn't exist
@@ -268,7 +270,7 @@ func TestHandleExecuteEscrow(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	initCmd := types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, 1, initMsgBz, deposit)
+	initCmd := types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, 1, initMsgBz, deposit, "")
 	res, err := h(input.Ctx, initCmd)
 	require.NoError(t, err)
 
@@ -289,7 +291,8 @@ func TestHandleExecuteEscrow(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := input.WasmKeeper.GetContractInfo(input.Ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, initMsgBz, "")
+	expectedContractInfo.StorageSize = contractStorageSize(input.Ctx, input.WasmKeeper, contractAddr)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	handleMsg := map[string]interface{}{
@@ -360,7 +363,7 @@ func TestHandleMigrate(t *testing.T) {
 	initDataBz, err := json.Marshal(initData)
 	require.NoError(t, err)
 
-	initMsg := types.NewMsgInstantiateContract(creator, creator, 1, initDataBz, deposit)
+	initMsg := types.NewMsgInstantiateContract(creator, creator, 1, initDataBz, deposit, "")
 	res, err = h(input.Ctx, initMsg)
 	require.NoError(t, err)
 
@@ -421,7 +424,7 @@ func TestHandleUpdateAdmin(t *testing.T) {
 	initDataBz, err := json.Marshal(initData)
 	require.NoError(t, err)
 
-	initMsg := types.NewMsgInstantiateContract(creator, creator, 1, initDataBz, deposit)
+	initMsg := types.NewMsgInstantiateContract(creator, creator, 1, initDataBz, deposit, "")
 	res, err := h(input.Ctx, initMsg)
 	require.NoError(t, err)
 
@@ -476,7 +479,7 @@ func TestHandleClearAdmin(t *testing.T) {
 	initDataBz, err := json.Marshal(initData)
 	require.NoError(t, err)
 
-	initMsg := types.NewMsgInstantiateContract(creator, creator, 1, initDataBz, deposit)
+	initMsg := types.NewMsgInstantiateContract(creator, creator, 1, initDataBz, deposit, "")
 	res, err := h(input.Ctx, initMsg)
 	require.NoError(t, err)
 
@@ -504,3 +507,15 @@ func TestHandleClearAdmin(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, len(cInfo.Admin) == 0)
 }
+
+// contractStorageSize returns the key and value bytes of the contract store
+func contractStorageSize(ctx sdk.Context, k keeper.Keeper, contractAddr sdk.AccAddress) (size uint64) {
+	iter := k.GetContractStoreIterator(ctx, contractAddr)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		size += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+
+	return size
+}
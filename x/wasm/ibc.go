@@ -0,0 +1,278 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/keeper"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+var _ porttypes.IBCModule = IBCHandler{}
+
+// IBCHandler implements the IBC module callbacks of the wasm module,
+// which are routed to the contract bound to the port
+type IBCHandler struct {
+	keeper        keeper.Keeper
+	channelKeeper types.ChannelKeeper
+}
+
+// NewIBCHandler returns the IBC module callbacks of the wasm module
+func NewIBCHandler(k keeper.Keeper, channelKeeper types.ChannelKeeper) IBCHandler {
+	return IBCHandler{keeper: k, channelKeeper: channelKeeper}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (i IBCHandler) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterParty channeltypes.Counterparty,
+	version string,
+) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCChannelOpenMsg{
+		OpenInit: &wasmvmtypes.IBCOpenInit{
+			Channel: types.NewIBCChannel(portID, channelID, channeltypes.NewChannel(
+				channeltypes.INIT, order, counterParty, connectionHops, version,
+			)),
+		},
+	}
+	if err := i.keeper.OnOpenChannel(ctx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	// claim channel capability passed back by IBC module
+	if err := i.keeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return sdkerrors.Wrap(err, "claim capability")
+	}
+
+	return nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (i IBCHandler) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterParty channeltypes.Counterparty,
+	version, counterpartyVersion string,
+) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCChannelOpenMsg{
+		OpenTry: &wasmvmtypes.IBCOpenTry{
+			Channel: types.NewIBCChannel(portID, channelID, channeltypes.NewChannel(
+				channeltypes.TRYOPEN, order, counterParty, connectionHops, version,
+			)),
+			CounterpartyVersion: counterpartyVersion,
+		},
+	}
+	if err := i.keeper.OnOpenChannel(ctx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	// module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
+	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
+	// If the module can already authenticate the capability then the module already owns it so we don't need to claim
+	// Otherwise, module does not have channel capability and we must claim it from IBC
+	if !i.keeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)) {
+		// only claim channel capability passed back by IBC module if we do not already own it
+		if err := i.keeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return sdkerrors.Wrap(err, "claim capability")
+		}
+	}
+
+	return nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (i IBCHandler) OnChanOpenAck(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyVersion string,
+) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelConnectMsg{
+		OpenAck: &wasmvmtypes.IBCOpenAck{
+			Channel:             types.NewIBCChannel(portID, channelID, channelInfo),
+			CounterpartyVersion: counterpartyVersion,
+		},
+	}
+
+	return i.keeper.OnConnectChannel(ctx, contractAddr, msg)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (i IBCHandler) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelConnectMsg{
+		OpenConfirm: &wasmvmtypes.IBCOpenConfirm{
+			Channel: types.NewIBCChannel(portID, channelID, channelInfo),
+		},
+	}
+
+	return i.keeper.OnConnectChannel(ctx, contractAddr, msg)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (i IBCHandler) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelCloseMsg{
+		CloseInit: &wasmvmtypes.IBCCloseInit{
+			Channel: types.NewIBCChannel(portID, channelID, channelInfo),
+		},
+	}
+
+	// the channel state is set to closed by the IBC module after the callback succeeds
+	return i.keeper.OnCloseChannel(ctx, contractAddr, msg)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (i IBCHandler) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelCloseMsg{
+		CloseConfirm: &wasmvmtypes.IBCCloseConfirm{
+			Channel: types.NewIBCChannel(portID, channelID, channelInfo),
+		},
+	}
+
+	// the channel state is set to closed by the IBC module after the callback succeeds
+	return i.keeper.OnCloseChannel(ctx, contractAddr, msg)
+}
+
+// OnRecvPacket implements the IBCModule interface; the contract errors are returned
+// as error acknowledgements, so the state changes of the contract are discarded
+func (i IBCHandler) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	contractAddr, err := types.ContractFromPortID(packet.DestinationPort)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(err, "contract port id").Error())
+	}
+
+	msg := wasmvmtypes.IBCPacketReceiveMsg{Packet: types.NewIBCPacket(packet)}
+	ack, err := i.keeper.OnRecvPacket(ctx, contractAddr, msg)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return ContractAcknowledgement{ack}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (i IBCHandler) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	contractAddr, err := types.ContractFromPortID(packet.SourcePort)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCPacketAckMsg{
+		Acknowledgement: wasmvmtypes.IBCAcknowledgement{Data: acknowledgement},
+		OriginalPacket:  types.NewIBCPacket(packet),
+	}
+	if err := i.keeper.OnAckPacket(ctx, contractAddr, msg); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (i IBCHandler) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) (*sdk.Result, error) {
+	contractAddr, err := types.ContractFromPortID(packet.SourcePort)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCPacketTimeoutMsg{Packet: types.NewIBCPacket(packet)}
+	if err := i.keeper.OnTimeoutPacket(ctx, contractAddr, msg); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+var _ ibcexported.Acknowledgement = ContractAcknowledgement{}
+
+// ContractAcknowledgement is the successful acknowledgement
+// with the raw acknowledgement data returned by the contract
+type ContractAcknowledgement struct {
+	data []byte
+}
+
+// Success implements the ibc Acknowledgement interface
+func (a ContractAcknowledgement) Success() bool {
+	return true
+}
+
+// Acknowledgement implements the ibc Acknowledgement interface
+func (a ContractAcknowledgement) Acknowledgement() []byte {
+	return a.data
+}
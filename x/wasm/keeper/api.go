@@ -53,6 +53,11 @@ func (k Keeper) getWasmVMGasMeter(ctx sdk.Context) wasmGasMeter {
 	}
 }
 
+// return querier for the contract with the context
+func (k Keeper) getWasmVMQuerier(ctx sdk.Context, contractAddress sdk.AccAddress) types.Querier {
+	return k.querier.WithCtx(ctx).WithContractAddr(contractAddress)
+}
+
 // return remaining gas in wasm gas unit
 func (k Keeper) getWasmVMGasRemaining(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// authorizationPolicy decides whether an actor is allowed to
+// upload codes, instantiate contracts and modify contracts
+type authorizationPolicy interface {
+	CanUploadCode(uploadAccess types.AccessConfig, actor sdk.AccAddress) bool
+	CanInstantiateContract(instantiateConfig types.AccessConfig, actor sdk.AccAddress) bool
+	CanModifyContract(admin string, actor sdk.AccAddress) bool
+}
+
+// DefaultAuthorizationPolicy enforces the upload access param,
+// the instantiate permission of the code and the contract admin;
+// it is used for the messages sent by the accounts and contracts
+type DefaultAuthorizationPolicy struct{}
+
+var _ authorizationPolicy = DefaultAuthorizationPolicy{}
+
+// CanUploadCode implements authorizationPolicy
+func (p DefaultAuthorizationPolicy) CanUploadCode(uploadAccess types.AccessConfig, actor sdk.AccAddress) bool {
+	return uploadAccess.Allowed(actor)
+}
+
+// CanInstantiateContract implements authorizationPolicy
+func (p DefaultAuthorizationPolicy) CanInstantiateContract(instantiateConfig types.AccessConfig, actor sdk.AccAddress) bool {
+	return instantiateConfig.Allowed(actor)
+}
+
+// CanModifyContract implements authorizationPolicy
+func (p DefaultAuthorizationPolicy) CanModifyContract(admin string, actor sdk.AccAddress) bool {
+	return admin != "" && admin == actor.String()
+}
+
+// GovAuthorizationPolicy allows everything; it is used for
+// the passed governance proposals
+type GovAuthorizationPolicy struct{}
+
+var _ authorizationPolicy = GovAuthorizationPolicy{}
+
+// CanUploadCode implements authorizationPolicy
+func (p GovAuthorizationPolicy) CanUploadCode(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+// CanInstantiateContract implements authorizationPolicy
+func (p GovAuthorizationPolicy) CanInstantiateContract(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+// CanModifyContract implements authorizationPolicy
+func (p GovAuthorizationPolicy) CanModifyContract(string, sdk.AccAddress) bool {
+	return true
+}
+
+// withAuthorizationPolicy returns a copy of the keeper using the given policy
+func (k Keeper) withAuthorizationPolicy(policy authorizationPolicy) Keeper {
+	k.authPolicy = policy
+	return k
+}
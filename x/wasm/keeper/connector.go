@@ -24,6 +24,9 @@ func (k Keeper) dispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, m
 
 		// first, we build a sub-context which we can use inside the submessages
 		subCtx, commit := ctx.CacheContext()
+		if k.tracer != nil {
+			subCtx = types.WithIncreasedSubMsgDepth(subCtx)
+		}
 
 		// check how much gas left locally, optionally wrap the gas meter
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
@@ -120,6 +123,11 @@ func (k Keeper) dispatchMessageWithGasLimit(ctx sdk.Context, contractAddr sdk.Ac
 
 // dispatchMessage does not emit events to prevent duplicate emission
 func (k Keeper) dispatchMessage(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.CosmosMsg) (events sdk.Events, data []byte, err error) {
+	// IBC packets have no sdk msg, so the keeper sends them through the contract port
+	if msg.IBC != nil && msg.IBC.SendPacket != nil {
+		return k.sendIBCPacket(ctx, contractAddr, msg.IBC.SendPacket)
+	}
+
 	sdkMsg, err := k.msgParser.Parse(ctx, contractAddr, msg)
 	if err != nil {
 		return nil, nil, err
@@ -170,6 +178,12 @@ func (k Keeper) handleSdkMessage(ctx sdk.Context, contractAddr sdk.AccAddress, m
 		}
 	}
 
+	for _, filter := range k.msgFilters {
+		if err := filter(ctx, contractAddr, msg); err != nil {
+			return nil, err
+		}
+	}
+
 	// find the handler and execute it
 	h := k.serviceRouter.Handler(msg)
 	if h == nil {
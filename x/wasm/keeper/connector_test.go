@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	core "github.com/bitwebs/iq-core/types"
+)
+
+func TestHandleSdkMessageFilters(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper, bankKeeper := input.Ctx, input.AccKeeper, input.WasmKeeper, input.BankKeeper
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+	contractAddr := createFakeFundedAccount(ctx, accKeeper, bankKeeper, coins)
+	_, _, bob := keyPubAddr()
+
+	// the filter rejects the sends to bob
+	var filtered []sdk.Msg
+	keeper.RegisterMsgFilters(func(ctx sdk.Context, sender sdk.AccAddress, msg sdk.Msg) error {
+		require.Equal(t, contractAddr, sender)
+		filtered = append(filtered, msg)
+
+		if send, ok := msg.(*banktypes.MsgSend); ok && send.ToAddress == bob.String() {
+			return sdkerrors.ErrUnauthorized
+		}
+
+		return nil
+	})
+
+	_, err := keeper.handleSdkMessage(ctx, contractAddr, banktypes.NewMsgSend(contractAddr, bob, coins))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.True(t, bankKeeper.GetAllBalances(ctx, bob).IsZero())
+
+	_, _, alice := keyPubAddr()
+	_, err = keeper.handleSdkMessage(ctx, contractAddr, banktypes.NewMsgSend(contractAddr, alice, coins))
+	require.NoError(t, err)
+	require.Equal(t, coins, bankKeeper.GetAllBalances(ctx, alice))
+	require.Len(t, filtered, 2)
+
+	// the msgs without the permission of the contract are rejected before the filters
+	_, err = keeper.handleSdkMessage(ctx, contractAddr, banktypes.NewMsgSend(bob, alice, coins))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Len(t, filtered, 2)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // CompileCode uncompress the wasm code bytes and store the code to local file system
@@ -38,8 +40,18 @@ func (k Keeper) CompileCode(ctx sdk.Context, wasmCode []byte) (codeHash []byte,
 	return
 }
 
-// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code
-func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte) (codeID uint64, err error) {
+// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code.
+// The code can be instantiated by everybody when no instantiate permission is given.
+func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiatePermission *types.AccessConfig) (codeID uint64, err error) {
+	if !k.authPolicy.CanUploadCode(k.CodeUploadAccess(ctx), creator) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not upload code")
+	}
+
+	instantiateConfig := types.AllowEverybody
+	if instantiatePermission != nil {
+		instantiateConfig = *instantiatePermission
+	}
+
 	codeHash, err := k.CompileCode(ctx, wasmCode)
 	if err != nil {
 		return 0, err
@@ -51,7 +63,7 @@ func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []by
 	}
 
 	codeID++
-	codeInfo := types.NewCodeInfo(codeID, codeHash, creator)
+	codeInfo := types.NewCodeInfo(codeID, codeHash, creator, instantiateConfig)
 
 	k.SetLastCodeID(ctx, codeID)
 	k.SetCodeInfo(ctx, codeID, codeInfo)
@@ -82,6 +94,30 @@ func (k Keeper) MigrateCode(ctx sdk.Context, codeID uint64, creator sdk.AccAddre
 
 	codeInfo.CodeHash = codeHash
 	k.SetCodeInfo(ctx, codeID, codeInfo)
+
+	// record the bytecode replacement in the history of all the contracts of the code;
+	// addresses are collected first to not write the store while iterating it
+	var contractAddresses []sdk.AccAddress
+	k.IterateContractsByCode(ctx, codeID, func(contractAddress sdk.AccAddress) bool {
+		contractAddresses = append(contractAddresses, contractAddress)
+		return false
+	})
+
+	for _, contractAddress := range contractAddresses {
+		contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+		if err != nil {
+			return err
+		}
+
+		k.AppendContractHistory(ctx, contractAddress, types.NewContractHistoryEntry(
+			types.ContractHistoryOperationTypeCodeMigrate,
+			codeID,
+			ctx.BlockHeight(),
+			creator,
+			contractInfo.Admin,
+			nil,
+		))
+	}
 	k.Logger(ctx).Debug("storing new contract", "code_id", codeID)
 
 	return nil
@@ -94,33 +130,117 @@ func (k Keeper) InstantiateContract(
 	creator sdk.AccAddress,
 	admin sdk.AccAddress,
 	initMsg []byte,
-	deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
+	deposit sdk.Coins,
+	label string) (sdk.AccAddress, []byte, error) {
+	instanceID, err := k.GetLastInstanceID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instanceID++
+
+	contractAddress, data, err := k.instantiate(ctx, codeID, creator, admin, initMsg, deposit, label, func(types.CodeInfo) sdk.AccAddress {
+		return types.GenerateContractAddress(codeID, instanceID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k.SetLastInstanceID(ctx, instanceID)
+	return contractAddress, data, nil
+}
+
+// InstantiateContract2 creates an instance of a WASM contract at the address
+// derived from the code hash, the creator and the salt
+func (k Keeper) InstantiateContract2(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	admin sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	salt []byte,
+	label string) (sdk.AccAddress, []byte, error) {
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, nil, err
+	}
+
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, deposit, label, func(codeInfo types.CodeInfo) sdk.AccAddress {
+		return types.GenerateContractAddress2(codeInfo.CodeHash, creator, salt)
+	})
+}
+
+// PredictContractAddress returns the address of the contract instantiated
+// by InstantiateContract2 with the given code, creator and salt
+func (k Keeper) PredictContractAddress(ctx sdk.Context, codeID uint64, creator sdk.AccAddress, salt []byte) (sdk.AccAddress, error) {
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, err
+	}
+
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.GenerateContractAddress2(codeInfo.CodeHash, creator, salt), nil
+}
+
+// instantiate creates an instance of a WASM contract at the address
+// returned by generateAddress
+func (k Keeper) instantiate(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	admin sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	label string,
+	generateAddress func(codeInfo types.CodeInfo) sdk.AccAddress) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
 	ctx.GasMeter().ConsumeGas(types.RegisterContractCosts(), "Registering contract to the store")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(initMsg)), "Loading CosmWasm module: init")
 
 	if uint64(len(initMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "init msg size is too huge")
 	}
 
-	instanceID, err := k.GetLastInstanceID(ctx)
-	if err != nil {
+	if err := types.ValidateLabel(label); err != nil {
 		return nil, nil, err
 	}
 
-	instanceID++
+	// get code info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeInfoKey(codeID))
+	if bz == nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", codeID)
+	}
+
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshal(bz, &codeInfo)
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeID), len(initMsg)), "Loading CosmWasm module: init")
+
+	if !k.authPolicy.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
 
 	// create contract address
-	contractAddress := types.GenerateContractAddress(codeID, instanceID)
+	contractAddress := generateAddress(codeInfo)
+	if store.Has(types.GetContractInfoKey(contractAddress)) {
+		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, contractAddress.String())
+	}
+
+	// the predictable address may have been funded before the instantiation, which leaves
+	// a plain account never used by a signer; any other existing account is rejected
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		if _, ok := existingAcct.(*authtypes.BaseAccount); !ok || existingAcct.GetPubKey() != nil || existingAcct.GetSequence() != 0 {
+			return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		}
+	} else {
+		// create contract account
+		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
+		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// create contract account
-	contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
-	k.accountKeeper.SetAccount(ctx, contractAccount)
-
 	// deposit initial contract funds
 	if !deposit.IsZero() {
 		if err := k.bankKeeper.SendCoins(ctx, creator, contractAddress, deposit); err != nil {
@@ -128,23 +248,20 @@ func (k Keeper) InstantiateContract(
 		}
 	}
 
-	// get code info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeInfoKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", codeID)
+	// bind the IBC port of the IBC-enabled contract
+	ibcPortID, err := k.ensureIBCPortOfCode(ctx, contractAddress, codeInfo)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
 
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshal(bz, &codeInfo)
-
 	// prepare env and info for contract instantiate call
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(creator, deposit)
 
 	// create prefixed data store
 	contractStoreKey := types.GetContractStoreKey(contractAddress)
-	contractStore := prefix.NewStore(types.KVStore(ctx, k.storeKey), contractStoreKey)
+	storageSizeStore := types.NewStorageSizeStore(ctx, k.storeKey, contractStoreKey)
+	trace, contractStore := k.startContractTrace(ctx, types.TraceCallInstantiate, contractAddress, storageSizeStore)
 
 	// instantiate wasm contract
 	res, gasUsed, err := k.wasmVM.Instantiate(
@@ -154,12 +271,14 @@ func (k Keeper) InstantiateContract(
 		initMsg,
 		contractStore,
 		k.getCosmWasmAPI(ctx),
-		k.querier.WithCtx(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
 		k.getWasmVMGasMeter(ctx),
 		k.getWasmVMGasRemaining(ctx),
 		types.JSONDeserializationWasmGasCost,
 	)
 
+	k.finishContractTrace(ctx, trace, gasUsed, err)
+
 	// add types.GasMultiplier to occur out of gas panic
 	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract initialize")
 	if err != nil {
@@ -170,10 +289,18 @@ func (k Keeper) InstantiateContract(
 	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
 
 	// Must store contract info first, so last part can use it
-	contractInfo := types.NewContractInfo(codeID, contractAddress, creator, admin, initMsg)
-
-	k.SetLastInstanceID(ctx, instanceID)
+	contractInfo := types.NewContractInfo(codeID, contractAddress, creator, admin, initMsg, label)
+	contractInfo.IBCPortID = ibcPortID
+	contractInfo.AddStorageSize(storageSizeStore.SizeDelta())
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractHistoryEntry(
+		types.ContractHistoryOperationTypeInit,
+		codeID,
+		ctx.BlockHeight(),
+		creator,
+		contractInfo.Admin,
+		initMsg,
+	))
 
 	// parse wasm events to sdk events
 	events, err := types.ParseEvents(contractAddress, res.Attributes, res.Events)
@@ -203,17 +330,22 @@ func (k Keeper) ExecuteContract(
 	execMsg []byte,
 	coins sdk.Coins) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(execMsg)), "Loading CosmWasm module: execute")
 
 	if uint64(len(execMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "execute msg size is too huge")
 	}
 
-	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
+	contractInfo, codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	if contractInfo.Frozen {
+		return nil, sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", contractAddress)
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), len(execMsg)), "Loading CosmWasm module: execute")
+
 	// add more funds
 	if !coins.IsZero() {
 		err = k.bankKeeper.SendCoins(ctx, sender, contractAddress, coins)
@@ -224,25 +356,30 @@ func (k Keeper) ExecuteContract(
 
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(sender, coins)
+	trace, contractStore := k.startContractTrace(ctx, types.TraceCallExecute, contractAddress, storePrefix)
 	res, gasUsed, err := k.wasmVM.Execute(
 		codeInfo.CodeHash,
 		env,
 		info,
 		execMsg,
-		storePrefix,
+		contractStore,
 		k.getCosmWasmAPI(ctx),
-		k.querier.WithCtx(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
 		k.getWasmVMGasMeter(ctx),
 		k.getWasmVMGasRemaining(ctx),
 		types.JSONDeserializationWasmGasCost,
 	)
 
+	k.finishContractTrace(ctx, trace, gasUsed, err)
+
 	// add types.GasMultiplier to occur out of gas panic
 	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract Execution")
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, err.Error())
 	}
 
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
 	// consume gas for wasm events
 	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
 
@@ -274,7 +411,6 @@ func (k Keeper) MigrateContract(
 	newCodeID uint64,
 	migrateMsg []byte) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(migrateMsg)), "Loading CosmWasm module: migrate")
 
 	if uint64(len(migrateMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "migrate msg size is too huge")
@@ -285,11 +421,15 @@ func (k Keeper) MigrateContract(
 		return nil, err
 	}
 
+	if contractInfo.Frozen {
+		return nil, sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", contractAddress)
+	}
+
 	if contractInfo.Admin == "" {
 		return nil, types.ErrNotMigratable
 	}
 
-	if contractInfo.Admin != sender.String() {
+	if !k.authPolicy.CanModifyContract(contractInfo.Admin, sender) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "no permission")
 	}
 
@@ -298,11 +438,13 @@ func (k Keeper) MigrateContract(
 		return nil, err
 	}
 
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(migrateMsg)), "Loading CosmWasm module: migrate")
+
 	env := types.NewEnv(ctx, contractAddress)
 
 	// prepare necessary meta data
 	prefixStoreKey := types.GetContractStoreKey(contractAddress)
-	prefixStore := prefix.NewStore(types.KVStore(ctx, k.storeKey), prefixStoreKey)
+	prefixStore := types.NewStorageSizeStore(ctx, k.storeKey, prefixStoreKey)
 
 	res, gasUsed, err := k.wasmVM.Migrate(
 		newCodeInfo.CodeHash,
@@ -310,7 +452,7 @@ func (k Keeper) MigrateContract(
 		migrateMsg,
 		prefixStore,
 		k.getCosmWasmAPI(ctx),
-		k.querier.WithCtx(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
 		k.getWasmVMGasMeter(ctx),
 		k.getWasmVMGasRemaining(ctx),
 		types.JSONDeserializationWasmGasCost,
@@ -334,9 +476,136 @@ func (k Keeper) MigrateContract(
 	// emit events
 	ctx.EventManager().EmitEvents(events)
 
+	// bind the IBC port when the contract is migrated to an IBC-enabled code
+	if contractInfo.IBCPortID == "" {
+		ibcPortID, err := k.ensureIBCPortOfCode(ctx, contractAddress, newCodeInfo)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
+		}
+
+		contractInfo.IBCPortID = ibcPortID
+	}
+
 	contractInfo.CodeID = newCodeID
+	contractInfo.AddStorageSize(prefixStore.SizeDelta())
+	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractHistoryEntry(
+		types.ContractHistoryOperationTypeMigrate,
+		newCodeID,
+		ctx.BlockHeight(),
+		sender,
+		contractInfo.Admin,
+		migrateMsg,
+	))
+
+	// dispatch submessages and messages
+	respData := res.Data
+	if replyData, err := k.dispatchMessages(ctx, contractAddress, res.Messages...); err != nil {
+		return nil, sdkerrors.Wrap(err, "dispatch")
+	} else if replyData != nil {
+		respData = replyData
+	}
+
+	return respData, nil
+}
+
+// UpdateContractAdmin sets the new admin of the contract;
+// the admin is cleared when the new admin is empty
+func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if !k.authPolicy.CanModifyContract(contractInfo.Admin, caller) {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	contractInfo.Admin = ""
+	if !newAdmin.Empty() {
+		contractInfo.Admin = newAdmin.String()
+	}
+
+	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractHistoryEntry(
+		types.ContractHistoryOperationTypeAdminUpdate,
+		contractInfo.CodeID,
+		ctx.BlockHeight(),
+		caller,
+		contractInfo.Admin,
+		nil,
+	))
+
+	return nil
+}
+
+// SetContractFrozen freezes or unfreezes the contract; a frozen contract fails
+// the execution, the migration and the replies but still answers the queries
+func (k Keeper) SetContractFrozen(ctx sdk.Context, contractAddress sdk.AccAddress, frozen bool) error {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contractInfo.Frozen == frozen {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "contract %s frozen is already %t", contractAddress, frozen)
+	}
+
+	contractInfo.Frozen = frozen
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
 
+	return nil
+}
+
+// Sudo calls the sudo entry point of the contract, which cannot be triggered
+// by any transaction but only by the chain itself, eg by the cron jobs or the
+// governance; it is allowed also on a frozen contract
+func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+
+	_, codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), len(msg)), "Loading CosmWasm module: sudo")
+
+	env := types.NewEnv(ctx, contractAddress)
+	trace, contractStore := k.startContractTrace(ctx, types.TraceCallSudo, contractAddress, storePrefix)
+	res, gasUsed, err := k.wasmVM.Sudo(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		contractStore,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	k.finishContractTrace(ctx, trace, gasUsed, err)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract Sudo")
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSudoFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	// consume gas for wasm events
+	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
+
+	// parse wasm events to sdk events
+	events, err := types.ParseEvents(contractAddress, res.Attributes, res.Events)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "event validation failed")
+	}
+
+	// emit events
+	ctx.EventManager().EmitEvents(events)
+
 	// dispatch submessages and messages
 	respData := res.Data
 	if replyData, err := k.dispatchMessages(ctx, contractAddress, res.Messages...); err != nil {
@@ -355,32 +624,42 @@ func (k Keeper) reply(
 	contractAddress sdk.AccAddress,
 	reply wasmvmtypes.Reply) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "reply")
-	ctx.GasMeter().ConsumeGas(types.ReplyCosts(reply), "Loading CosmWasm module: reply")
 
-	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
+	contractInfo, codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	if contractInfo.Frozen {
+		return nil, sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", contractAddress)
+	}
+
+	ctx.GasMeter().ConsumeGas(types.ReplyCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), reply), "Loading CosmWasm module: reply")
+
 	env := types.NewEnv(ctx, contractAddress)
+	trace, contractStore := k.startContractTrace(ctx, types.TraceCallReply, contractAddress, storePrefix)
 	res, gasUsed, err := k.wasmVM.Reply(
 		codeInfo.CodeHash,
 		env,
 		reply,
-		storePrefix,
+		contractStore,
 		k.getCosmWasmAPI(ctx),
-		k.querier.WithCtx(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
 		k.getWasmVMGasMeter(ctx),
 		k.getWasmVMGasRemaining(ctx),
 		types.JSONDeserializationWasmGasCost,
 	)
 
+	k.finishContractTrace(ctx, trace, gasUsed, err)
+
 	// add types.GasMultiplier to occur out of gas panic
 	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract Reply")
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrReplyFailed, err.Error())
 	}
 
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
 	// consume gas for wasm events
 	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
 
@@ -418,13 +697,14 @@ func (k Keeper) queryToStore(ctx sdk.Context, contractAddress sdk.AccAddress, ke
 
 func (k Keeper) queryToContract(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "query-smart")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(queryMsg)), "Loading CosmWasm module: query")
 
-	codeInfo, contractStorePrefix, err := k.getContractDetails(ctx, contractAddress)
+	_, codeInfo, contractStorePrefix, err := k.getContractDetails(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), len(queryMsg)), "Loading CosmWasm module: query")
+
 	env := types.NewEnv(ctx, contractAddress)
 
 	// assert and increase query depth
@@ -433,18 +713,21 @@ func (k Keeper) queryToContract(ctx sdk.Context, contractAddress sdk.AccAddress,
 		return nil, err
 	}
 
+	trace, contractStore := k.startContractTrace(ctx, types.TraceCallQuery, contractAddress, contractStorePrefix)
 	queryResult, gasUsed, err := k.wasmVM.Query(
 		codeInfo.CodeHash,
 		env,
 		queryMsg,
-		contractStorePrefix,
+		contractStore,
 		k.getCosmWasmAPI(ctx),
-		k.querier.WithCtx(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
 		k.getWasmVMGasMeter(ctx),
 		k.getWasmVMGasRemaining(ctx),
 		types.JSONDeserializationWasmGasCost,
 	)
 
+	k.finishContractTrace(ctx, trace, gasUsed, err)
+
 	// add types.GasMultiplier to occur out of gas panic
 	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract Query")
 	if err != nil {
@@ -472,7 +755,7 @@ func assertAndIncreaseQueryDepth(ctx sdk.Context) (sdk.Context, error) {
 	return ctx, nil
 }
 
-func (k Keeper) getContractDetails(ctx sdk.Context, contractAddress sdk.AccAddress) (codeInfo types.CodeInfo, contractStorePrefix prefix.Store, err error) {
+func (k Keeper) getContractDetails(ctx sdk.Context, contractAddress sdk.AccAddress) (contractInfo types.ContractInfo, codeInfo types.CodeInfo, contractStorePrefix *types.StorageSizeStore, err error) {
 	store := ctx.KVStore(k.storeKey)
 
 	bz := store.Get(types.GetContractInfoKey(contractAddress))
@@ -481,7 +764,6 @@ func (k Keeper) getContractDetails(ctx sdk.Context, contractAddress sdk.AccAddre
 		return
 	}
 
-	var contractInfo types.ContractInfo
 	k.cdc.MustUnmarshal(bz, &contractInfo)
 
 	bz = store.Get(types.GetCodeInfoKey(contractInfo.CodeID))
@@ -492,6 +774,99 @@ func (k Keeper) getContractDetails(ctx sdk.Context, contractAddress sdk.AccAddre
 
 	k.cdc.MustUnmarshal(bz, &codeInfo)
 	contractStoreKey := types.GetContractStoreKey(contractAddress)
-	contractStorePrefix = prefix.NewStore(types.KVStore(ctx, k.storeKey), contractStoreKey)
+	contractStorePrefix = types.NewStorageSizeStore(ctx, k.storeKey, contractStoreKey)
 	return
 }
+
+// PinCode pins the code to the wasmvm in-memory cache,
+// such that it is always loaded quickly when executed;
+// the pin is stored to be restored on the node start
+func (k Keeper) PinCode(ctx sdk.Context, codeID uint64) error {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
+		return sdkerrors.Wrap(types.ErrPinContractFailed, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPinnedCodeIndexKey(codeID), []byte{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	return nil
+}
+
+// UnpinCode removes the code from the wasmvm in-memory cache
+func (k Keeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.wasmVM.Unpin(codeInfo.CodeHash); err != nil {
+		return sdkerrors.Wrap(types.ErrUnpinContractFailed, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPinnedCodeIndexKey(codeID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	return nil
+}
+
+// IsPinnedCode returns whether the code is pinned in the wasmvm cache. The pin index
+// is read without gas, so the lookup does not charge the calls of the unpinned codes.
+func (k Keeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	return store.Has(types.GetPinnedCodeIndexKey(codeID))
+}
+
+// IteratePinnedCodeIDs iterates the ids of the pinned codes
+func (k Keeper) IteratePinnedCodeIDs(ctx sdk.Context, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.BigEndianToUint64(iter.Key())) {
+			break
+		}
+	}
+}
+
+// InitializePinnedCodes pins the stored pinned codes in the wasmvm cache;
+// it must be called on the node start because the cache is not persisted
+func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
+	var err error
+	k.IteratePinnedCodeIDs(ctx, func(codeID uint64) bool {
+		var codeInfo types.CodeInfo
+		codeInfo, err = k.GetCodeInfo(ctx, codeID)
+		if err != nil {
+			return true
+		}
+
+		if err = k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
+			err = sdkerrors.Wrapf(types.ErrPinContractFailed, "code id %d: %s", codeID, err)
+			return true
+		}
+
+		return false
+	})
+
+	return err
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,7 +30,7 @@ func TestStoreCode(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create contract
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), codeID)
 
@@ -39,6 +40,77 @@ func TestStoreCode(t *testing.T) {
 	require.Equal(t, wasmCode, storedCode)
 }
 
+func TestStoreCodeUploadAccess(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	other := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.CodeUploadAccess = types.AllowNobody
+	keeper.SetParams(ctx, params)
+
+	_, err = keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	params.CodeUploadAccess = types.NewOnlyAddressesAccessConfig(creator)
+	keeper.SetParams(ctx, params)
+
+	_, err = keeper.StoreCode(ctx, other, wasmCode, nil)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	// everybody can instantiate when no permission is given
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowEverybody, codeInfo.InstantiateConfig)
+}
+
+func TestPinCode(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	// the lookup does not consume gas
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.False(t, keeper.IsPinnedCode(ctx, codeID))
+	require.Equal(t, gasBefore, ctx.GasMeter().GasConsumed())
+
+	require.NoError(t, keeper.PinCode(ctx, codeID))
+	require.True(t, keeper.IsPinnedCode(ctx, codeID))
+
+	var pinned []uint64
+	keeper.IteratePinnedCodeIDs(ctx, func(id uint64) bool {
+		pinned = append(pinned, id)
+		return false
+	})
+	require.Equal(t, []uint64{codeID}, pinned)
+
+	// re-pinning on start succeeds for the persisted codes
+	require.NoError(t, keeper.InitializePinnedCodes(ctx))
+
+	// unknown codes can not be pinned
+	require.Error(t, keeper.PinCode(ctx, codeID+1))
+
+	require.NoError(t, keeper.UnpinCode(ctx, codeID))
+	require.False(t, keeper.IsPinnedCode(ctx, codeID))
+}
+
 func TestMigrateCode(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
@@ -54,6 +126,10 @@ func TestMigrateCode(t *testing.T) {
 		Creator:  creator.String(),
 	})
 
+	// legacy contract of the code, without bytecode
+	contractAddr := types.GenerateContractAddress(codeID, 1)
+	keeper.SetContractInfo(ctx, contractAddr, types.NewContractInfo(codeID, contractAddr, creator, creator, []byte("{}"), ""))
+
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
@@ -64,6 +140,11 @@ func TestMigrateCode(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), codeID)
 
+	// the bytecode replacement is recorded in the contract history
+	require.Equal(t, []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeCodeMigrate, codeID, ctx.BlockHeight(), creator, creator.String(), nil),
+	}, keeper.GetContractHistory(ctx, contractAddr))
+
 	// Verify content
 	storedCode, err := keeper.GetByteCode(ctx, codeID)
 	require.NoError(t, err)
@@ -80,7 +161,7 @@ func TestStoreCodeWithHugeCode(t *testing.T) {
 
 	_, _, creator := keyPubAddr()
 	wasmCode := make([]byte, keeper.MaxContractSize(ctx)+1)
-	_, err := keeper.StoreCode(ctx, creator, wasmCode)
+	_, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "contract size is too huge")
@@ -96,7 +177,7 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm.gzip")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 	// and verify content
@@ -117,7 +198,7 @@ func TestInstantiate(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -132,11 +213,51 @@ func TestInstantiate(t *testing.T) {
 	require.NoError(t, err)
 
 	// create with no balance is also legal
-	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 }
 
+func TestInstantiatePermission(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	other := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	onlyCreator := types.NewOnlyAddressesAccessConfig(creator)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, &onlyCreator)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	_, _, err = keeper.InstantiateContract(ctx, codeID, other, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, _, err = keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	// nobody except the governance can instantiate
+	codeID, err = keeper.StoreCode(ctx, creator, wasmCode, &types.AllowNobody)
+	require.NoError(t, err)
+
+	_, _, err = keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, _, err = keeper.withAuthorizationPolicy(GovAuthorizationPolicy{}).InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.NoError(t, err)
+}
+
 func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
@@ -149,10 +270,87 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.NoError(t, err)
 
 	const nonExistingCodeID = 9999
-	_, _, err = keeper.InstantiateContract(ctx, nonExistingCodeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	_, _, err = keeper.InstantiateContract(ctx, nonExistingCodeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
 	require.Error(t, err, sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", nonExistingCodeID))
 }
 
+func TestInstantiate2(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	salt := []byte("salt")
+	predicted, err := keeper.PredictContractAddress(ctx, codeID, creator, salt)
+	require.NoError(t, err)
+
+	lastInstanceID, err := keeper.GetLastInstanceID(ctx)
+	require.NoError(t, err)
+
+	addr, _, err := keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, salt, "")
+	require.NoError(t, err)
+	require.Equal(t, predicted, addr)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, codeID, contractInfo.CodeID)
+
+	// instance id is not consumed by instantiate2
+	instanceID, err := keeper.GetLastInstanceID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lastInstanceID, instanceID)
+
+	// same salt collides with the existing contract
+	_, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, salt, "")
+	require.ErrorIs(t, err, types.ErrAccountExists)
+
+	// other salt gives a new address
+	addr2, _, err := keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, []byte("other_salt"), "")
+	require.NoError(t, err)
+	require.NotEqual(t, addr, addr2)
+
+	// empty salt is rejected
+	_, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, nil, "")
+	require.Error(t, err)
+
+	// the address funded before the instantiation is accepted
+	prefund := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+	funded, err := keeper.PredictContractAddress(ctx, codeID, creator, []byte("funded"))
+	require.NoError(t, err)
+	require.NoError(t, bankKeeper.SendCoins(ctx, creator, funded, prefund))
+
+	addr3, _, err := keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, prefund, []byte("funded"), "")
+	require.NoError(t, err)
+	require.Equal(t, funded, addr3)
+	require.Equal(t, prefund.Add(prefund...), bankKeeper.GetAllBalances(ctx, addr3))
+
+	// the address used by a signer is rejected
+	used, err := keeper.PredictContractAddress(ctx, codeID, creator, []byte("used"))
+	require.NoError(t, err)
+	usedAcct := accKeeper.NewAccountWithAddress(ctx, used)
+	require.NoError(t, usedAcct.SetSequence(1))
+	accKeeper.SetAccount(ctx, usedAcct)
+
+	_, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, []byte("used"), "")
+	require.ErrorIs(t, err, types.ErrAccountExists)
+}
+
 func TestInstantiateWithBigInitMsg(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
@@ -163,12 +361,12 @@ func TestInstantiateWithBigInitMsg(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	// test max init msg size
 	initMsgBz := make([]byte, keeper.MaxContractMsgSize(ctx)+1)
-	_, _, err = keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	_, _, err = keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "init msg size is too huge")
 }
@@ -185,7 +383,7 @@ func TestExecute(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -196,7 +394,7 @@ func TestExecute(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 
@@ -232,7 +430,7 @@ func TestExecute(t *testing.T) {
 
 	// make sure gas is properly deducted from ctx
 	gasAfter := ctx.GasMeter().GasConsumed()
-	require.True(t, gasAfter-gasBefore > types.InstantiateContractCosts(0))
+	require.True(t, gasAfter-gasBefore > types.InstantiateContractCosts(false, 0))
 
 	// ensure bob now exists and got both payments released
 	bobAcct = accKeeper.GetAccount(ctx, bob)
@@ -272,7 +470,7 @@ func TestExecuteWithHugeMsg(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -283,7 +481,7 @@ func TestExecuteWithHugeMsg(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 
@@ -305,7 +503,7 @@ func TestExecuteWithPanic(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -316,7 +514,7 @@ func TestExecuteWithPanic(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	// let's make sure we get a reasonable error, no panic/crash
@@ -336,7 +534,7 @@ func TestExecuteWithCpuLoop(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -347,7 +545,7 @@ func TestExecuteWithCpuLoop(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	// make sure we set a limit before calling
@@ -372,7 +570,7 @@ func TestExecuteWithStorageLoop(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -383,7 +581,7 @@ func TestExecuteWithStorageLoop(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	// make sure we set a limit before calling
@@ -408,9 +606,9 @@ func TestMigrate(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	originalCodeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	originalCodeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
-	newCodeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	newCodeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 	require.NotEqual(t, originalCodeID, newCodeID)
 
@@ -501,7 +699,7 @@ func TestMigrate(t *testing.T) {
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-			addr, _, err := keeper.InstantiateContract(ctx, originalCodeID, creator, spec.admin, initMsgBz, nil)
+			addr, _, err := keeper.InstantiateContract(ctx, originalCodeID, creator, spec.admin, initMsgBz, nil, "")
 			require.NoError(t, err)
 			if spec.overrideContractAddr != nil {
 				addr = spec.overrideContractAddr
@@ -525,6 +723,70 @@ func TestMigrate(t *testing.T) {
 	}
 }
 
+func TestContractHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	fred := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	originalCodeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+	newCodeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, anyAddr := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: anyAddr,
+	})
+	require.NoError(t, err)
+
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: anyAddr})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	addr, _, err := keeper.InstantiateContract(ctx, originalCodeID, creator, creator, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	_, err = keeper.MigrateContract(ctx, addr, creator, newCodeID, migMsgBz)
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(keeper)
+	ctx = ctx.WithBlockHeight(12)
+	_, err = msgServer.UpdateContractAdmin(sdk.WrapSDKContext(ctx), types.NewMsgUpdateContractAdmin(creator, fred, addr))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(13)
+	_, err = msgServer.ClearContractAdmin(sdk.WrapSDKContext(ctx), types.NewMsgClearContractAdmin(fred, addr))
+	require.NoError(t, err)
+
+	expected := []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeInit, originalCodeID, 10, creator, creator.String(), initMsgBz),
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeMigrate, newCodeID, 11, creator, creator.String(), migMsgBz),
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeAdminUpdate, newCodeID, 12, creator, fred.String(), nil),
+		types.NewContractHistoryEntry(types.ContractHistoryOperationTypeAdminUpdate, newCodeID, 13, fred, "", nil),
+	}
+	require.Equal(t, expected, keeper.GetContractHistory(ctx, addr))
+
+	// paginated query
+	querier := NewQuerier(keeper)
+	res, err := querier.ContractHistory(sdk.WrapSDKContext(ctx), &types.QueryContractHistoryRequest{
+		ContractAddress: addr.String(),
+		Pagination:      &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected[1:3], res.Entries)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+}
+
 func TestMigrateWithDispatchedMessage(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
@@ -538,9 +800,9 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 	burnerCode, err := ioutil.ReadFile("./testdata/burner.wasm")
 	require.NoError(t, err)
 
-	originalContractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	originalContractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
-	burnerContractID, err := keeper.StoreCode(ctx, creator, burnerCode)
+	burnerContractID, err := keeper.StoreCode(ctx, creator, burnerCode, nil)
 	require.NoError(t, err)
 	require.NotEqual(t, originalContractID, burnerContractID)
 
@@ -553,7 +815,7 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 	require.NoError(t, err)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-	contractAddr, _, err := keeper.InstantiateContract(ctx, originalContractID, creator, creator, initMsgBz, deposit)
+	contractAddr, _, err := keeper.InstantiateContract(ctx, originalContractID, creator, creator, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	migMsg := struct {
@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+const (
+	// maxCronJobsPerContract bounds the active cron jobs of a contract
+	maxCronJobsPerContract = 10
+
+	// maxCronJobsVisitedPerBlock bounds the due cron jobs a block visits to execute or skip
+	maxCronJobsVisitedPerBlock = 100
+)
+
+// GetLastCronJobID returns the last cron job ID, zero before the first job
+func (k Keeper) GetLastCronJobID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastCronJobIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastCronJobID sets the last cron job ID
+func (k Keeper) SetLastCronJobID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastCronJobIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetCronJob returns the cron job of the given ID
+func (k Keeper) GetCronJob(ctx sdk.Context, jobID uint64) (job types.CronJob, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCronJobKey(jobID))
+	if bz == nil {
+		return types.CronJob{}, sdkerrors.Wrapf(types.ErrNotFound, "cron job %d", jobID)
+	}
+
+	k.cdc.MustUnmarshal(bz, &job)
+	return job, nil
+}
+
+// SetCronJob stores the cron job and keeps the queue and the contract index up to date
+func (k Keeper) SetCronJob(ctx sdk.Context, job types.CronJob) {
+	store := ctx.KVStore(k.storeKey)
+	if prevJob, err := k.GetCronJob(ctx, job.ID); err == nil {
+		store.Delete(types.GetCronQueueKey(prevJob.NextHeight, prevJob.ID))
+	}
+
+	contractAddr := job.ContractAddress()
+	store.Set(types.GetCronJobKey(job.ID), k.cdc.MustMarshal(&job))
+	store.Set(types.GetCronQueueKey(job.NextHeight, job.ID), []byte{})
+	store.Set(types.GetCronJobByContractKey(contractAddr, job.ID), []byte{})
+}
+
+// deleteCronJob removes the cron job with its queue entry and contract index
+func (k Keeper) deleteCronJob(ctx sdk.Context, job types.CronJob) {
+	store := ctx.KVStore(k.storeKey)
+	contractAddr := job.ContractAddress()
+	store.Delete(types.GetCronJobKey(job.ID))
+	store.Delete(types.GetCronQueueKey(job.NextHeight, job.ID))
+	store.Delete(types.GetCronJobByContractKey(contractAddr, job.ID))
+}
+
+// IterateCronJobs iterates all cron jobs in the order of their IDs
+func (k Keeper) IterateCronJobs(ctx sdk.Context, cb func(types.CronJob) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronJobKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var job types.CronJob
+		k.cdc.MustUnmarshal(iter.Value(), &job)
+		// cb returns true to stop early
+		if cb(job) {
+			break
+		}
+	}
+}
+
+// RegisterCronJob schedules a callback of the contract at the future height, repeated
+// every interval blocks unless the interval is zero. The fee of the gas budget at the
+// cron gas price is escrowed from the contract, and the unused part is refunded when
+// the job ends.
+func (k Keeper) RegisterCronJob(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	msg []byte,
+	height int64,
+	interval uint64,
+	gasLimit uint64,
+	gasBudget uint64) (uint64, error) {
+	if _, err := k.GetContractInfo(ctx, contractAddress); err != nil {
+		return 0, err
+	}
+
+	if uint64(len(msg)) > k.MaxContractMsgSize(ctx) {
+		return 0, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "cron job msg size is too huge")
+	}
+
+	if height <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidCronJob, "height %d is not in the future", height)
+	}
+
+	if maxGas := k.MaxCronGasPerBlock(ctx); gasLimit > maxGas {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidCronJob, "gas limit %d exceeds the max cron gas per block %d", gasLimit, maxGas)
+	}
+
+	if maxGas := k.MaxContractGas(ctx); gasLimit > maxGas {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidCronJob, "gas limit %d exceeds the max contract gas %d", gasLimit, maxGas)
+	}
+
+	if gasLimit == 0 || gasBudget < gasLimit {
+		return 0, sdkerrors.Wrap(types.ErrInvalidCronJob, "gas budget must cover the non-zero gas limit")
+	}
+
+	if k.countCronJobsByContract(ctx, contractAddress) >= maxCronJobsPerContract {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidCronJob, "contract %s already has the max %d cron jobs", contractAddress, maxCronJobsPerContract)
+	}
+
+	escrow := types.NewCronEscrow(k.CronGasPrice(ctx), gasBudget)
+	if escrow.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddress, types.ModuleName, sdk.NewCoins(escrow)); err != nil {
+			return 0, sdkerrors.Wrap(err, "failed to escrow the gas budget")
+		}
+	}
+
+	jobID := k.GetLastCronJobID(ctx) + 1
+	k.SetLastCronJobID(ctx, jobID)
+	k.SetCronJob(ctx, types.NewCronJob(jobID, contractAddress, msg, height, interval, gasLimit, gasBudget, escrow))
+
+	return jobID, nil
+}
+
+// countCronJobsByContract counts the active cron jobs of the contract, up to the max per contract
+func (k Keeper) countCronJobsByContract(ctx sdk.Context, contractAddress sdk.AccAddress) (count int) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCronJobsByContractPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid() && count < maxCronJobsPerContract; iter.Next() {
+		count++
+	}
+
+	return count
+}
+
+// CancelCronJob removes the cron job of the contract and refunds its remaining escrow
+func (k Keeper) CancelCronJob(ctx sdk.Context, contractAddress sdk.AccAddress, jobID uint64) error {
+	job, err := k.GetCronJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	if job.Contract != contractAddress.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cron job %d is not owned by %s", jobID, contractAddress)
+	}
+
+	return k.endCronJob(ctx, job)
+}
+
+// endCronJob removes the cron job and refunds its remaining escrow to the contract
+func (k Keeper) endCronJob(ctx sdk.Context, job types.CronJob) error {
+	k.deleteCronJob(ctx, job)
+	return k.refundCronEscrow(ctx, job.ContractAddress(), job.Escrow)
+}
+
+func (k Keeper) refundCronEscrow(ctx sdk.Context, contractAddress sdk.AccAddress, escrow sdk.Coin) error {
+	if !escrow.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, sdk.NewCoins(escrow))
+}
+
+// ExecuteCronJobs executes the due cron jobs in the order of their heights and IDs.
+// The gas limits of the executed jobs are counted against the max cron gas per block;
+// the jobs which do not fit in the remaining gas wait for the next blocks in the queue,
+// while the smaller jobs behind them are still executed. The jobs whose gas limit exceeds
+// the max cron gas per block can never be executed, so they are removed with a refund.
+// A block visits a bounded number of due jobs, the rest wait for the next blocks.
+func (k Keeper) ExecuteCronJobs(ctx sdk.Context) {
+	maxGas := k.MaxCronGasPerBlock(ctx)
+
+	// collect the due jobs first, the executions update the queue
+	var dueJobs, exhaustedJobs, oversizedJobs []types.CronJob
+	var reservedGas uint64
+	var visited int
+	k.iterateDueCronJobs(ctx, func(job types.CronJob) bool {
+		visited++
+
+		switch {
+		case job.GasBudget < job.GasLimit:
+			exhaustedJobs = append(exhaustedJobs, job)
+		case job.GasLimit > maxGas:
+			oversizedJobs = append(oversizedJobs, job)
+		case reservedGas+job.GasLimit > maxGas:
+			ctx.EventManager().EmitEvent(newCronJobEvent(types.EventTypeCronJobSkipped, job,
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueBlockGasLimit),
+			))
+		default:
+			reservedGas += job.GasLimit
+			dueJobs = append(dueJobs, job)
+		}
+
+		// stop once the gas of the block is used up or enough jobs are visited
+		return reservedGas >= maxGas || visited >= maxCronJobsVisitedPerBlock
+	})
+
+	k.endSkippedCronJobs(ctx, exhaustedJobs, types.AttributeValueInsufficientGas)
+	k.endSkippedCronJobs(ctx, oversizedJobs, types.AttributeValueExceedsBlockGasLimit)
+
+	for _, job := range dueJobs {
+		if err := k.executeCronJob(ctx, job); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// endSkippedCronJobs removes the cron jobs which cannot be executed anymore, with the refund
+// of their remaining escrow
+func (k Keeper) endSkippedCronJobs(ctx sdk.Context, jobs []types.CronJob, reason string) {
+	for _, job := range jobs {
+		ctx.EventManager().EmitEvent(newCronJobEvent(types.EventTypeCronJobSkipped, job,
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		))
+
+		if err := k.endCronJob(ctx, job); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// iterateDueCronJobs iterates the cron jobs scheduled at or before the current height
+func (k Keeper) iterateDueCronJobs(ctx sdk.Context, cb func(types.CronJob) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.CronQueueKey, types.GetCronQueuePrefix(ctx.BlockHeight()+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		jobID := sdk.BigEndianToUint64(iter.Key()[len(types.CronQueueKey)+8:])
+		job, err := k.GetCronJob(ctx, jobID)
+		if err != nil {
+			panic(err)
+		}
+
+		// cb returns true to stop early
+		if cb(job) {
+			break
+		}
+	}
+}
+
+// executeCronJob calls the contract back with the gas limit of the job. The fee of
+// the used gas is paid to the fee collector from the escrow; the state changes of
+// a failed execution are discarded, but its gas is paid as well.
+func (k Keeper) executeCronJob(ctx sdk.Context, job types.CronJob) error {
+	contractAddr := job.ContractAddress()
+
+	// reserve the gas limit before the execution, which may cancel the job itself
+	gasLimit := job.GasLimit
+	reserved := job.ReserveExecution()
+	k.SetCronJob(ctx, job)
+
+	gasUsed, err := k.sudoWithGasLimit(ctx, contractAddr, job.Msg, gasLimit)
+	if err != nil {
+		ctx.EventManager().EmitEvent(newCronJobEvent(types.EventTypeCronJobFailed, job,
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		))
+	} else {
+		ctx.EventManager().EmitEvent(newCronJobEvent(types.EventTypeExecuteCronJob, job,
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		))
+	}
+
+	fee := sdk.NewCoin(reserved.Denom, reserved.Amount.Mul(sdk.NewIntFromUint64(gasUsed)).Quo(sdk.NewIntFromUint64(gasLimit)))
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return err
+		}
+	}
+
+	unused := reserved.Sub(fee)
+
+	// the job cancelled by its own execution is refunded the unused reservation directly
+	job, err = k.GetCronJob(ctx, job.ID)
+	if err != nil {
+		return k.refundCronEscrow(ctx, contractAddr, unused)
+	}
+
+	job.GasBudget += gasLimit - gasUsed
+	job.Escrow = job.Escrow.Add(unused)
+
+	if job.Interval == 0 {
+		return k.endCronJob(ctx, job)
+	}
+
+	job.NextHeight = ctx.BlockHeight() + int64(job.Interval)
+	k.SetCronJob(ctx, job)
+
+	return nil
+}
+
+// sudoWithGasLimit calls the sudo entry point of the contract on a branched state
+// with the gas limit, and commits the state changes and the events on success
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (gasUsed uint64, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit)).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+		}
+
+		gasUsed = cacheCtx.GasMeter().GasConsumedToLimit()
+	}()
+
+	// the cron jobs of a frozen contract fail until the contract is unfrozen
+	contractInfo, err := k.GetContractInfo(cacheCtx, contractAddress)
+	if err != nil {
+		return
+	}
+
+	if contractInfo.Frozen {
+		err = sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", contractAddress)
+		return
+	}
+
+	if _, err = k.Sudo(cacheCtx, contractAddress, msg); err != nil {
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return
+}
+
+func newCronJobEvent(eventType string, job types.CronJob, attrs ...sdk.Attribute) sdk.Event {
+	return sdk.NewEvent(eventType,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyCronJobID, fmt.Sprintf("%d", job.ID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, job.Contract),
+		}, attrs...)...,
+	)
+}
@@ -0,0 +1,452 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func setupCronContract(t *testing.T, input TestInput, deposit sdk.Coins) sdk.AccAddress {
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, verifier := keyPubAddr()
+	_, _, beneficiary := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    verifier,
+		Beneficiary: beneficiary,
+	})
+	require.NoError(t, err)
+
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
+	require.NoError(t, err)
+
+	return addr
+}
+
+func stealFundsMsg(recipient sdk.AccAddress, amount sdk.Coin) []byte {
+	return []byte(fmt.Sprintf(`{"steal_funds":{"recipient":"%s","amount":[{"denom":"%s","amount":"%s"}]}}`,
+		recipient, amount.Denom, amount.Amount))
+}
+
+func requireCronJobEvent(t *testing.T, ctx sdk.Context, eventType string, jobID uint64) sdk.Event {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyCronJobID && string(attr.Value) == fmt.Sprintf("%d", jobID) {
+				return event
+			}
+		}
+	}
+
+	require.Failf(t, "event not found", "%s of cron job %d", eventType, jobID)
+	return sdk.Event{}
+}
+
+func eventAttribute(event sdk.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+
+	return ""
+}
+
+func TestRegisterCronJob(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, bankKeeper, keeper := input.Ctx, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	msg := stealFundsMsg(bob, sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+
+	// not a contract
+	_, err := keeper.RegisterCronJob(ctx, bob, msg, ctx.BlockHeight()+1, 0, 500000, 2000000)
+	require.Error(t, err)
+
+	// past height
+	_, err = keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight(), 0, 500000, 2000000)
+	require.ErrorIs(t, err, types.ErrInvalidCronJob)
+
+	// gas limit over the max cron gas per block
+	_, err = keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 0, keeper.MaxCronGasPerBlock(ctx)+1, keeper.MaxCronGasPerBlock(ctx)+1)
+	require.ErrorIs(t, err, types.ErrInvalidCronJob)
+
+	// budget below the gas limit
+	_, err = keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 0, 500000, 400000)
+	require.ErrorIs(t, err, types.ErrInvalidCronJob)
+
+	// escrow over the contract balance
+	_, err = keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 0, 500000, 100000000)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	jobID, err := keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 10, 500000, 2000000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), jobID)
+	require.Equal(t, uint64(1), keeper.GetLastCronJobID(ctx))
+
+	escrow := types.NewCronEscrow(keeper.CronGasPrice(ctx), 2000000)
+	require.Equal(t, sdk.NewInt64Coin(core.MicroBiqDenom, 300000), escrow)
+	require.Equal(t, deposit.Sub(sdk.NewCoins(escrow)), bankKeeper.GetAllBalances(ctx, contractAddr))
+	require.Equal(t, sdk.NewCoins(escrow), bankKeeper.GetAllBalances(ctx, input.AccKeeper.GetModuleAddress(types.ModuleName)))
+
+	job, err := keeper.GetCronJob(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, types.NewCronJob(jobID, contractAddr, msg, ctx.BlockHeight()+1, 10, 500000, 2000000, escrow), job)
+
+	// only the owner can cancel the job
+	err = keeper.CancelCronJob(ctx, bob, jobID)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	err = keeper.CancelCronJob(ctx, contractAddr, jobID)
+	require.NoError(t, err)
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, contractAddr))
+
+	_, err = keeper.GetCronJob(ctx, jobID)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	err = keeper.CancelCronJob(ctx, contractAddr, jobID)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestRegisterCronJobMaxPerContract(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 10000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	msg := stealFundsMsg(bob, sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+
+	var jobID uint64
+	for i := 0; i < maxCronJobsPerContract; i++ {
+		var err error
+		jobID, err = keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 0, 100000, 100000)
+		require.NoError(t, err)
+	}
+
+	_, err := keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 0, 100000, 100000)
+	require.ErrorIs(t, err, types.ErrInvalidCronJob)
+
+	// an ended job frees a slot
+	require.NoError(t, keeper.CancelCronJob(ctx, contractAddr, jobID))
+	_, err = keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+1, 0, 100000, 100000)
+	require.NoError(t, err)
+}
+
+func TestExecuteCronJob(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	amount := sdk.NewInt64Coin(core.MicroBiqDenom, 1000)
+
+	height := ctx.BlockHeight() + 1
+	jobID, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 10, 500000, 2000000)
+	require.NoError(t, err)
+
+	escrow := types.NewCronEscrow(keeper.CronGasPrice(ctx), 2000000)
+	feeCollectorAddr := accKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := bankKeeper.GetBalance(ctx, feeCollectorAddr, core.MicroBiqDenom)
+
+	// not due yet
+	keeper.ExecuteCronJobs(ctx)
+	require.True(t, bankKeeper.GetAllBalances(ctx, bob).IsZero())
+
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount), bankKeeper.GetAllBalances(ctx, bob))
+
+	event := requireCronJobEvent(t, ctx, types.EventTypeExecuteCronJob, jobID)
+	gasUsed, err := sdk.ParseUint(eventAttribute(event, types.AttributeKeyGasUsed))
+	require.NoError(t, err)
+	require.True(t, gasUsed.Uint64() > 0)
+
+	// the fee of the used gas is paid to the fee collector
+	fee := bankKeeper.GetBalance(ctx, feeCollectorAddr, core.MicroBiqDenom).Sub(feesBefore)
+	require.True(t, fee.IsPositive())
+	require.Equal(t, escrow.Amount.QuoRaw(4).MulRaw(int64(gasUsed.Uint64())).QuoRaw(500000), fee.Amount)
+
+	// the recurring job is rescheduled with the unused gas
+	job, err := keeper.GetCronJob(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, height+10, job.NextHeight)
+	require.Equal(t, 2000000-gasUsed.Uint64(), job.GasBudget)
+	require.Equal(t, escrow.Sub(fee), job.Escrow)
+
+	// not executed again before the next height
+	ctx = ctx.WithBlockHeight(height + 9).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount), bankKeeper.GetAllBalances(ctx, bob))
+
+	ctx = ctx.WithBlockHeight(height + 10).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount.Add(amount)), bankKeeper.GetAllBalances(ctx, bob))
+	requireCronJobEvent(t, ctx, types.EventTypeExecuteCronJob, jobID)
+
+	// the remaining escrow is refunded on the cancel
+	job, err = keeper.GetCronJob(ctx, jobID)
+	require.NoError(t, err)
+	require.NoError(t, keeper.CancelCronJob(ctx, contractAddr, jobID))
+
+	fees := bankKeeper.GetBalance(ctx, feeCollectorAddr, core.MicroBiqDenom).Sub(feesBefore)
+	require.Equal(t, escrow.Sub(fees), job.Escrow)
+	require.Equal(t, deposit.Sub(sdk.NewCoins(amount.Add(amount).Add(fees))), bankKeeper.GetAllBalances(ctx, contractAddr))
+	require.True(t, bankKeeper.GetAllBalances(ctx, accKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestExecuteCronJobFailed(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+
+	// steal more than the contract balance
+	height := ctx.BlockHeight() + 1
+	jobID, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, sdk.NewInt64Coin(core.MicroBiqDenom, 5000000)), height, 0, 500000, 500000)
+	require.NoError(t, err)
+
+	feeCollectorAddr := accKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := bankKeeper.GetBalance(ctx, feeCollectorAddr, core.MicroBiqDenom)
+
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.True(t, bankKeeper.GetAllBalances(ctx, bob).IsZero())
+
+	event := requireCronJobEvent(t, ctx, types.EventTypeCronJobFailed, jobID)
+	require.NotEmpty(t, eventAttribute(event, types.AttributeKeyError))
+
+	// the one-time job is removed and the failed execution is paid
+	_, err = keeper.GetCronJob(ctx, jobID)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	fee := bankKeeper.GetBalance(ctx, feeCollectorAddr, core.MicroBiqDenom).Sub(feesBefore)
+	require.True(t, fee.IsPositive())
+	require.Equal(t, deposit.Sub(sdk.NewCoins(fee)), bankKeeper.GetAllBalances(ctx, contractAddr))
+}
+
+func TestExecuteCronJobsBlockGasLimit(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, bankKeeper, keeper := input.Ctx, input.BankKeeper, input.WasmKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MaxCronGasPerBlock = 700000
+	keeper.SetParams(ctx, params)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	amount := sdk.NewInt64Coin(core.MicroBiqDenom, 1000)
+
+	height := ctx.BlockHeight() + 1
+	jobID1, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 0, 500000, 500000)
+	require.NoError(t, err)
+	jobID2, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 0, 500000, 500000)
+	require.NoError(t, err)
+
+	// the second job does not fit in the block
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount), bankKeeper.GetAllBalances(ctx, bob))
+	requireCronJobEvent(t, ctx, types.EventTypeExecuteCronJob, jobID1)
+
+	event := requireCronJobEvent(t, ctx, types.EventTypeCronJobSkipped, jobID2)
+	require.Equal(t, types.AttributeValueBlockGasLimit, eventAttribute(event, types.AttributeKeyReason))
+
+	job, err := keeper.GetCronJob(ctx, jobID2)
+	require.NoError(t, err)
+	require.Equal(t, height, job.NextHeight)
+
+	// and is executed in the next block
+	ctx = ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount.Add(amount)), bankKeeper.GetAllBalances(ctx, bob))
+	requireCronJobEvent(t, ctx, types.EventTypeExecuteCronJob, jobID2)
+
+	_, err = keeper.GetCronJob(ctx, jobID2)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestExecuteCronJobsAfterSkippedJob(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, bankKeeper, keeper := input.Ctx, input.BankKeeper, input.WasmKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MaxCronGasPerBlock = 1000000
+	keeper.SetParams(ctx, params)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	amount := sdk.NewInt64Coin(core.MicroBiqDenom, 1000)
+
+	height := ctx.BlockHeight() + 1
+	jobID1, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 0, 1000000, 1000000)
+	require.NoError(t, err)
+	jobID2, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 0, 500000, 500000)
+	require.NoError(t, err)
+	jobID3, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 0, 500000, 500000)
+	require.NoError(t, err)
+	jobID4, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 0, 200000, 200000)
+	require.NoError(t, err)
+
+	// the first job can never fit in a block anymore
+	params.MaxCronGasPerBlock = 800000
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+
+	event := requireCronJobEvent(t, ctx, types.EventTypeCronJobSkipped, jobID1)
+	require.Equal(t, types.AttributeValueExceedsBlockGasLimit, eventAttribute(event, types.AttributeKeyReason))
+	_, err = keeper.GetCronJob(ctx, jobID1)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// the third job does not fit in the rest of the block, but the smaller fourth one does
+	requireCronJobEvent(t, ctx, types.EventTypeExecuteCronJob, jobID2)
+	event = requireCronJobEvent(t, ctx, types.EventTypeCronJobSkipped, jobID3)
+	require.Equal(t, types.AttributeValueBlockGasLimit, eventAttribute(event, types.AttributeKeyReason))
+	requireCronJobEvent(t, ctx, types.EventTypeExecuteCronJob, jobID4)
+	require.Equal(t, sdk.NewCoins(amount.Add(amount)), bankKeeper.GetAllBalances(ctx, bob))
+
+	job, err := keeper.GetCronJob(ctx, jobID3)
+	require.NoError(t, err)
+	require.Equal(t, height, job.NextHeight)
+}
+
+func TestExecuteCronJobsMaxVisitedPerBlock(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+
+	// the jobs without the budget of an execution are only visited to be removed
+	height := ctx.BlockHeight() + 1
+	for jobID := uint64(1); jobID <= maxCronJobsVisitedPerBlock+1; jobID++ {
+		keeper.SetCronJob(ctx, types.NewCronJob(jobID, contractAddr, []byte("{}"), height, 0, 500000, 0, sdk.NewInt64Coin(core.MicroBiqDenom, 0)))
+	}
+
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	_, err := keeper.GetCronJob(ctx, maxCronJobsVisitedPerBlock)
+	require.ErrorIs(t, err, types.ErrNotFound)
+	_, err = keeper.GetCronJob(ctx, maxCronJobsVisitedPerBlock+1)
+	require.NoError(t, err)
+
+	// the rest is visited in the next block
+	ctx = ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	requireCronJobEvent(t, ctx, types.EventTypeCronJobSkipped, maxCronJobsVisitedPerBlock+1)
+	_, err = keeper.GetCronJob(ctx, maxCronJobsVisitedPerBlock+1)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestExecuteCronJobInsufficientGasBudget(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, bankKeeper, keeper := input.Ctx, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	amount := sdk.NewInt64Coin(core.MicroBiqDenom, 1000)
+
+	// the budget covers a single execution
+	height := ctx.BlockHeight() + 1
+	jobID, err := keeper.RegisterCronJob(ctx, contractAddr, stealFundsMsg(bob, amount), height, 1, 500000, 500000)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount), bankKeeper.GetAllBalances(ctx, bob))
+
+	job, err := keeper.GetCronJob(ctx, jobID)
+	require.NoError(t, err)
+	require.True(t, job.GasBudget < job.GasLimit)
+
+	ctx = ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCronJobs(ctx)
+	require.Equal(t, sdk.NewCoins(amount), bankKeeper.GetAllBalances(ctx, bob))
+
+	event := requireCronJobEvent(t, ctx, types.EventTypeCronJobSkipped, jobID)
+	require.Equal(t, types.AttributeValueInsufficientGas, eventAttribute(event, types.AttributeKeyReason))
+
+	// the job is removed with the refund of the remaining escrow
+	_, err = keeper.GetCronJob(ctx, jobID)
+	require.ErrorIs(t, err, types.ErrNotFound)
+	fee := types.NewCronEscrow(keeper.CronGasPrice(ctx), 500000).Sub(job.Escrow)
+	require.Equal(t, deposit.Sub(sdk.NewCoins(amount.Add(fee))), bankKeeper.GetAllBalances(ctx, contractAddr))
+}
+
+func TestQueryCronJobs(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000000))
+	contractAddr := setupCronContract(t, input, deposit)
+	otherAddr := setupCronContract(t, input, deposit)
+	_, _, bob := keyPubAddr()
+	msg := stealFundsMsg(bob, sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+
+	var jobs []types.CronJob
+	for i := 0; i < 3; i++ {
+		jobID, err := keeper.RegisterCronJob(ctx, contractAddr, msg, ctx.BlockHeight()+int64(i+1), 0, 500000, 500000)
+		require.NoError(t, err)
+
+		job, err := keeper.GetCronJob(ctx, jobID)
+		require.NoError(t, err)
+		jobs = append(jobs, job)
+	}
+
+	_, err := keeper.RegisterCronJob(ctx, otherAddr, msg, ctx.BlockHeight()+1, 0, 500000, 500000)
+	require.NoError(t, err)
+
+	querier := NewQuerier(keeper)
+
+	res, err := querier.CronJob(goCtx, &types.QueryCronJobRequest{JobId: jobs[1].ID})
+	require.NoError(t, err)
+	require.Equal(t, jobs[1], res.CronJob)
+
+	_, err = querier.CronJob(goCtx, &types.QueryCronJobRequest{JobId: 100})
+	require.Error(t, err)
+
+	listRes, err := querier.CronJobs(goCtx, &types.QueryCronJobsRequest{ContractAddress: contractAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, jobs, listRes.CronJobs)
+
+	listRes, err = querier.CronJobs(goCtx, &types.QueryCronJobsRequest{ContractAddress: contractAddr.String(), Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, jobs[:2], listRes.CronJobs)
+	require.NotNil(t, listRes.Pagination.NextKey)
+
+	_, err = querier.CronJobs(goCtx, &types.QueryCronJobsRequest{ContractAddress: "invalid"})
+	require.Error(t, err)
+}
@@ -119,7 +119,7 @@ func TestInstantiateMaker(t *testing.T) {
 	// upload staking derivatives code
 	makingCode, err := ioutil.ReadFile("./testdata/maker.wasm")
 	require.NoError(t, err)
-	makerID, err := keeper.StoreCode(ctx, creatorAddr, makingCode)
+	makerID, err := keeper.StoreCode(ctx, creatorAddr, makingCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), makerID)
 
@@ -130,12 +130,12 @@ func TestInstantiateMaker(t *testing.T) {
 	}
 
 	initBz, err := json.Marshal(&initMsg)
-	makerAddr, _, err := keeper.InstantiateContract(input.Ctx, makerID, creatorAddr, sdk.AccAddress{}, initBz, nil)
+	makerAddr, _, err := keeper.InstantiateContract(input.Ctx, makerID, creatorAddr, sdk.AccAddress{}, initBz, nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, makerAddr)
 
 	// invalid init msg
-	_, _, err = keeper.InstantiateContract(input.Ctx, makerID, creatorAddr, sdk.AccAddress{}, []byte{}, nil)
+	_, _, err = keeper.InstantiateContract(input.Ctx, makerID, creatorAddr, sdk.AccAddress{}, []byte{}, nil, "")
 	require.Error(t, err)
 }
 
@@ -408,7 +408,7 @@ func setupMakerContract(t *testing.T) (input TestInput, creatorAddr, makerAddr s
 	// upload staking derivatives code
 	makingCode, err := ioutil.ReadFile("./testdata/maker.wasm")
 	require.NoError(t, err)
-	makerID, err := keeper.StoreCode(ctx, creatorAddr, makingCode)
+	makerID, err := keeper.StoreCode(ctx, creatorAddr, makingCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), makerID)
 
@@ -418,7 +418,7 @@ func setupMakerContract(t *testing.T) (input TestInput, creatorAddr, makerAddr s
 	}
 
 	initBz, err := json.Marshal(&initMsg)
-	makerAddr, _, err = keeper.InstantiateContract(input.Ctx, makerID, creatorAddr, sdk.AccAddress{}, initBz, nil)
+	makerAddr, _, err = keeper.InstantiateContract(input.Ctx, makerID, creatorAddr, sdk.AccAddress{}, initBz, nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, makerAddr)
 
@@ -445,13 +445,13 @@ func setupBindingsTesterContract(t *testing.T) (input TestInput, creatorAddr, bi
 	// upload binding_tester contract codes
 	bindingsTCode, err := ioutil.ReadFile("./testdata/bindings_tester.wasm")
 	require.NoError(t, err)
-	bindingsTesterID, err := keeper.StoreCode(ctx, creatorAddr, bindingsTCode)
+	bindingsTesterID, err := keeper.StoreCode(ctx, creatorAddr, bindingsTCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), bindingsTesterID)
 
 	type EmptyStruct struct{}
 	initBz, err := json.Marshal(&EmptyStruct{})
-	bindingsTesterAddr, _, err = keeper.InstantiateContract(input.Ctx, bindingsTesterID, creatorAddr, sdk.AccAddress{}, initBz, nil)
+	bindingsTesterAddr, _, err = keeper.InstantiateContract(input.Ctx, bindingsTesterID, creatorAddr, sdk.AccAddress{}, initBz, nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, bindingsTesterAddr)
 
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// Keys for the contract event index
+// Items are stored with the following key: values
+//
+// - 0x01<accAddress_Bytes><uint64_height><uint32_seq>: ContractEvent
+//
+// - 0x02<accAddress_Bytes><eventType_Bytes><uint64_height><uint32_seq>: []byte{}
+var (
+	contractEventKey       = []byte{0x01}
+	contractEventByTypeKey = []byte{0x02}
+)
+
+// ContractEventIndex is the node local index of the wasm and wasm-* events emitted by
+// the contracts, keyed by the contract address and the event type. It is not part of
+// the consensus state: the events are collected from the ABCI responses while a block
+// is executed, and written when the block is committed.
+type ContractEventIndex struct {
+	db dbm.DB
+
+	height  int64
+	seq     uint32
+	pending []indexedContractEvent
+}
+
+type indexedContractEvent struct {
+	contract sdk.AccAddress
+	seq      uint32
+	event    types.ContractEvent
+}
+
+// NewContractEventIndex returns the contract event index stored in the db
+func NewContractEventIndex(db dbm.DB) *ContractEventIndex {
+	return &ContractEventIndex{db: db}
+}
+
+// Close closes the db of the index
+func (idx *ContractEventIndex) Close() error {
+	return idx.db.Close()
+}
+
+// ListenBeginBlock starts collecting the events of the block
+func (idx *ContractEventIndex) ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	idx.height = req.Header.Height
+	idx.seq = 0
+	idx.pending = nil
+
+	idx.collect("", res.Events)
+}
+
+// ListenDeliverTx collects the events of the successful tx
+func (idx *ContractEventIndex) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	if !res.IsOK() {
+		return
+	}
+
+	idx.collect(fmt.Sprintf("%X", tmhash.Sum(req.Tx)), res.Events)
+}
+
+// ListenEndBlock collects the events of the end blockers, such as the cron job executions
+func (idx *ContractEventIndex) ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	idx.collect("", res.Events)
+}
+
+// ListenCommit writes the collected events of the block. The keys only depend on
+// the block, so a block executed again after a restart overwrites its own events.
+func (idx *ContractEventIndex) ListenCommit() error {
+	pending := idx.pending
+	idx.pending = nil
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for _, indexed := range pending {
+		bz, err := indexed.event.Marshal()
+		if err != nil {
+			return err
+		}
+
+		suffix := contractEventSuffix(indexed.event.Height, indexed.seq)
+		if err := batch.Set(append(getContractEventsPrefix(indexed.contract), suffix...), bz); err != nil {
+			return err
+		}
+
+		// the event types too long for a length prefix are only indexed by the contract
+		typePrefix, err := getContractEventsByTypePrefix(indexed.contract, indexed.event.Type)
+		if err != nil {
+			continue
+		}
+
+		if err := batch.Set(append(typePrefix, suffix...), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// collect keeps the contract events among the events, which are the wasm and the
+// wasm-* events tagged with the address of the emitting contract
+func (idx *ContractEventIndex) collect(txHash string, events []abci.Event) {
+	for _, event := range events {
+		if event.Type != types.EventTypeWasmPrefix && !strings.HasPrefix(event.Type, types.EventTypeWasmPrefix+"-") {
+			continue
+		}
+
+		var contract sdk.AccAddress
+		attributes := make([]types.EventAttribute, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyContractAddress && contract == nil {
+				addr, err := sdk.AccAddressFromBech32(string(attr.Value))
+				if err != nil {
+					break
+				}
+
+				contract = addr
+			}
+
+			attributes = append(attributes, types.EventAttribute{Key: string(attr.Key), Value: string(attr.Value)})
+		}
+
+		if contract == nil {
+			continue
+		}
+
+		idx.seq++
+		idx.pending = append(idx.pending, indexedContractEvent{
+			contract: contract,
+			seq:      idx.seq,
+			event: types.ContractEvent{
+				Height:     idx.height,
+				TxHash:     txHash,
+				Type:       event.Type,
+				Attributes: attributes,
+			},
+		})
+	}
+}
+
+// ContractEvents returns the events of the contract within the height range, in the order
+// of their emission; the event type filters the events of the type unless it is empty
+// and a zero max height means no upper bound
+func (idx *ContractEventIndex) ContractEvents(
+	contract sdk.AccAddress,
+	eventType string,
+	minHeight int64,
+	maxHeight int64,
+	pageReq *query.PageRequest) ([]types.ContractEvent, *query.PageResponse, error) {
+	store := dbadapter.Store{DB: idx.db}
+	eventStore := prefix.NewStore(store, getContractEventsPrefix(contract))
+
+	var events []types.ContractEvent
+	onResult := func(key []byte) error {
+		var event types.ContractEvent
+		if err := event.Unmarshal(eventStore.Get(key)); err != nil {
+			return err
+		}
+
+		events = append(events, event)
+		return nil
+	}
+
+	pageStore := eventStore
+	if eventType != "" {
+		typePrefix, err := getContractEventsByTypePrefix(contract, eventType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pageStore = prefix.NewStore(store, typePrefix)
+	}
+
+	pageRes, err := paginateHeightRange(pageStore, minHeight, maxHeight, pageReq, onResult)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, pageRes, nil
+}
+
+// paginateHeightRange paginates the keys of the store starting with the block heights
+// within the height range, in the same manner as query.Paginate
+func paginateHeightRange(
+	store prefix.Store,
+	minHeight int64,
+	maxHeight int64,
+	pageReq *query.PageRequest,
+	onResult func(key []byte) error) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	start := sdk.Uint64ToBigEndian(uint64(minHeight))
+	var end []byte
+	if maxHeight > 0 {
+		end = sdk.Uint64ToBigEndian(uint64(maxHeight) + 1)
+	}
+
+	// the next key is the first key of the page in either order
+	if pageReq.Key != nil {
+		if pageReq.Reverse {
+			end = append(append([]byte{}, pageReq.Key...), 0x00)
+		} else {
+			start = pageReq.Key
+		}
+	}
+
+	var iter dbm.Iterator
+	if pageReq.Reverse {
+		iter = store.ReverseIterator(start, end)
+	} else {
+		iter = store.Iterator(start, end)
+	}
+	defer iter.Close()
+
+	var count uint64
+	var nextKey []byte
+	for ; iter.Valid(); iter.Next() {
+		count++
+
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		if count <= pageReq.Offset+limit {
+			if err := onResult(iter.Key()); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if nextKey == nil {
+			nextKey = iter.Key()
+		}
+
+		if !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return pageRes, nil
+}
+
+func getContractEventsPrefix(contract sdk.AccAddress) []byte {
+	return append(contractEventKey, address.MustLengthPrefix(contract)...)
+}
+
+func getContractEventsByTypePrefix(contract sdk.AccAddress, eventType string) ([]byte, error) {
+	typeBz, err := address.LengthPrefix([]byte(eventType))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "event type is too long: %s", err)
+	}
+
+	return append(append(contractEventByTypeKey, address.MustLengthPrefix(contract)...), typeBz...), nil
+}
+
+func contractEventSuffix(height int64, seq uint32) []byte {
+	seqBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(seqBz, seq)
+
+	return append(sdk.Uint64ToBigEndian(uint64(height)), seqBz...)
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func contractEvent(eventType string, contract sdk.AccAddress, attrs ...string) abci.Event {
+	event := sdk.NewEvent(eventType, sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()))
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event = event.AppendAttributes(sdk.NewAttribute(attrs[i], attrs[i+1]))
+	}
+
+	return abci.Event(event)
+}
+
+func indexBlock(idx *ContractEventIndex, height int64, txs [][]abci.Event, endBlockEvents []abci.Event) {
+	idx.ListenBeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{})
+	for i, events := range txs {
+		idx.ListenDeliverTx(
+			abci.RequestDeliverTx{Tx: []byte(fmt.Sprintf("tx%d-%d", height, i))},
+			abci.ResponseDeliverTx{Events: events},
+		)
+	}
+	idx.ListenEndBlock(abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{Events: endBlockEvents})
+}
+
+func TestContractEventIndex(t *testing.T) {
+	idx := NewContractEventIndex(dbm.NewMemDB())
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	indexBlock(idx, 10, [][]abci.Event{
+		{
+			abci.Event(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))),
+			contractEvent(types.EventTypeWasmPrefix, alice, "action", "increment"),
+			contractEvent(types.EventTypeFromContract, alice, "action", "increment"),
+			contractEvent("wasm-transfer", alice, "amount", "100"),
+			contractEvent(types.EventTypeWasmPrefix, bob, "action", "reset"),
+		},
+	}, []abci.Event{
+		contractEvent(types.EventTypeWasmPrefix, alice, "action", "tick"),
+	})
+
+	// the events are written on the commit
+	events, _, err := idx.ContractEvents(alice, "", 0, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	require.NoError(t, idx.ListenCommit())
+
+	// the failed txs are not indexed
+	idx.ListenBeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 11}}, abci.ResponseBeginBlock{})
+	idx.ListenDeliverTx(abci.RequestDeliverTx{Tx: []byte("failed")}, abci.ResponseDeliverTx{
+		Code:   1,
+		Events: []abci.Event{contractEvent(types.EventTypeWasmPrefix, alice, "action", "failed")},
+	})
+	require.NoError(t, idx.ListenCommit())
+
+	indexBlock(idx, 12, [][]abci.Event{
+		{contractEvent("wasm-transfer", alice, "amount", "200")},
+		{contractEvent(types.EventTypeWasmPrefix, alice, "action", "increment")},
+	}, nil)
+	require.NoError(t, idx.ListenCommit())
+
+	events, pageRes, err := idx.ContractEvents(alice, "", 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), pageRes.Total)
+	require.Equal(t, []types.ContractEvent{
+		{
+			Height: 10,
+			TxHash: fmt.Sprintf("%X", tmhash.Sum([]byte("tx10-0"))),
+			Type:   types.EventTypeWasmPrefix,
+			Attributes: []types.EventAttribute{
+				{Key: types.AttributeKeyContractAddress, Value: alice.String()},
+				{Key: "action", Value: "increment"},
+			},
+		},
+		{
+			Height: 10,
+			TxHash: fmt.Sprintf("%X", tmhash.Sum([]byte("tx10-0"))),
+			Type:   "wasm-transfer",
+			Attributes: []types.EventAttribute{
+				{Key: types.AttributeKeyContractAddress, Value: alice.String()},
+				{Key: "amount", Value: "100"},
+			},
+		},
+		{
+			Height: 10,
+			Type:   types.EventTypeWasmPrefix,
+			Attributes: []types.EventAttribute{
+				{Key: types.AttributeKeyContractAddress, Value: alice.String()},
+				{Key: "action", Value: "tick"},
+			},
+		},
+		{
+			Height: 12,
+			TxHash: fmt.Sprintf("%X", tmhash.Sum([]byte("tx12-0"))),
+			Type:   "wasm-transfer",
+			Attributes: []types.EventAttribute{
+				{Key: types.AttributeKeyContractAddress, Value: alice.String()},
+				{Key: "amount", Value: "200"},
+			},
+		},
+		{
+			Height: 12,
+			TxHash: fmt.Sprintf("%X", tmhash.Sum([]byte("tx12-1"))),
+			Type:   types.EventTypeWasmPrefix,
+			Attributes: []types.EventAttribute{
+				{Key: types.AttributeKeyContractAddress, Value: alice.String()},
+				{Key: "action", Value: "increment"},
+			},
+		},
+	}, events)
+
+	// by the event type
+	events, _, err = idx.ContractEvents(alice, "wasm-transfer", 0, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "100", events[0].Attributes[1].Value)
+	require.Equal(t, "200", events[1].Attributes[1].Value)
+
+	events, _, err = idx.ContractEvents(bob, "", 0, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	// within the height range
+	events, _, err = idx.ContractEvents(alice, "", 11, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	events, _, err = idx.ContractEvents(alice, types.EventTypeWasmPrefix, 0, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "tick", events[1].Attributes[1].Value)
+}
+
+func TestContractEventIndexPagination(t *testing.T) {
+	idx := NewContractEventIndex(dbm.NewMemDB())
+
+	_, _, alice := keyPubAddr()
+	for height := int64(1); height <= 5; height++ {
+		indexBlock(idx, height, [][]abci.Event{
+			{contractEvent(types.EventTypeWasmPrefix, alice, "height", fmt.Sprintf("%d", height))},
+		}, nil)
+		require.NoError(t, idx.ListenCommit())
+	}
+
+	heights := func(events []types.ContractEvent) (res []int64) {
+		for _, event := range events {
+			res = append(res, event.Height)
+		}
+		return res
+	}
+
+	events, pageRes, err := idx.ContractEvents(alice, "", 0, 0, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, heights(events))
+	require.NotNil(t, pageRes.NextKey)
+
+	events, pageRes, err = idx.ContractEvents(alice, "", 0, 0, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4}, heights(events))
+
+	events, pageRes, err = idx.ContractEvents(alice, "", 0, 0, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{5}, heights(events))
+	require.Nil(t, pageRes.NextKey)
+
+	// the latest events first
+	events, pageRes, err = idx.ContractEvents(alice, "", 2, 0, &query.PageRequest{Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 4}, heights(events))
+
+	events, pageRes, err = idx.ContractEvents(alice, "", 2, 0, &query.PageRequest{Key: pageRes.NextKey, Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 2}, heights(events))
+	require.Nil(t, pageRes.NextKey)
+
+	events, _, err = idx.ContractEvents(alice, "", 0, 0, &query.PageRequest{Offset: 3, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []int64{4}, heights(events))
+
+	_, _, err = idx.ContractEvents(alice, "", 0, 0, &query.PageRequest{Offset: 1, Key: []byte{0x01}})
+	require.Error(t, err)
+}
+
+func TestQueryContractEvents(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, alice := keyPubAddr()
+	req := &types.QueryContractEventsRequest{ContractAddress: alice.String()}
+
+	// disabled by default
+	q := NewQuerier(keeper)
+	_, err := q.ContractEvents(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	keeper.eventIndex = NewContractEventIndex(dbm.NewMemDB())
+	indexBlock(keeper.eventIndex, 1, [][]abci.Event{
+		{contractEvent(types.EventTypeWasmPrefix, alice, "action", "increment")},
+	}, nil)
+	require.NoError(t, keeper.eventIndex.ListenCommit())
+
+	q = NewQuerier(keeper)
+	res, err := q.ContractEvents(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, int64(1), res.Events[0].Height)
+
+	_, err = q.ContractEvents(sdk.WrapSDKContext(ctx), &types.QueryContractEventsRequest{ContractAddress: alice.String(), MinHeight: 5, MaxHeight: 4})
+	require.Error(t, err)
+
+	_, err = q.ContractEvents(sdk.WrapSDKContext(ctx), &types.QueryContractEventsRequest{ContractAddress: "invalid"})
+	require.Error(t, err)
+
+	require.NoError(t, keeper.Close())
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// bindIBCPort binds the port to the wasm module and claims its capability
+func (k Keeper) bindIBCPort(ctx sdk.Context, portID string) error {
+	portCap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, portCap, host.PortPath(portID))
+}
+
+// ensureIBCPort binds the port of the contract unless it is already bound,
+// and returns the port id
+func (k Keeper) ensureIBCPort(ctx sdk.Context, contractAddr sdk.AccAddress) (string, error) {
+	portID := types.PortIDForContract(contractAddr)
+	if _, ok := k.capabilityKeeper.GetCapability(ctx, host.PortPath(portID)); ok {
+		return portID, nil
+	}
+
+	return portID, k.bindIBCPort(ctx, portID)
+}
+
+// ensureIBCPortOfCode binds the port of the contract when the code has the IBC entry points,
+// and returns the port id; the port id is empty for the non IBC-enabled codes
+func (k Keeper) ensureIBCPortOfCode(ctx sdk.Context, contractAddr sdk.AccAddress, codeInfo types.CodeInfo) (string, error) {
+	report, err := k.wasmVM.AnalyzeCode(codeInfo.CodeHash)
+	if err != nil {
+		return "", err
+	}
+
+	if !report.HasIBCEntryPoints {
+		return "", nil
+	}
+
+	return k.ensureIBCPort(ctx, contractAddr)
+}
+
+// ClaimCapability allows the wasm module to claim a capability that the IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.capabilityKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// AuthenticateCapability wraps the scoped keeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.capabilityKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// sendIBCPacket sends the packet of the contract through its own port;
+// the events are returned instead of being emitted like the other dispatched msgs
+func (k Keeper) sendIBCPacket(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.SendPacketMsg) (sdk.Events, []byte, error) {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sourcePort := contractInfo.IBCPortID
+	if sourcePort == "" {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalidIBCPort, "contract is not IBC-enabled")
+	}
+
+	sourceChannel := msg.ChannelID
+	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", sourcePort, sourceChannel)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port %s, channel %s", sourcePort, sourceChannel)
+	}
+
+	channelCap, ok := k.capabilityKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return nil, nil, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		msg.Data,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		types.ParseToTimeoutHeight(msg.Timeout.Block),
+		msg.Timeout.Timestamp,
+	)
+
+	eventManager := sdk.NewEventManager()
+	if err := k.channelKeeper.SendPacket(ctx.WithEventManager(eventManager), channelCap, packet); err != nil {
+		return nil, nil, err
+	}
+
+	return eventManager.Events(), nil, nil
+}
@@ -0,0 +1,369 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// ibcWasmEngine reports all the codes as IBC-enabled and
+// replies to the IBC callbacks with the configured responses
+type ibcWasmEngine struct {
+	types.WasmerEngine
+
+	openErr     error
+	openMsgs    []wasmvmtypes.IBCChannelOpenMsg
+	recvMsgs    []wasmvmtypes.IBCPacketReceiveMsg
+	recvResp    *wasmvmtypes.IBCReceiveResponse
+	closingMsgs []wasmvmtypes.IBCChannelCloseMsg
+}
+
+func (e *ibcWasmEngine) AnalyzeCode(checksum wasmvm.Checksum) (*wasmvmtypes.AnalysisReport, error) {
+	return &wasmvmtypes.AnalysisReport{HasIBCEntryPoints: true}, nil
+}
+
+func (e *ibcWasmEngine) IBCChannelOpen(_ wasmvm.Checksum, _ wasmvmtypes.Env, msg wasmvmtypes.IBCChannelOpenMsg, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (uint64, error) {
+	e.openMsgs = append(e.openMsgs, msg)
+	return 1, e.openErr
+}
+
+func (e *ibcWasmEngine) IBCChannelClose(_ wasmvm.Checksum, _ wasmvmtypes.Env, msg wasmvmtypes.IBCChannelCloseMsg, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResponse, uint64, error) {
+	e.closingMsgs = append(e.closingMsgs, msg)
+	return &wasmvmtypes.IBCBasicResponse{}, 1, nil
+}
+
+func (e *ibcWasmEngine) IBCPacketReceive(_ wasmvm.Checksum, _ wasmvmtypes.Env, msg wasmvmtypes.IBCPacketReceiveMsg, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.IBCReceiveResponse, uint64, error) {
+	e.recvMsgs = append(e.recvMsgs, msg)
+	return e.recvResp, 1, nil
+}
+
+// recordingChannelKeeper records the packets sent through the channel keeper
+type recordingChannelKeeper struct {
+	types.ChannelKeeper
+
+	sentPackets []ibcexported.PacketI
+}
+
+func (k *recordingChannelKeeper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	k.sentPackets = append(k.sentPackets, packet)
+	return nil
+}
+
+func instantiateIBCContract(t *testing.T, input TestInput, engine *ibcWasmEngine) (Keeper, sdk.AccAddress) {
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	engine.WasmerEngine = keeper.wasmVM
+	keeper.wasmVM = engine
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	return keeper, contractAddr
+}
+
+func openChannel(t *testing.T, input TestInput, keeper Keeper, portID, channelID string, state channeltypes.State) {
+	channel := channeltypes.NewChannel(
+		state, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty("counterparty-port", "channel-7"),
+		[]string{"connection-0"}, "ics-test",
+	)
+	input.IBCKeeper.ChannelKeeper.SetChannel(input.Ctx, portID, channelID, channel)
+	input.IBCKeeper.ChannelKeeper.SetNextSequenceSend(input.Ctx, portID, channelID, 3)
+
+	// the capability is passed to the wasm module on the channel handshake
+	scopedKeeper := keeper.capabilityKeeper.(capabilitykeeper.ScopedKeeper)
+	channelCap, err := scopedKeeper.NewCapability(input.Ctx, host.ChannelCapabilityPath(portID, channelID))
+	require.NoError(t, err)
+	require.NotNil(t, channelCap)
+}
+
+func TestInstantiateBindsIBCPort(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	// hackatom has no IBC entry points
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Empty(t, contractInfo.IBCPortID)
+
+	// the IBC-enabled contract is bound to its own port
+	ibcKeeper, ibcContractAddr := instantiateIBCContract(t, input, &ibcWasmEngine{})
+	contractInfo, err = ibcKeeper.GetContractInfo(ctx, ibcContractAddr)
+	require.NoError(t, err)
+
+	portID := types.PortIDForContract(ibcContractAddr)
+	require.Equal(t, portID, contractInfo.IBCPortID)
+	_, ok := ibcKeeper.capabilityKeeper.GetCapability(ctx, host.PortPath(portID))
+	require.True(t, ok)
+
+	addr, err := types.ContractFromPortID(portID)
+	require.NoError(t, err)
+	require.Equal(t, ibcContractAddr, addr)
+}
+
+func TestIBCWasmQuerier(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+	keeper, contractAddr := instantiateIBCContract(t, input, &ibcWasmEngine{})
+
+	portID := types.PortIDForContract(contractAddr)
+	openChannel(t, input, keeper, portID, "channel-0", channeltypes.OPEN)
+	openChannel(t, input, keeper, portID, "channel-1", channeltypes.INIT)
+
+	querier := NewIBCWasmQuerier(keeper)
+
+	bz, err := querier.Query(ctx, contractAddr, wasmvmtypes.QueryRequest{IBC: &wasmvmtypes.IBCQuery{PortID: &wasmvmtypes.PortIDQuery{}}})
+	require.NoError(t, err)
+	var portRes wasmvmtypes.PortIDResponse
+	require.NoError(t, json.Unmarshal(bz, &portRes))
+	require.Equal(t, portID, portRes.PortID)
+
+	// only the open channels are listed
+	bz, err = querier.Query(ctx, contractAddr, wasmvmtypes.QueryRequest{IBC: &wasmvmtypes.IBCQuery{ListChannels: &wasmvmtypes.ListChannelsQuery{}}})
+	require.NoError(t, err)
+	var channelsRes wasmvmtypes.ListChannelsResponse
+	require.NoError(t, json.Unmarshal(bz, &channelsRes))
+	require.Len(t, channelsRes.Channels, 1)
+	require.Equal(t, wasmvmtypes.IBCChannel{
+		Endpoint:             wasmvmtypes.IBCEndpoint{PortID: portID, ChannelID: "channel-0"},
+		CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: "counterparty-port", ChannelID: "channel-7"},
+		Order:                wasmvmtypes.Unordered,
+		Version:              "ics-test",
+		ConnectionID:         "connection-0",
+	}, channelsRes.Channels[0])
+
+	bz, err = querier.Query(ctx, contractAddr, wasmvmtypes.QueryRequest{IBC: &wasmvmtypes.IBCQuery{Channel: &wasmvmtypes.ChannelQuery{ChannelID: "channel-0"}}})
+	require.NoError(t, err)
+	var channelRes wasmvmtypes.ChannelResponse
+	require.NoError(t, json.Unmarshal(bz, &channelRes))
+	require.NotNil(t, channelRes.Channel)
+	require.Equal(t, channelsRes.Channels[0], *channelRes.Channel)
+
+	bz, err = querier.Query(ctx, contractAddr, wasmvmtypes.QueryRequest{IBC: &wasmvmtypes.IBCQuery{Channel: &wasmvmtypes.ChannelQuery{ChannelID: "channel-1"}}})
+	require.NoError(t, err)
+	channelRes = wasmvmtypes.ChannelResponse{}
+	require.NoError(t, json.Unmarshal(bz, &channelRes))
+	require.Nil(t, channelRes.Channel)
+}
+
+func TestIBCWasmMsgParser(t *testing.T) {
+	input := CreateTestInput(t)
+	_, _, contractAddr := keyPubAddr()
+	_, _, receiver := keyPubAddr()
+
+	parser := NewIBCWasmMsgParser(staticPortSource(ibctransfertypes.PortID))
+
+	msg, err := parser.Parse(input.Ctx, contractAddr, wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{
+		Transfer: &wasmvmtypes.TransferMsg{
+			ChannelID: "channel-0",
+			ToAddress: receiver.String(),
+			Amount:    wasmvmtypes.NewCoin(100, core.MicroBiqDenom),
+			Timeout:   wasmvmtypes.IBCTimeout{Block: &wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 100}},
+		},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID, "channel-0", sdk.NewInt64Coin(core.MicroBiqDenom, 100),
+		contractAddr.String(), receiver.String(), types.ParseToTimeoutHeight(&wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 100}), 0,
+	), msg)
+
+	msg, err = parser.Parse(input.Ctx, contractAddr, wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{
+		CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-0"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.NewMsgChannelCloseInit(types.PortIDForContract(contractAddr), "channel-0", contractAddr.String()), msg)
+
+	// empty receiver
+	_, err = parser.Parse(input.Ctx, contractAddr, wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{
+		Transfer: &wasmvmtypes.TransferMsg{
+			ChannelID: "channel-0",
+			Amount:    wasmvmtypes.NewCoin(100, core.MicroBiqDenom),
+			Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 1000},
+		},
+	}})
+	require.Error(t, err)
+}
+
+func TestDispatchIBCSendPacket(t *testing.T) {
+	input := CreateTestInput(t)
+	keeper, contractAddr := instantiateIBCContract(t, input, &ibcWasmEngine{})
+
+	portID := types.PortIDForContract(contractAddr)
+	openChannel(t, input, keeper, portID, "channel-0", channeltypes.OPEN)
+
+	channelKeeper := &recordingChannelKeeper{ChannelKeeper: keeper.channelKeeper}
+	keeper.channelKeeper = channelKeeper
+
+	msg := wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{SendPacket: &wasmvmtypes.SendPacketMsg{
+		ChannelID: "channel-0",
+		Data:      []byte(`{"ping":{}}`),
+		Timeout:   wasmvmtypes.IBCTimeout{Timestamp: 1000},
+	}}}
+	_, _, err := keeper.dispatchMessage(input.Ctx, contractAddr, msg)
+	require.NoError(t, err)
+
+	require.Len(t, channelKeeper.sentPackets, 1)
+	require.Equal(t, channeltypes.NewPacket(
+		[]byte(`{"ping":{}}`), 3, portID, "channel-0", "counterparty-port", "channel-7",
+		types.ParseToTimeoutHeight(nil), 1000,
+	), channelKeeper.sentPackets[0])
+
+	// the channel must be owned by the contract port
+	msg.IBC.SendPacket.ChannelID = "channel-1"
+	_, _, err = keeper.dispatchMessage(input.Ctx, contractAddr, msg)
+	require.Error(t, err)
+
+	// non IBC-enabled contracts can not send packets
+	_, _, otherAddr := keyPubAddr()
+	keeper.SetContractInfo(input.Ctx, otherAddr, types.NewContractInfo(1, otherAddr, otherAddr, nil, []byte("{}"), ""))
+	_, _, err = keeper.dispatchMessage(input.Ctx, otherAddr, msg)
+	require.True(t, types.ErrInvalidIBCPort.Is(err))
+}
+
+func TestIBCContractCallbacks(t *testing.T) {
+	input := CreateTestInput(t)
+	engine := &ibcWasmEngine{
+		recvResp: &wasmvmtypes.IBCReceiveResponse{
+			Acknowledgement: []byte("ack"),
+			Attributes:      []wasmvmtypes.EventAttribute{{Key: "action", Value: "recv"}},
+		},
+	}
+	keeper, contractAddr := instantiateIBCContract(t, input, engine)
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+
+	channel := wasmvmtypes.IBCChannel{
+		Endpoint:             wasmvmtypes.IBCEndpoint{PortID: types.PortIDForContract(contractAddr), ChannelID: "channel-0"},
+		CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: "counterparty-port", ChannelID: "channel-7"},
+		Order:                wasmvmtypes.Unordered,
+		Version:              "ics-test",
+		ConnectionID:         "connection-0",
+	}
+	openMsg := wasmvmtypes.IBCChannelOpenMsg{OpenInit: &wasmvmtypes.IBCOpenInit{Channel: channel}}
+	require.NoError(t, keeper.OnOpenChannel(ctx, contractAddr, openMsg))
+	require.Equal(t, []wasmvmtypes.IBCChannelOpenMsg{openMsg}, engine.openMsgs)
+
+	engine.openErr = types.ErrInvalidMsg
+	err := keeper.OnOpenChannel(ctx, contractAddr, openMsg)
+	require.True(t, types.ErrIBCCallbackFailed.Is(err))
+
+	recvMsg := wasmvmtypes.IBCPacketReceiveMsg{Packet: wasmvmtypes.IBCPacket{
+		Data:     []byte(`{"ping":{}}`),
+		Src:      channel.CounterpartyEndpoint,
+		Dest:     channel.Endpoint,
+		Sequence: 1,
+		Timeout:  wasmvmtypes.IBCTimeout{Timestamp: 1000},
+	}}
+	ack, err := keeper.OnRecvPacket(ctx, contractAddr, recvMsg)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ack"), ack)
+	require.Equal(t, []wasmvmtypes.IBCPacketReceiveMsg{recvMsg}, engine.recvMsgs)
+
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	require.Equal(t, types.EventTypeFromContract, events[len(events)-1].Type)
+
+	closeMsg := wasmvmtypes.IBCChannelCloseMsg{CloseInit: &wasmvmtypes.IBCCloseInit{Channel: channel}}
+	require.NoError(t, keeper.OnCloseChannel(ctx, contractAddr, closeMsg))
+	require.Equal(t, []wasmvmtypes.IBCChannelCloseMsg{closeMsg}, engine.closingMsgs)
+}
+
+func TestIBCFrozenContractCallbacks(t *testing.T) {
+	input := CreateTestInput(t)
+	engine := &ibcWasmEngine{recvResp: &wasmvmtypes.IBCReceiveResponse{Acknowledgement: []byte("ack")}}
+	keeper, contractAddr := instantiateIBCContract(t, input, engine)
+	ctx := input.Ctx
+
+	channel := wasmvmtypes.IBCChannel{
+		Endpoint:             wasmvmtypes.IBCEndpoint{PortID: types.PortIDForContract(contractAddr), ChannelID: "channel-0"},
+		CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: "counterparty-port", ChannelID: "channel-7"},
+		Order:                wasmvmtypes.Unordered,
+		Version:              "ics-test",
+		ConnectionID:         "connection-0",
+	}
+	packet := wasmvmtypes.IBCPacket{
+		Data:     []byte(`{"ping":{}}`),
+		Src:      channel.CounterpartyEndpoint,
+		Dest:     channel.Endpoint,
+		Sequence: 1,
+		Timeout:  wasmvmtypes.IBCTimeout{Timestamp: 1000},
+	}
+
+	require.NoError(t, keeper.SetContractFrozen(ctx, contractAddr, true))
+
+	// the packet sent to the frozen contract is not passed to the contract
+	_, err := keeper.OnRecvPacket(ctx, contractAddr, wasmvmtypes.IBCPacketReceiveMsg{Packet: packet})
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+	require.Empty(t, engine.recvMsgs)
+
+	err = keeper.OnOpenChannel(ctx, contractAddr, wasmvmtypes.IBCChannelOpenMsg{OpenInit: &wasmvmtypes.IBCOpenInit{Channel: channel}})
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+	err = keeper.OnConnectChannel(ctx, contractAddr, wasmvmtypes.IBCChannelConnectMsg{OpenAck: &wasmvmtypes.IBCOpenAck{Channel: channel}})
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+	err = keeper.OnCloseChannel(ctx, contractAddr, wasmvmtypes.IBCChannelCloseMsg{CloseInit: &wasmvmtypes.IBCCloseInit{Channel: channel}})
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+	err = keeper.OnAckPacket(ctx, contractAddr, wasmvmtypes.IBCPacketAckMsg{OriginalPacket: packet})
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+	err = keeper.OnTimeoutPacket(ctx, contractAddr, wasmvmtypes.IBCPacketTimeoutMsg{Packet: packet})
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+	require.Empty(t, engine.openMsgs)
+	require.Empty(t, engine.closingMsgs)
+
+	// and is received again once the contract is unfrozen
+	require.NoError(t, keeper.SetContractFrozen(ctx, contractAddr, false))
+	ack, err := keeper.OnRecvPacket(ctx, contractAddr, wasmvmtypes.IBCPacketReceiveMsg{Packet: packet})
+	require.NoError(t, err)
+	require.Equal(t, []byte("ack"), ack)
+}
+
+type staticPortSource string
+
+func (s staticPortSource) GetPort(sdk.Context) string {
+	return string(s)
+}
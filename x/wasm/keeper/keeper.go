@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -29,16 +30,30 @@ type Keeper struct {
 	bankKeeper     types.BankKeeper
 	treasuryKeeper types.TreasuryKeeper
 
+	channelKeeper    types.ChannelKeeper
+	portKeeper       types.PortKeeper
+	capabilityKeeper types.CapabilityKeeper
+	portSource       types.ICS20TransferPortSource
+
 	serviceRouter types.MsgServiceRouter
 	queryRouter   types.GRPCQueryRouter
 
 	wasmVM types.WasmerEngine
 
-	querier   types.Querier
-	msgParser types.MsgParser
+	querier    types.Querier
+	msgParser  types.MsgParser
+	msgFilters []types.MsgFilter
+
+	authPolicy authorizationPolicy
 
 	// WASM config values
 	wasmConfig *config.Config
+
+	// contract call tracer, nil unless the tracing mode is enabled
+	tracer *contractTracer
+
+	// contract event index, nil unless the event indexing is enabled
+	eventIndex *ContractEventIndex
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -49,6 +64,10 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	treasuryKeeper types.TreasuryKeeper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	capabilityKeeper types.CapabilityKeeper,
+	portSource types.ICS20TransferPortSource,
 	serviceRouter types.MsgServiceRouter,
 	queryRouter types.GRPCQueryRouter,
 	supportedFeatures string,
@@ -77,20 +96,72 @@ func NewKeeper(
 		panic(err)
 	}
 
-	return Keeper{
-		storeKey:       storeKey,
-		cdc:            cdc,
-		paramSpace:     paramspace,
-		wasmVM:         vm,
-		accountKeeper:  accountKeeper,
-		bankKeeper:     bankKeeper,
-		treasuryKeeper: treasuryKeeper,
-		serviceRouter:  serviceRouter,
-		queryRouter:    queryRouter,
-		wasmConfig:     wasmConfig,
-		msgParser:      types.NewWasmMsgParser(),
-		querier:        types.NewWasmQuerier(),
+	keeper := Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
+		paramSpace:       paramspace,
+		wasmVM:           vm,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		treasuryKeeper:   treasuryKeeper,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		capabilityKeeper: capabilityKeeper,
+		portSource:       portSource,
+		serviceRouter:    serviceRouter,
+		queryRouter:      queryRouter,
+		wasmConfig:       wasmConfig,
+		msgParser:        types.NewWasmMsgParser(),
+		querier:          types.NewWasmQuerier(),
+		authPolicy:       DefaultAuthorizationPolicy{},
+	}
+
+	if wasmConfig.ContractTracingMode {
+		traceFile := wasmConfig.ContractTraceFile
+		if traceFile == "" {
+			traceFile = config.DefaultContractTraceFile
+		}
+
+		keeper.tracer, err = newContractTracer(homePath, traceFile)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if wasmConfig.ContractEventIndexing {
+		db, err := dbm.NewDB(config.EventIndexDBName, dbm.GoLevelDBBackend, filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.eventIndex = NewContractEventIndex(db)
+	}
+
+	// the ibc msgs and queries are resolved with the ports bound by the keeper
+	keeper.msgParser.IBCParser = NewIBCWasmMsgParser(portSource)
+	keeper.querier.IBCQuerier = NewIBCWasmQuerier(keeper)
+
+	return keeper
+}
+
+// Close closes the contract trace file and the contract event index; it is called on the app shutdown
+func (k Keeper) Close() error {
+	if k.tracer != nil {
+		if err := k.tracer.close(); err != nil {
+			return err
+		}
+	}
+
+	if k.eventIndex != nil {
+		return k.eventIndex.Close()
 	}
+
+	return nil
+}
+
+// ContractEventIndex returns the contract event index, nil unless the event indexing is enabled
+func (k Keeper) ContractEventIndex() *ContractEventIndex {
+	return k.eventIndex
 }
 
 // Logger returns a module-specific logger.
@@ -152,6 +223,22 @@ func (k Keeper) SetCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.CodeI
 	store.Set(types.GetCodeInfoKey(codeID), bz)
 }
 
+// IterateCodeInfos iterates all code infos
+func (k Keeper) IterateCodeInfos(ctx sdk.Context, cb func(types.CodeInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var codeInfo types.CodeInfo
+		k.cdc.MustUnmarshal(iter.Value(), &codeInfo)
+		// cb returns true to stop early
+		if cb(codeInfo) {
+			break
+		}
+	}
+}
+
 // GetContractInfo returns contract info of the given address
 func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) (contractInfo types.ContractInfo, err error) {
 	store := ctx.KVStore(k.storeKey)
@@ -164,10 +251,69 @@ func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress)
 }
 
 // SetContractInfo stores ContractInfo for the given contractAddress
-func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, codeInfo types.ContractInfo) {
+// and keeps the code, creator and admin indexes up to date
+func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo types.ContractInfo) {
 	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshal(&codeInfo)
+	if prevInfo, err := k.GetContractInfo(ctx, contractAddress); err == nil {
+		k.deleteContractIndexes(store, contractAddress, prevInfo)
+	}
+
+	b := k.cdc.MustMarshal(&contractInfo)
 	store.Set(types.GetContractInfoKey(contractAddress), b)
+	k.setContractIndexes(store, contractAddress, contractInfo)
+}
+
+func (k Keeper) setContractIndexes(store sdk.KVStore, contractAddress sdk.AccAddress, contractInfo types.ContractInfo) {
+	store.Set(types.GetContractByCodeKey(contractInfo.CodeID, contractAddress), []byte{})
+
+	if creatorAddr, err := sdk.AccAddressFromBech32(contractInfo.Creator); err == nil {
+		store.Set(types.GetContractByCreatorKey(creatorAddr, contractAddress), []byte{})
+	}
+
+	if adminAddr, err := sdk.AccAddressFromBech32(contractInfo.Admin); err == nil {
+		store.Set(types.GetContractByAdminKey(adminAddr, contractAddress), []byte{})
+	}
+}
+
+func (k Keeper) deleteContractIndexes(store sdk.KVStore, contractAddress sdk.AccAddress, contractInfo types.ContractInfo) {
+	store.Delete(types.GetContractByCodeKey(contractInfo.CodeID, contractAddress))
+
+	if creatorAddr, err := sdk.AccAddressFromBech32(contractInfo.Creator); err == nil {
+		store.Delete(types.GetContractByCreatorKey(creatorAddr, contractAddress))
+	}
+
+	if adminAddr, err := sdk.AccAddressFromBech32(contractInfo.Admin); err == nil {
+		store.Delete(types.GetContractByAdminKey(adminAddr, contractAddress))
+	}
+}
+
+// IterateContractsByCode iterates the addresses of the contracts instantiated from the code
+func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCodePrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// the key is the length prefixed contract address
+		if cb(sdk.AccAddress(iter.Key()[1:])) {
+			break
+		}
+	}
+}
+
+// RebuildContractIndexes rebuilds the code, creator and admin indexes
+// from all the stored contract infos
+func (k Keeper) RebuildContractIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	k.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+		contractAddr, err := sdk.AccAddressFromBech32(contractInfo.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.setContractIndexes(store, contractAddr, contractInfo)
+		return false
+	})
 }
 
 // IterateContractInfo iterates all contract infos
@@ -184,20 +330,63 @@ func (k Keeper) IterateContractInfo(ctx sdk.Context, cb func(types.ContractInfo)
 	}
 }
 
+// AppendContractHistory appends the entries to the history of the contract
+func (k Keeper) AppendContractHistory(ctx sdk.Context, contractAddress sdk.AccAddress, entries ...types.ContractHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the next position follows the last stored entry
+	pos := uint64(0)
+	iter := prefix.NewStore(store, types.GetContractHistoryPrefix(contractAddress)).ReverseIterator(nil, nil)
+	if iter.Valid() {
+		pos = binary.BigEndian.Uint64(iter.Key()) + 1
+	}
+	iter.Close()
+
+	for _, entry := range entries {
+		entry := entry
+		store.Set(types.GetContractHistoryKey(contractAddress, pos), k.cdc.MustMarshal(&entry))
+		pos++
+	}
+}
+
+// GetContractHistory returns all the history entries of the contract, from the oldest
+func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddress sdk.AccAddress) (entries []types.ContractHistoryEntry) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractHistoryPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ContractHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
 // GetContractStoreIterator returns iterator for a contract store
 func (k Keeper) GetContractStoreIterator(ctx sdk.Context, contractAddress sdk.AccAddress) sdk.Iterator {
+	return k.GetContractStoreRangeIterator(ctx, contractAddress, nil, nil)
+}
+
+// GetContractStoreRangeIterator returns iterator for a contract store over the key range [start, end);
+// nil start and end are the first and the last key of the store
+func (k Keeper) GetContractStoreRangeIterator(ctx sdk.Context, contractAddress sdk.AccAddress, start, end []byte) sdk.Iterator {
 	prefixStoreKey := types.GetContractStoreKey(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	return prefixStore.Iterator(nil, nil)
+	return prefixStore.Iterator(start, end)
 }
 
 // SetContractStore records all the Models on the contract store
+// and counts them to the storage size of the contract
 func (k Keeper) SetContractStore(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) {
 	prefixStoreKey := types.GetContractStoreKey(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := types.NewStorageSizeStore(ctx, k.storeKey, prefixStoreKey)
 	for _, model := range models {
 		prefixStore.Set(model.Key, model.Value)
 	}
+
+	k.addContractStorageSize(ctx, contractAddress, prefixStore.SizeDelta())
 }
 
 // GetByteCode returns ByteCode of the given CodeHash
@@ -229,6 +418,12 @@ func (k *Keeper) RegisterMsgParsers(
 	}
 }
 
+// RegisterMsgFilters register the filters checking the msgs dispatched by the contracts;
+// it must be called before the keeper is passed to the other modules
+func (k *Keeper) RegisterMsgFilters(filters ...types.MsgFilter) {
+	k.msgFilters = append(k.msgFilters, filters...)
+}
+
 // RegisterQueriers register module queriers
 func (k *Keeper) RegisterQueriers(
 	queriers map[string]types.WasmQuerierInterface,
@@ -22,7 +22,7 @@ func TestCodeInfo(t *testing.T) {
 
 	codeID := uint64(1)
 	_, _, creatorAddr := keyPubAddr()
-	expected := types.NewCodeInfo(codeID, []byte{1, 2, 3}, creatorAddr)
+	expected := types.NewCodeInfo(codeID, []byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
 	keeper.SetCodeInfo(ctx, 1, expected)
 
 	as, err := keeper.GetCodeInfo(ctx, codeID)
@@ -49,7 +49,7 @@ func TestContractInfo(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	expected := types.NewContractInfo(codeID, contractAddr, creatorAddr, sdk.AccAddress{}, initMsgBz)
+	expected := types.NewContractInfo(codeID, contractAddr, creatorAddr, sdk.AccAddress{}, initMsgBz, "")
 	keeper.SetContractInfo(ctx, contractAddr, expected)
 
 	as, err := keeper.GetContractInfo(ctx, contractAddr)
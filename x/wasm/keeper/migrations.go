@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, building the code,
+// creator and admin indexes of the existing contracts.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.RebuildContractIndexes(ctx)
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3, setting the default code upload
+// access param and allowing everybody to instantiate the existing codes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyCodeUploadAccess, types.AllowEverybody)
+
+	var codeInfos []types.CodeInfo
+	m.keeper.IterateCodeInfos(ctx, func(codeInfo types.CodeInfo) bool {
+		codeInfos = append(codeInfos, codeInfo)
+		return false
+	})
+
+	for _, codeInfo := range codeInfos {
+		if codeInfo.InstantiateConfig.Permission == types.AccessTypeUnspecified {
+			codeInfo.InstantiateConfig = types.AllowEverybody
+			m.keeper.SetCodeInfo(ctx, codeInfo.CodeID, codeInfo)
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, setting the default cron job params
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyCronGasPrice, types.DefaultCronGasPrice)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxCronGasPerBlock, types.DefaultMaxCronGasPerBlock)
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5, setting the default storage rent
+// params and counting the storage size of the existing contracts.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyStorageRentPrice, types.DefaultStorageRentPrice)
+	m.keeper.paramSpace.Set(ctx, types.KeyStorageRentPeriod, types.DefaultStorageRentPeriod)
+
+	var contractInfos []types.ContractInfo
+	m.keeper.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+		contractInfos = append(contractInfos, contractInfo)
+		return false
+	})
+
+	for _, contractInfo := range contractInfos {
+		contractAddr, err := sdk.AccAddressFromBech32(contractInfo.Address)
+		if err != nil {
+			return err
+		}
+
+		var storageSize uint64
+		iter := m.keeper.GetContractStoreIterator(ctx, contractAddr)
+		for ; iter.Valid(); iter.Next() {
+			storageSize += uint64(len(iter.Key()) + len(iter.Value()))
+		}
+		iter.Close()
+
+		contractInfo.StorageSize = storageSize
+		m.keeper.SetContractInfo(ctx, contractAddr, contractInfo)
+	}
+
+	return nil
+}
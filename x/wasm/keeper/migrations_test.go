@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, creatorAddr := keyPubAddr()
+	_, _, adminAddr := keyPubAddr()
+	contractAddr := types.GenerateContractAddress(1, 1)
+
+	// store the contract info without the indexes, as in version 1
+	contractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, adminAddr, []byte("{}"), "")
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetContractInfoKey(contractAddr), keeper.cdc.MustMarshal(&contractInfo))
+	require.False(t, store.Has(types.GetContractByCodeKey(1, contractAddr)))
+
+	require.NoError(t, NewMigrator(keeper).Migrate1to2(ctx))
+
+	require.True(t, store.Has(types.GetContractByCodeKey(1, contractAddr)))
+	require.True(t, store.Has(types.GetContractByCreatorKey(creatorAddr, contractAddr)))
+	require.True(t, store.Has(types.GetContractByAdminKey(adminAddr, contractAddr)))
+	require.False(t, store.Has(types.GetContractByAdminKey(creatorAddr, contractAddr)))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, creatorAddr := keyPubAddr()
+
+	// store the code info without the instantiate permission, as in version 2
+	keeper.SetCodeInfo(ctx, 1, types.CodeInfo{CodeID: 1, CodeHash: []byte{1, 2, 3}, Creator: creatorAddr.String()})
+	keeper.SetCodeInfo(ctx, 2, types.NewCodeInfo(2, []byte{1, 2, 3}, creatorAddr, types.AllowNobody))
+
+	require.NoError(t, NewMigrator(keeper).Migrate2to3(ctx))
+
+	require.Equal(t, types.AllowEverybody, keeper.CodeUploadAccess(ctx))
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowEverybody, codeInfo.InstantiateConfig)
+
+	codeInfo, err = keeper.GetCodeInfo(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowNobody, codeInfo.InstantiateConfig)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	require.NoError(t, NewMigrator(keeper).Migrate3to4(ctx))
+
+	require.Equal(t, types.DefaultCronGasPrice, keeper.CronGasPrice(ctx))
+	require.Equal(t, types.DefaultMaxCronGasPerBlock, keeper.MaxCronGasPerBlock(ctx))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, creatorAddr := keyPubAddr()
+	contractAddr := types.GenerateContractAddress(1, 1)
+
+	// store the contract state without the storage size, as in version 4
+	keeper.SetContractInfo(ctx, contractAddr, types.NewContractInfo(1, contractAddr, creatorAddr, nil, []byte("{}"), ""))
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetContractStoreKey(contractAddr))
+	prefixStore.Set([]byte("foo"), []byte("bar"))
+	prefixStore.Set([]byte("config"), []byte("{}"))
+
+	require.NoError(t, NewMigrator(keeper).Migrate4to5(ctx))
+
+	require.Equal(t, types.DefaultStorageRentPrice, keeper.StorageRentPrice(ctx))
+	require.Equal(t, types.DefaultStorageRentPeriod, keeper.StorageRentPeriod(ctx))
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), contractInfo.StorageSize)
+}
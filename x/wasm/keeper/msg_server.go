@@ -7,7 +7,6 @@ import (
 	"github.com/bitwebs/iq-core/x/wasm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -29,7 +28,7 @@ func (k msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		return nil, err
 	}
 
-	codeID, err := k.Keeper.StoreCode(ctx, senderAddr, msg.WASMByteCode)
+	codeID, err := k.Keeper.StoreCode(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +110,7 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 		adminAddr,
 		msg.InitMsg,
 		msg.InitCoins,
+		msg.Label,
 	)
 	if err != nil {
 		return nil, err
@@ -143,6 +143,69 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (k msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	adminAddr := sdk.AccAddress{}
+	if len(msg.Admin) != 0 {
+		adminAddr, err = sdk.AccAddressFromBech32(msg.Admin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxGas := k.MaxContractGas(ctx)
+	remain := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
+	if remain > maxGas {
+		remain = maxGas
+	}
+
+	subCtx := ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewGasMeter(remain))
+	contractAddr, data, err := k.Keeper.InstantiateContract2(
+		subCtx,
+		msg.CodeID,
+		senderAddr,
+		adminAddr,
+		msg.InitMsg,
+		msg.InitCoins,
+		msg.Salt,
+		msg.Label,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// consume gas used from wasm execution
+	ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "wasm vm execute")
+
+	// prepend the event to keep the events order
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeInstantiateContract,
+				sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			),
+		}.AppendEvents(subCtx.EventManager().Events()),
+	)
+
+	return &types.MsgInstantiateContract2Response{
+		ContractAddress: contractAddr.String(),
+		Data:            data,
+	}, nil
+}
+
 func (k msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteContract) (*types.MsgExecuteContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -261,28 +324,20 @@ func (k msgServer) UpdateContractAdmin(goCtx context.Context, msg *types.MsgUpda
 		return nil, err
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Admin)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.NewAdmin)
+	newAdminAddr, err := sdk.AccAddressFromBech32(msg.NewAdmin)
 	if err != nil {
 		return nil, err
 	}
 
-	contractInfo, err := k.GetContractInfo(ctx, contractAddr)
-	if err != nil {
+	if err := k.Keeper.UpdateContractAdmin(ctx, contractAddr, adminAddr, newAdminAddr); err != nil {
 		return nil, err
 	}
 
-	if contractInfo.Admin != msg.Admin {
-		return nil, sdkerrors.ErrUnauthorized
-	}
-
-	contractInfo.Admin = msg.NewAdmin
-	k.SetContractInfo(ctx, contractAddr, contractInfo)
-
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -308,28 +363,79 @@ func (k msgServer) ClearContractAdmin(goCtx context.Context, msg *types.MsgClear
 		return nil, err
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Admin)
 	if err != nil {
 		return nil, err
 	}
 
-	contractInfo, err := k.GetContractInfo(ctx, contractAddr)
+	if err := k.Keeper.UpdateContractAdmin(ctx, contractAddr, adminAddr, nil); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClearContractAdmin,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &types.MsgClearContractAdminResponse{}, nil
+}
+
+func (k msgServer) RegisterCronJob(goCtx context.Context, msg *types.MsgRegisterCronJob) (*types.MsgRegisterCronJobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
 	if err != nil {
 		return nil, err
 	}
 
-	if contractInfo.Admin != msg.Admin {
-		return nil, sdkerrors.ErrUnauthorized
+	jobID, err := k.Keeper.RegisterCronJob(ctx, contractAddr, msg.Msg, msg.Height, msg.Interval, msg.GasLimit, msg.GasBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRegisterCronJob,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract),
+				sdk.NewAttribute(types.AttributeKeyCronJobID, fmt.Sprintf("%d", jobID)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &types.MsgRegisterCronJobResponse{JobID: jobID}, nil
+}
+
+func (k msgServer) CancelCronJob(goCtx context.Context, msg *types.MsgCancelCronJob) (*types.MsgCancelCronJobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
 	}
 
-	contractInfo.Admin = ""
-	k.SetContractInfo(ctx, contractAddr, contractInfo)
+	if err := k.Keeper.CancelCronJob(ctx, contractAddr, msg.JobID); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
-				types.EventTypeClearContractAdmin,
+				types.EventTypeCancelCronJob,
 				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract),
+				sdk.NewAttribute(types.AttributeKeyCronJobID, fmt.Sprintf("%d", msg.JobID)),
 			),
 			sdk.NewEvent(
 				sdk.EventTypeMessage,
@@ -338,5 +444,64 @@ func (k msgServer) ClearContractAdmin(goCtx context.Context, msg *types.MsgClear
 		},
 	)
 
-	return &types.MsgClearContractAdminResponse{}, nil
+	return &types.MsgCancelCronJobResponse{}, nil
+}
+
+func (k msgServer) SetGasSponsorship(goCtx context.Context, msg *types.MsgSetGasSponsorship) (*types.MsgSetGasSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RegisterGasSponsorship(ctx, contractAddr, msg.UserLimit, msg.UserPeriod, msg.BlockLimit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetGasSponsorship,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract),
+				sdk.NewAttribute(types.AttributeKeyUserLimit, msg.UserLimit.String()),
+				sdk.NewAttribute(types.AttributeKeyUserPeriod, fmt.Sprintf("%d", msg.UserPeriod)),
+				sdk.NewAttribute(types.AttributeKeyBlockLimit, msg.BlockLimit.String()),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &types.MsgSetGasSponsorshipResponse{}, nil
+}
+
+func (k msgServer) RemoveGasSponsorship(goCtx context.Context, msg *types.MsgRemoveGasSponsorship) (*types.MsgRemoveGasSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveGasSponsorship(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRemoveGasSponsorship,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &types.MsgRemoveGasSponsorshipResponse{}, nil
 }
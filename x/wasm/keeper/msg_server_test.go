@@ -22,7 +22,7 @@ func TestInstantiateExceedMaxGas(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -39,9 +39,9 @@ func TestInstantiateExceedMaxGas(t *testing.T) {
 	// must panic
 	require.Panics(t, func() {
 		params := keeper.GetParams(ctx)
-		params.MaxContractGas = types.InstantiateContractCosts(0) + 1
+		params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
 		keeper.SetParams(ctx, params)
-		NewMsgServerImpl(keeper).InstantiateContract(ctx.Context(), types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, codeID, initMsgBz, nil))
+		NewMsgServerImpl(keeper).InstantiateContract(ctx.Context(), types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, codeID, initMsgBz, nil, ""))
 	})
 }
 
@@ -55,7 +55,7 @@ func TestExecuteExceedMaxGas(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -69,12 +69,12 @@ func TestExecuteExceedMaxGas(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
 
 	// must panic
 	require.Panics(t, func() {
 		params := keeper.GetParams(ctx)
-		params.MaxContractGas = types.InstantiateContractCosts(0) + 1
+		params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
 		keeper.SetParams(ctx, params)
 		NewMsgServerImpl(keeper).ExecuteContract(ctx.Context(), types.NewMsgExecuteContract(creator, addr, []byte(`{"release":{}}`), nil))
 	})
@@ -90,7 +90,7 @@ func TestMigrateExceedMaxGas(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -104,12 +104,12 @@ func TestMigrateExceedMaxGas(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
 
 	// must panic
 	require.Panics(t, func() {
 		params := keeper.GetParams(ctx)
-		params.MaxContractGas = types.InstantiateContractCosts(0) + 1
+		params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
 		keeper.SetParams(ctx, params)
 		NewMsgServerImpl(keeper).MigrateContract(ctx.Context(), types.NewMsgMigrateContract(creator, addr, codeID, []byte(`{"release":{}}`)))
 	})
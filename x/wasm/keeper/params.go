@@ -34,3 +34,33 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// CronGasPrice defines the price of the gas prepaid for the cron jobs
+func (k Keeper) CronGasPrice(ctx sdk.Context) (res sdk.DecCoin) {
+	k.paramSpace.Get(ctx, types.KeyCronGasPrice, &res)
+	return
+}
+
+// MaxCronGasPerBlock defines the maximum sum of the gas limits of the cron jobs executed in a block
+func (k Keeper) MaxCronGasPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxCronGasPerBlock, &res)
+	return
+}
+
+// StorageRentPrice defines the rent charged per stored byte of a contract at every rent period
+func (k Keeper) StorageRentPrice(ctx sdk.Context) (res sdk.DecCoin) {
+	k.paramSpace.Get(ctx, types.KeyStorageRentPrice, &res)
+	return
+}
+
+// StorageRentPeriod defines the blocks between the storage rent charges; zero disables the rent
+func (k Keeper) StorageRentPeriod(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyStorageRentPeriod, &res)
+	return
+}
+
+// CodeUploadAccess defines the addresses allowed to upload a code
+func (k Keeper) CodeUploadAccess(ctx sdk.Context) (res types.AccessConfig) {
+	k.paramSpace.Get(ctx, types.KeyCodeUploadAccess, &res)
+	return
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// NewWasmProposalHandler creates a new governance Handler for the wasm proposals;
+// the proposals bypass the upload access, instantiate permission and admin checks
+func NewWasmProposalHandler(k Keeper) govtypes.Handler {
+	govKeeper := k.withAuthorizationPolicy(GovAuthorizationPolicy{})
+
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.StoreCodeProposal:
+			return handleStoreCodeProposal(ctx, govKeeper, c)
+		case *types.InstantiateContractProposal:
+			return handleInstantiateContractProposal(ctx, govKeeper, c)
+		case *types.MigrateContractProposal:
+			return handleMigrateContractProposal(ctx, govKeeper, c)
+		case *types.UpdateAdminProposal:
+			return handleUpdateAdminProposal(ctx, govKeeper, c)
+		case *types.PinCodesProposal:
+			return handlePinCodesProposal(ctx, govKeeper, c)
+		case *types.UnpinCodesProposal:
+			return handleUnpinCodesProposal(ctx, govKeeper, c)
+		case *types.SudoContractProposal:
+			return handleSudoContractProposal(ctx, govKeeper, c)
+		case *types.FreezeContractProposal:
+			return handleFreezeContractProposal(ctx, govKeeper, c)
+		case *types.UnfreezeContractProposal:
+			return handleUnfreezeContractProposal(ctx, govKeeper, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
+		}
+	}
+}
+
+func handleStoreCodeProposal(ctx sdk.Context, k Keeper, p *types.StoreCodeProposal) error {
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return err
+	}
+
+	codeID, err := k.StoreCode(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreCode,
+			sdk.NewAttribute(types.AttributeKeySender, p.RunAs),
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	return nil
+}
+
+func handleInstantiateContractProposal(ctx sdk.Context, k Keeper, p *types.InstantiateContractProposal) error {
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return err
+	}
+
+	adminAddr := sdk.AccAddress{}
+	if len(p.Admin) != 0 {
+		adminAddr, err = sdk.AccAddressFromBech32(p.Admin)
+		if err != nil {
+			return err
+		}
+	}
+
+	subCtx := ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewGasMeter(k.MaxContractGas(ctx)))
+	contractAddr, _, err := k.InstantiateContract(
+		subCtx,
+		p.CodeID,
+		runAsAddr,
+		adminAddr,
+		p.InitMsg,
+		p.InitCoins,
+		p.Label,
+	)
+	if err != nil {
+		return err
+	}
+
+	// prepend the event to keep the events order
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeInstantiateContract,
+				sdk.NewAttribute(types.AttributeKeyCreator, p.RunAs),
+				sdk.NewAttribute(types.AttributeKeyAdmin, p.Admin),
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.CodeID)),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			),
+		}.AppendEvents(subCtx.EventManager().Events()),
+	)
+
+	return nil
+}
+
+func handleMigrateContractProposal(ctx sdk.Context, k Keeper, p *types.MigrateContractProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	subCtx := ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewGasMeter(k.MaxContractGas(ctx)))
+	if _, err := k.MigrateContract(subCtx, contractAddr, govAddr, p.NewCodeID, p.MigrateMsg); err != nil {
+		return err
+	}
+
+	// prepend the event to keep the events order
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeMigrateContract,
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.NewCodeID)),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+			),
+		}.AppendEvents(subCtx.EventManager().Events()),
+	)
+
+	return nil
+}
+
+func handleUpdateAdminProposal(ctx sdk.Context, k Keeper, p *types.UpdateAdminProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	var newAdminAddr sdk.AccAddress
+	if len(p.NewAdmin) != 0 {
+		newAdminAddr, err = sdk.AccAddressFromBech32(p.NewAdmin)
+		if err != nil {
+			return err
+		}
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if err := k.UpdateContractAdmin(ctx, contractAddr, govAddr, newAdminAddr); err != nil {
+		return err
+	}
+
+	if newAdminAddr.Empty() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClearContractAdmin,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+			),
+		)
+
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateContractAdmin,
+			sdk.NewAttribute(types.AttributeKeyAdmin, p.NewAdmin),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+		),
+	)
+
+	return nil
+}
+
+func handlePinCodesProposal(ctx sdk.Context, k Keeper, p *types.PinCodesProposal) error {
+	for _, codeID := range p.CodeIDs {
+		if err := k.PinCode(ctx, codeID); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+
+	return nil
+}
+
+func handleUnpinCodesProposal(ctx sdk.Context, k Keeper, p *types.UnpinCodesProposal) error {
+	for _, codeID := range p.CodeIDs {
+		if err := k.UnpinCode(ctx, codeID); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+
+	return nil
+}
+
+func handleSudoContractProposal(ctx sdk.Context, k Keeper, p *types.SudoContractProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	subCtx := ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewGasMeter(k.MaxContractGas(ctx)))
+	if _, err := k.Sudo(subCtx, contractAddr, p.Msg); err != nil {
+		return err
+	}
+
+	// prepend the event to keep the events order
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSudoContract,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+			),
+		}.AppendEvents(subCtx.EventManager().Events()),
+	)
+
+	return nil
+}
+
+func handleFreezeContractProposal(ctx sdk.Context, k Keeper, p *types.FreezeContractProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetContractFrozen(ctx, contractAddr, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+		),
+	)
+
+	return nil
+}
+
+func handleUnfreezeContractProposal(ctx sdk.Context, k Keeper, p *types.UnfreezeContractProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetContractFrozen(ctx, contractAddr, false); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func TestStoreCodeProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	// uploads are restricted to the governance
+	params := keeper.GetParams(ctx)
+	params.CodeUploadAccess = types.AllowNobody
+	keeper.SetParams(ctx, params)
+
+	_, _, runAs := keyPubAddr()
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	handler := NewWasmProposalHandler(keeper)
+	err = handler(ctx, &types.StoreCodeProposal{
+		Title:                 "title",
+		Description:           "desc",
+		RunAs:                 runAs.String(),
+		WASMByteCode:          wasmCode,
+		InstantiatePermission: &types.AllowNobody,
+	})
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, runAs.String(), codeInfo.Creator)
+	require.Equal(t, types.AllowNobody, codeInfo.InstantiateConfig)
+
+	storedCode, err := keeper.GetByteCode(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, wasmCode, storedCode)
+}
+
+func TestInstantiateContractProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, &types.AllowNobody)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	handler := NewWasmProposalHandler(keeper)
+	err = handler(ctx, &types.InstantiateContractProposal{
+		Title:       "title",
+		Description: "desc",
+		RunAs:       creator.String(),
+		Admin:       creator.String(),
+		CodeID:      codeID,
+		InitMsg:     initMsgBz,
+		InitCoins:   deposit,
+		Label:       "gov contract",
+	})
+	require.NoError(t, err)
+
+	contractAddr := types.GenerateContractAddress(codeID, 1)
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, creator.String(), contractInfo.Admin)
+	require.Equal(t, "gov contract", contractInfo.Label)
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, contractAddr))
+}
+
+func TestMigrateAndUpdateAdminProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+	newCodeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	_, _, newAdmin := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, creator, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: newAdmin})
+	require.NoError(t, err)
+
+	// the governance migrates the contract without being its admin
+	handler := NewWasmProposalHandler(keeper)
+	err = handler(ctx, &types.MigrateContractProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    contractAddr.String(),
+		NewCodeID:   newCodeID,
+		MigrateMsg:  migMsgBz,
+	})
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, newCodeID, contractInfo.CodeID)
+
+	err = handler(ctx, &types.UpdateAdminProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    contractAddr.String(),
+		NewAdmin:    newAdmin.String(),
+	})
+	require.NoError(t, err)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, newAdmin.String(), contractInfo.Admin)
+
+	// the sender of the governance operations is the gov module account
+	history := keeper.GetContractHistory(ctx, contractAddr)
+	require.Len(t, history, 3)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	require.Equal(t, govAddr, history[1].Sender)
+	require.Equal(t, govAddr, history[2].Sender)
+
+	// an empty new admin clears the admin
+	err = handler(ctx, &types.UpdateAdminProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    contractAddr.String(),
+	})
+	require.NoError(t, err)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Empty(t, contractInfo.Admin)
+
+	// contracts without an admin are not migratable
+	err = handler(ctx, &types.MigrateContractProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    contractAddr.String(),
+		NewCodeID:   codeID,
+		MigrateMsg:  migMsgBz,
+	})
+	require.ErrorIs(t, err, types.ErrNotMigratable)
+}
+
+func TestPinCodesProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	handler := NewWasmProposalHandler(keeper)
+	err = handler(ctx, &types.PinCodesProposal{Title: "title", Description: "desc", CodeIDs: []uint64{codeID}})
+	require.NoError(t, err)
+
+	err = handler(ctx, &types.UnpinCodesProposal{Title: "title", Description: "desc", CodeIDs: []uint64{codeID}})
+	require.NoError(t, err)
+
+	// unknown code
+	err = handler(ctx, &types.PinCodesProposal{Title: "title", Description: "desc", CodeIDs: []uint64{codeID + 1}})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestSudoContractProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	// the contract without an admin
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
+	require.NoError(t, err)
+
+	handler := NewWasmProposalHandler(keeper)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = handler(ctx, &types.SudoContractProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    contractAddr.String(),
+		Msg:         []byte(`{"steal_funds":{"recipient":"` + bob.String() + `","amount":[{"denom":"` + core.MicroBiqDenom + `","amount":"100000"}]}}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, bob))
+	require.Equal(t, types.EventTypeSudoContract, ctx.EventManager().Events()[0].Type)
+
+	// invalid sudo msg
+	err = handler(ctx, &types.SudoContractProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    contractAddr.String(),
+		Msg:         []byte(`{"unknown":{}}`),
+	})
+	require.ErrorIs(t, err, types.ErrSudoFailed)
+
+	// unknown contract
+	err = handler(ctx, &types.SudoContractProposal{
+		Title:       "title",
+		Description: "desc",
+		Contract:    bob.String(),
+		Msg:         []byte(`{}`),
+	})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestFreezeContractProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, creator, initMsgBz, deposit, "")
+	require.NoError(t, err)
+
+	handler := NewWasmProposalHandler(keeper)
+	err = handler(ctx, &types.FreezeContractProposal{Title: "title", Description: "desc", Contract: contractAddr.String()})
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.True(t, contractInfo.Frozen)
+
+	// already frozen
+	err = handler(ctx, &types.FreezeContractProposal{Title: "title", Description: "desc", Contract: contractAddr.String()})
+	require.Error(t, err)
+
+	// the frozen contract fails the execution and the migration
+	_, err = keeper.ExecuteContract(ctx, contractAddr, fred, []byte(`{"release":{}}`), nil)
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+
+	_, err = keeper.MigrateContract(ctx, contractAddr, creator, codeID, []byte(`{"payout":"`+fred.String()+`"}`))
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+
+	// but still answers the queries
+	res, err := keeper.queryToContract(ctx, contractAddr, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	require.Contains(t, string(res), fred.String())
+
+	err = handler(ctx, &types.UnfreezeContractProposal{Title: "title", Description: "desc", Contract: contractAddr.String()})
+	require.NoError(t, err)
+
+	// not frozen
+	err = handler(ctx, &types.UnfreezeContractProposal{Title: "title", Description: "desc", Contract: contractAddr.String()})
+	require.Error(t, err)
+
+	_, err = keeper.ExecuteContract(ctx, contractAddr, fred, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, bob))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"runtime/debug"
@@ -9,8 +10,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
@@ -88,31 +92,7 @@ func (q querier) ContractStore(c context.Context, req *types.QueryContractStoreR
 	}
 
 	// recover from out-of-gas panic
-	defer func() {
-
-		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			// TODO: Use ErrOutOfGas instead of ErrorOutOfGas which would allow us
-			// to keep the stracktrace.
-			case sdk.ErrorOutOfGas:
-				err = sdkerrors.Wrap(
-					sdkerrors.ErrOutOfGas, fmt.Sprintf(
-						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-						rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
-					),
-				)
-
-			default:
-				err = sdkerrors.Wrap(
-					sdkerrors.ErrPanic, fmt.Sprintf(
-						"recovered: %v\nstack:\n%v", r, string(debug.Stack()),
-					),
-				)
-			}
-
-			res = nil
-		}
-	}()
+	defer recoverQueryPanic(ctx, &err)
 
 	bz, err := q.queryToContract(ctx, contractAddr, req.QueryMsg)
 	if err != nil {
@@ -126,6 +106,167 @@ func (q querier) ContractStore(c context.Context, req *types.QueryContractStoreR
 	return
 }
 
+// ContractStoreBatch return the smart query results of the contracts at the same height;
+// the queries share the contract query gas limit, and the error of a query is returned in its result
+func (q querier) ContractStoreBatch(c context.Context, req *types.QueryContractStoreBatchRequest) (*types.QueryContractStoreBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Queries) > types.ContractMaxQueryBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many queries (max: %d, actual: %d)", types.ContractMaxQueryBatchSize, len(req.Queries))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// external query gas limit must be specified here
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(q.wasmConfig.ContractQueryGasLimit))
+
+	results := make([]types.ContractStoreResult, len(req.Queries))
+	for i, query := range req.Queries {
+		bz, err := q.queryToContractOfBatch(ctx, query)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		results[i].QueryResult = bz
+	}
+
+	return &types.QueryContractStoreBatchResponse{Results: results}, nil
+}
+
+// queryToContractOfBatch returns the smart query result of a query of the batch;
+// the queries after the out-of-gas query fail with the out-of-gas error too
+func (q querier) queryToContractOfBatch(ctx sdk.Context, req types.QueryContractStoreRequest) (bz []byte, err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// recover from out-of-gas panic
+	defer recoverQueryPanic(ctx, &err)
+
+	return q.queryToContract(ctx, contractAddr, req.QueryMsg)
+}
+
+// recoverQueryPanic converts the panic of the contract query to the error,
+// the query gas limit is reported for the out-of-gas panic
+func recoverQueryPanic(ctx sdk.Context, err *error) {
+	if r := recover(); r != nil {
+		switch rType := r.(type) {
+		// TODO: Use ErrOutOfGas instead of ErrorOutOfGas which would allow us
+		// to keep the stracktrace.
+		case sdk.ErrorOutOfGas:
+			*err = sdkerrors.Wrap(
+				sdkerrors.ErrOutOfGas, fmt.Sprintf(
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				),
+			)
+
+		default:
+			*err = sdkerrors.Wrap(
+				sdkerrors.ErrPanic, fmt.Sprintf(
+					"recovered: %v\nstack:\n%v", r, string(debug.Stack()),
+				),
+			)
+		}
+	}
+}
+
+// SimulateExecute dry-runs the contract execution on the branched state with the contract query gas limit;
+// the submessages and the replies are dispatched as in the tx, and no signature of the sender is required
+func (q querier) SimulateExecute(c context.Context, req *types.QuerySimulateExecuteRequest) (res *types.QuerySimulateExecuteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := req.Coins.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the state changes of the simulation are never committed
+	simCtx, _ := ctx.CacheContext()
+	simCtx = simCtx.WithGasMeter(sdk.NewGasMeter(q.wasmConfig.ContractQueryGasLimit)).
+		WithEventManager(sdk.NewEventManager())
+
+	// recover from out-of-gas panic
+	defer recoverQueryPanic(simCtx, &err)
+
+	data, err := q.ExecuteContract(simCtx, contractAddr, sender, req.ExecuteMsg, req.Coins)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	events := simCtx.EventManager().Events()
+	res = &types.QuerySimulateExecuteResponse{
+		Data:           data,
+		Events:         events.ToABCIEvents(),
+		GasUsed:        simCtx.GasMeter().GasConsumed(),
+		BalanceChanges: q.getBalanceChanges(ctx, simCtx, events),
+	}
+
+	return
+}
+
+// getBalanceChanges returns the balance changes of the accounts spending or receiving coins in the events,
+// by comparing their balances before and after the execution
+func (q querier) getBalanceChanges(ctx sdk.Context, simCtx sdk.Context, events sdk.Events) []types.BalanceChange {
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, event := range events {
+		var addressKey string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addressKey = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			addressKey = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == addressKey && !seen[string(attr.Value)] {
+				seen[string(attr.Value)] = true
+				addresses = append(addresses, string(attr.Value))
+			}
+		}
+	}
+
+	// balance queries must not be charged to the simulation
+	simCtx = simCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	var changes []types.BalanceChange
+	for _, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			continue
+		}
+
+		change := types.NewBalanceChange(addr, q.bankKeeper.GetAllBalances(ctx, addr), q.bankKeeper.GetAllBalances(simCtx, addr))
+		if change.Received.Empty() && change.Spent.Empty() {
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
 // RawStore return single key from the raw store data of a contract
 func (q querier) RawStore(c context.Context, req *types.QueryRawStoreRequest) (*types.QueryRawStoreResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -140,3 +281,357 @@ func (q querier) RawStore(c context.Context, req *types.QueryRawStoreRequest) (*
 		Data: res,
 	}, nil
 }
+
+// AllContractState returns the raw store data of a contract within the key range;
+// the pagination only supports the key and the limit, as the range is iterated directly
+func (q querier) AllContractState(c context.Context, req *types.QueryAllContractStateRequest) (*types.QueryAllContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	start, limit := req.StartKey, uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if req.Pagination.Offset != 0 || req.Pagination.Reverse || req.Pagination.CountTotal {
+			return nil, status.Error(codes.InvalidArgument, "only the key and the limit pagination are supported")
+		}
+
+		// the next key of the previous page is in the range already
+		if req.Pagination.Key != nil {
+			start = req.Pagination.Key
+		}
+
+		if req.Pagination.Limit != 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	if len(start) == 0 {
+		start = nil
+	}
+
+	end := req.EndKey
+	if len(end) == 0 {
+		end = nil
+	}
+
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return nil, status.Error(codes.InvalidArgument, "start key must be smaller than end key")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := q.GetContractInfo(ctx, contractAddr); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	iter := q.GetContractStoreRangeIterator(ctx, contractAddr, start, end)
+	defer iter.Close()
+
+	models := []types.Model{}
+	pageRes := &query.PageResponse{}
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(models)) == limit {
+			pageRes.NextKey = iter.Key()
+			break
+		}
+
+		models = append(models, types.Model{Key: iter.Key(), Value: iter.Value()})
+	}
+
+	return &types.QueryAllContractStateResponse{Models: models, Pagination: pageRes}, nil
+}
+
+// PredictContractAddress returns the address of the contract instantiated by
+// MsgInstantiateContract2 with the given code id, creator and salt
+func (q querier) PredictContractAddress(c context.Context, req *types.QueryPredictContractAddressRequest) (*types.QueryPredictContractAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	creatorAddr, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateSalt(req.Salt); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contractAddr, err := q.Keeper.PredictContractAddress(ctx, req.CodeId, creatorAddr, req.Salt)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPredictContractAddressResponse{ContractAddress: contractAddr.String()}, nil
+}
+
+// Codes returns all the stored code infos
+func (q querier) Codes(c context.Context, req *types.QueryCodesRequest) (*types.QueryCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	codeStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeKey)
+
+	var codeInfos []types.CodeInfo
+	pageRes, err := query.Paginate(codeStore, req.Pagination, func(_ []byte, value []byte) error {
+		var codeInfo types.CodeInfo
+		if err := q.cdc.Unmarshal(value, &codeInfo); err != nil {
+			return err
+		}
+
+		codeInfos = append(codeInfos, codeInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCodesResponse{CodeInfos: codeInfos, Pagination: pageRes}, nil
+}
+
+// PinnedCodes returns the ids of the codes pinned in the wasmvm cache
+func (q querier) PinnedCodes(c context.Context, req *types.QueryPinnedCodesRequest) (*types.QueryPinnedCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pinnedStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.PinnedCodeIndexKey)
+
+	var codeIDs []uint64
+	pageRes, err := query.Paginate(pinnedStore, req.Pagination, func(key []byte, _ []byte) error {
+		codeIDs = append(codeIDs, sdk.BigEndianToUint64(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPinnedCodesResponse{CodeIDs: codeIDs, Pagination: pageRes}, nil
+}
+
+// ContractsByCode returns the addresses of the contracts instantiated from the given code
+func (q querier) ContractsByCode(c context.Context, req *types.QueryContractsByCodeRequest) (*types.QueryContractsByCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts, pageRes, err := q.paginateContractIndex(ctx, types.GetContractsByCodePrefix(req.CodeId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByCodeResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+// ContractsByCreator returns the addresses of the contracts instantiated by the given creator
+func (q querier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	creatorAddr, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contracts, pageRes, err := q.paginateContractIndex(ctx, types.GetContractsByCreatorPrefix(creatorAddr), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByCreatorResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+// ContractsByAdmin returns the addresses of the contracts administrated by the given admin
+func (q querier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	adminAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contracts, pageRes, err := q.paginateContractIndex(ctx, types.GetContractsByAdminPrefix(adminAddr), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+// paginateContractIndex returns the contract addresses stored under the index prefix
+func (q querier) paginateContractIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	indexStore := prefix.NewStore(ctx.KVStore(q.storeKey), indexPrefix)
+
+	var contracts []string
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		// the key is the length prefixed contract address
+		contracts = append(contracts, sdk.AccAddress(key[1:]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return contracts, pageRes, nil
+}
+
+// ContractHistory returns the code and admin history of the contract
+func (q querier) ContractHistory(c context.Context, req *types.QueryContractHistoryRequest) (*types.QueryContractHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	historyStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractHistoryPrefix(contractAddr))
+
+	var entries []types.ContractHistoryEntry
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.ContractHistoryEntry
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// ContractStorageSize returns the bytes stored by the contract
+func (q querier) ContractStorageSize(c context.Context, req *types.QueryContractStorageSizeRequest) (*types.QueryContractStorageSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contractInfo, err := q.GetContractInfo(ctx, contractAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryContractStorageSizeResponse{StorageSize: contractInfo.StorageSize}, nil
+}
+
+// CronJob returns the cron job of the given id
+func (q querier) CronJob(c context.Context, req *types.QueryCronJobRequest) (*types.QueryCronJobResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	job, err := q.GetCronJob(ctx, req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryCronJobResponse{CronJob: job}, nil
+}
+
+// CronJobs returns the cron jobs of the given contract
+func (q querier) CronJobs(c context.Context, req *types.QueryCronJobsRequest) (*types.QueryCronJobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	jobStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetCronJobsByContractPrefix(contractAddr))
+
+	var jobs []types.CronJob
+	pageRes, err := query.Paginate(jobStore, req.Pagination, func(key []byte, _ []byte) error {
+		job, err := q.GetCronJob(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+
+		jobs = append(jobs, job)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCronJobsResponse{CronJobs: jobs, Pagination: pageRes}, nil
+}
+
+// GasSponsorship returns the gas sponsorship of the given contract
+func (q querier) GasSponsorship(c context.Context, req *types.QueryGasSponsorshipRequest) (*types.QueryGasSponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sponsorship, err := q.GetGasSponsorship(ctx, contractAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGasSponsorshipResponse{GasSponsorship: sponsorship}, nil
+}
+
+// ContractEvents returns the events emitted by the given contract from the event index
+func (q querier) ContractEvents(c context.Context, req *types.QueryContractEventsRequest) (*types.QueryContractEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if q.eventIndex == nil {
+		return nil, status.Error(codes.Unavailable, "contract event indexing is disabled")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.MinHeight < 0 || req.MaxHeight < 0 || (req.MaxHeight != 0 && req.MaxHeight < req.MinHeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.MinHeight, req.MaxHeight)
+	}
+
+	events, pageRes, err := q.eventIndex.ContractEvents(contractAddr, req.EventType, req.MinHeight, req.MaxHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractEventsResponse{
+		Events:     events,
+		Pagination: pageRes,
+	}, nil
+}
@@ -1,5 +1,4 @@
 package keeper
-package keeper
 
 import (
 	"encoding/json"
@@ -9,7 +8,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	core "github.com/bitwebs/iq-core/types"
 	"github.com/bitwebs/iq-core/x/wasm/types"
 
 	"github.com/stretchr/testify/require"
@@ -28,7 +29,7 @@ func TestQueryContractState(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -39,7 +40,7 @@ func TestQueryContractState(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	contractModel := []types.Model{
@@ -71,6 +72,232 @@ func TestQueryContractState(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestQueryAllContractState(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, verifier := keyPubAddr()
+	_, _, beneficiary := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    verifier,
+		Beneficiary: beneficiary,
+	})
+	require.NoError(t, err)
+
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	// hackatom stores the config under the key "config"
+	keeper.SetContractStore(ctx, addr, []types.Model{
+		{Key: []byte("a"), Value: []byte(`1`)},
+		{Key: []byte("b"), Value: []byte(`2`)},
+		{Key: []byte("c"), Value: []byte(`3`)},
+	})
+
+	querier := NewQuerier(keeper)
+	res, err := querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{ContractAddress: addr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Models, 4)
+	require.Equal(t, []byte("a"), res.Models[0].Key)
+	require.Equal(t, []byte("config"), res.Models[3].Key)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// key range
+	res, err = querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{
+		ContractAddress: addr.String(),
+		StartKey:        []byte("b"),
+		EndKey:          []byte("config"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Model{
+		{Key: []byte("b"), Value: []byte(`2`)},
+		{Key: []byte("c"), Value: []byte(`3`)},
+	}, res.Models)
+
+	// paginate the key range
+	res, err = querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{
+		ContractAddress: addr.String(),
+		EndKey:          []byte("config"),
+		Pagination:      &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Models, 2)
+	require.Equal(t, []byte("c"), res.Pagination.NextKey)
+
+	res, err = querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{
+		ContractAddress: addr.String(),
+		EndKey:          []byte("config"),
+		Pagination:      &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Model{{Key: []byte("c"), Value: []byte(`3`)}}, res.Models)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// invalid range
+	_, err = querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{
+		ContractAddress: addr.String(),
+		StartKey:        []byte("c"),
+		EndKey:          []byte("a"),
+	})
+	require.Error(t, err)
+
+	// offset pagination is not supported
+	_, err = querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{
+		ContractAddress: addr.String(),
+		Pagination:      &query.PageRequest{Offset: 1},
+	})
+	require.Error(t, err)
+
+	// non existing contract
+	_, err = querier.AllContractState(goCtx, &types.QueryAllContractStateRequest{ContractAddress: verifier.String()})
+	require.Error(t, err)
+}
+
+func TestQueryContractStoreBatch(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	var verifiers, contracts []sdk.AccAddress
+	for i := 0; i < 2; i++ {
+		_, _, verifier := keyPubAddr()
+		_, _, beneficiary := keyPubAddr()
+		initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+			Verifier:    verifier,
+			Beneficiary: beneficiary,
+		})
+		require.NoError(t, err)
+
+		addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+		require.NoError(t, err)
+
+		verifiers = append(verifiers, verifier)
+		contracts = append(contracts, addr)
+	}
+
+	querier := NewQuerier(keeper)
+	res, err := querier.ContractStoreBatch(goCtx, &types.QueryContractStoreBatchRequest{
+		Queries: []types.QueryContractStoreRequest{
+			{ContractAddress: contracts[0].String(), QueryMsg: []byte(`{"verifier":{}}`)},
+			{ContractAddress: "invalid", QueryMsg: []byte(`{"verifier":{}}`)},
+			{ContractAddress: contracts[1].String(), QueryMsg: []byte(`{"raw":{"key":"config"}}`)},
+			{ContractAddress: contracts[1].String(), QueryMsg: []byte(`{"verifier":{}}`)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 4)
+
+	require.Equal(t, fmt.Sprintf(`{"verifier":"%s"}`, verifiers[0]), string(res.Results[0].QueryResult))
+	require.Empty(t, res.Results[0].Error)
+	require.NotEmpty(t, res.Results[1].Error)
+	require.NotEmpty(t, res.Results[2].Error)
+	require.Equal(t, fmt.Sprintf(`{"verifier":"%s"}`, verifiers[1]), string(res.Results[3].QueryResult))
+
+	// too many queries
+	_, err = querier.ContractStoreBatch(goCtx, &types.QueryContractStoreBatchRequest{
+		Queries: make([]types.QueryContractStoreRequest, types.ContractMaxQueryBatchSize+1),
+	})
+	require.Error(t, err)
+
+	// the queries share the gas limit, so the queries after the out of gas fail too
+	keeper.wasmConfig.ContractQueryGasLimit = types.InstantiateContractCosts(false, 0) * 3 / 2
+
+	res, err = querier.ContractStoreBatch(goCtx, &types.QueryContractStoreBatchRequest{
+		Queries: []types.QueryContractStoreRequest{
+			{ContractAddress: contracts[0].String(), QueryMsg: []byte(`{"verifier":{}}`)},
+			{ContractAddress: contracts[1].String(), QueryMsg: []byte(`{"verifier":{}}`)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+	require.Empty(t, res.Results[0].Error)
+	require.Contains(t, res.Results[1].Error, "out of gas")
+}
+
+func TestQuerySimulateExecute(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 5000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	verifier := createFakeFundedAccount(ctx, accKeeper, bankKeeper, topUp)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    verifier,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
+	require.NoError(t, err)
+
+	querier := NewQuerier(keeper)
+
+	// the non verifier is not authorized to release
+	_, err = querier.SimulateExecute(goCtx, &types.QuerySimulateExecuteRequest{
+		ContractAddress: addr.String(),
+		Sender:          creator.String(),
+		ExecuteMsg:      []byte(`{"release":{}}`),
+	})
+	require.Error(t, err)
+
+	// invalid sender
+	_, err = querier.SimulateExecute(goCtx, &types.QuerySimulateExecuteRequest{
+		ContractAddress: addr.String(),
+		ExecuteMsg:      []byte(`{"release":{}}`),
+	})
+	require.Error(t, err)
+
+	// the verifier tops up the contract, and the contract releases everything to bob
+	res, err := querier.SimulateExecute(goCtx, &types.QuerySimulateExecuteRequest{
+		ContractAddress: addr.String(),
+		Sender:          verifier.String(),
+		ExecuteMsg:      []byte(`{"release":{}}`),
+		Coins:           topUp,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, res.GasUsed)
+	require.NotEmpty(t, res.Events)
+	require.ElementsMatch(t, []types.BalanceChange{
+		{Address: verifier.String(), Spent: topUp},
+		{Address: addr.String(), Spent: deposit},
+		{Address: bob.String(), Received: deposit.Add(topUp...)},
+	}, res.BalanceChanges)
+
+	// the state changes of the simulation are discarded
+	require.Equal(t, topUp, bankKeeper.GetAllBalances(ctx, verifier))
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, addr))
+	require.True(t, bankKeeper.GetAllBalances(ctx, bob).Empty())
+}
+
 func TestQueryParams(t *testing.T) {
 	input := CreateTestInput(t)
 	goCtx := sdk.WrapSDKContext(input.Ctx)
@@ -80,6 +307,121 @@ func TestQueryParams(t *testing.T) {
 	require.Equal(t, input.WasmKeeper.GetParams(input.Ctx), res.Params)
 }
 
+func TestQueryPinnedCodes(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	var codeIDs []uint64
+	for i := 0; i < 3; i++ {
+		codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+		require.NoError(t, err)
+		codeIDs = append(codeIDs, codeID)
+	}
+	require.NoError(t, keeper.PinCode(ctx, codeIDs[2]))
+	require.NoError(t, keeper.PinCode(ctx, codeIDs[0]))
+
+	querier := NewQuerier(keeper)
+	res, err := querier.PinnedCodes(goCtx, &types.QueryPinnedCodesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{codeIDs[0], codeIDs[2]}, res.CodeIDs)
+
+	res, err = querier.PinnedCodes(goCtx, &types.QueryPinnedCodesRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{codeIDs[0]}, res.CodeIDs)
+	require.NotNil(t, res.Pagination.NextKey)
+}
+
+func TestQueryContractsByIndexes(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	otherCreator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	_, _, admin := keyPubAddr()
+	_, _, newAdmin := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+	otherCodeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    creator,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	addr1, _, err := keeper.InstantiateContract(ctx, codeID, creator, admin, initMsgBz, nil, "first")
+	require.NoError(t, err)
+	addr2, _, err := keeper.InstantiateContract(ctx, codeID, otherCreator, admin, initMsgBz, nil, "second")
+	require.NoError(t, err)
+	addr3, _, err := keeper.InstantiateContract(ctx, otherCodeID, creator, sdk.AccAddress{}, initMsgBz, nil, "")
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, addr1)
+	require.NoError(t, err)
+	require.Equal(t, "first", contractInfo.Label)
+
+	querier := NewQuerier(keeper)
+
+	codesRes, err := querier.Codes(goCtx, &types.QueryCodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, codesRes.CodeInfos, 2)
+	require.Equal(t, codeID, codesRes.CodeInfos[0].CodeID)
+	require.Equal(t, otherCodeID, codesRes.CodeInfos[1].CodeID)
+
+	byCodeRes, err := querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{CodeId: codeID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{addr1.String(), addr2.String()}, byCodeRes.Contracts)
+
+	byCreatorRes, err := querier.ContractsByCreator(goCtx, &types.QueryContractsByCreatorRequest{Creator: creator.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{addr1.String(), addr3.String()}, byCreatorRes.Contracts)
+
+	byAdminRes, err := querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{Admin: admin.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{addr1.String(), addr2.String()}, byAdminRes.Contracts)
+
+	// pagination
+	pageRes, err := querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{CodeId: codeID, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, pageRes.Contracts, 1)
+	require.Equal(t, uint64(2), pageRes.Pagination.Total)
+
+	// admin and code changes move the contract between the indexes
+	contractInfo.Admin = newAdmin.String()
+	contractInfo.CodeID = otherCodeID
+	keeper.SetContractInfo(ctx, addr1, contractInfo)
+
+	byAdminRes, err = querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{Admin: admin.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{addr2.String()}, byAdminRes.Contracts)
+
+	byAdminRes, err = querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{Admin: newAdmin.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{addr1.String()}, byAdminRes.Contracts)
+
+	byCodeRes, err = querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{CodeId: otherCodeID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{addr1.String(), addr3.String()}, byCodeRes.Contracts)
+
+	_, err = querier.ContractsByCreator(goCtx, &types.QueryContractsByCreatorRequest{Creator: "invalid"})
+	require.Error(t, err)
+}
+
 func TestQueryMultipleGoroutines(t *testing.T) {
 	input := CreateTestInput(t)
 	goCtx := sdk.WrapSDKContext(input.Ctx)
@@ -93,7 +435,7 @@ func TestQueryMultipleGoroutines(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -104,7 +446,7 @@ func TestQueryMultipleGoroutines(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	addr, _, err := keeper.InstantiateContract(ctx, contractID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	contractModel := []types.Model{
@@ -76,7 +76,7 @@ func initRecurseContract(t *testing.T) (contract sdk.AccAddress, creator sdk.Acc
 	// store the code
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
-	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
 	require.NoError(t, err)
 
 	// instantiate the contract
@@ -88,14 +88,14 @@ func initRecurseContract(t *testing.T) (contract sdk.AccAddress, creator sdk.Acc
 	}
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
-	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
 	require.NoError(t, err)
 
 	return contractAddr, creator, ctx, keeper, cdc
 }
 
 func TestGasCostOnQuery(t *testing.T) {
-	GasNoWork := types.InstantiateContractCosts(0) + 3_509
+	GasNoWork := types.InstantiateContractCosts(false, 0) + 3_530
 	// Note: about 100 SDK gas (10k wasmVM gas) for each round of sha256
 	GasWork50 := GasNoWork + 5_662 // this is a little shy of 50k gas - to keep an eye on the limit
 
@@ -182,7 +182,7 @@ func TestGasCostOnQuery(t *testing.T) {
 }
 
 func TestGasOnExternalQuery(t *testing.T) {
-	GasNoWork := types.InstantiateContractCosts(0) + 3_509
+	GasNoWork := types.InstantiateContractCosts(false, 0) + 3_530
 	// Note: about 100 SDK gas (10k wasmVM gas) for each round of sha256
 	GasWork50 := GasNoWork + 5_662 // this is a little shy of 50k gas - to keep an eye on the limit
 
@@ -260,7 +260,7 @@ func TestLimitRecursiveQueryGas(t *testing.T) {
 	// This attack would allow us to use far more than the provided gas before
 	// eventually hitting an OutOfGas panic.
 
-	GasNoWork := types.InstantiateContractCosts(0) + 3_509
+	GasNoWork := types.InstantiateContractCosts(false, 0) + 3_530
 	GasWork2k := GasNoWork + 228_931
 
 	// This is overhead for calling into a sub-contract
@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// OnOpenChannel calls the contract to participate in the IBC channel handshake step.
+// In the IBC protocol this is either the `Channel Open Init` event on the initiating chain or
+// the `Channel Open Try` on the counterparty chain.
+// Protocol version and channel ordering should be verified for example.
+func (k Keeper) OnOpenChannel(ctx sdk.Context, contractAddress sdk.AccAddress, msg wasmvmtypes.IBCChannelOpenMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
+
+	codeInfo, storePrefix, err := k.getIBCContractDetails(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), 0), "Loading CosmWasm module: ibc-open-channel")
+
+	env := types.NewEnv(ctx, contractAddress)
+	gasUsed, err := k.wasmVM.IBCChannelOpen(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Channel Open")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	return nil
+}
+
+// OnConnectChannel calls the contract to let it know the IBC channel was established.
+// In the IBC protocol this is either the `Channel Open Ack` event on the initiating chain or
+// the `Channel Open Confirm` on the counterparty chain.
+func (k Keeper) OnConnectChannel(ctx sdk.Context, contractAddress sdk.AccAddress, msg wasmvmtypes.IBCChannelConnectMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
+
+	codeInfo, storePrefix, err := k.getIBCContractDetails(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), 0), "Loading CosmWasm module: ibc-connect-channel")
+
+	env := types.NewEnv(ctx, contractAddress)
+	res, gasUsed, err := k.wasmVM.IBCChannelConnect(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Channel Connect")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddress, res)
+}
+
+// OnCloseChannel calls the contract to let it know the IBC channel is closed.
+// Calling modules MAY atomically execute appropriate application logic in conjunction with calling chanCloseConfirm.
+func (k Keeper) OnCloseChannel(ctx sdk.Context, contractAddress sdk.AccAddress, msg wasmvmtypes.IBCChannelCloseMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
+
+	codeInfo, storePrefix, err := k.getIBCContractDetails(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), 0), "Loading CosmWasm module: ibc-close-channel")
+
+	env := types.NewEnv(ctx, contractAddress)
+	res, gasUsed, err := k.wasmVM.IBCChannelClose(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Channel Close")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddress, res)
+}
+
+// OnRecvPacket calls the contract to process the incoming IBC packet. The contract fully owns the data processing and
+// returns the acknowledgement data for the chain level. This allows custom applications and protocols on top
+// of IBC. Although it is recommended to use a standard acknowledgement envelope defined in
+// https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#acknowledgement-envelope
+func (k Keeper) OnRecvPacket(ctx sdk.Context, contractAddress sdk.AccAddress, msg wasmvmtypes.IBCPacketReceiveMsg) ([]byte, error) {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
+
+	codeInfo, storePrefix, err := k.getIBCContractDetails(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), len(msg.Packet.Data)), "Loading CosmWasm module: ibc-recv-packet")
+
+	env := types.NewEnv(ctx, contractAddress)
+	res, gasUsed, err := k.wasmVM.IBCPacketReceive(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Packet Receive")
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	if err := k.handleIBCContractResponse(ctx, contractAddress, res.Attributes, res.Events, res.Messages); err != nil {
+		return nil, err
+	}
+
+	return res.Acknowledgement, nil
+}
+
+// OnAckPacket calls the contract to handle the "acknowledgement" data which can contain success or failure of a packet.
+// Acknowledgement can be async and delivered later. The contract must handle the result of the acknowledgement
+// for the packet it sent.
+func (k Keeper) OnAckPacket(ctx sdk.Context, contractAddress sdk.AccAddress, msg wasmvmtypes.IBCPacketAckMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
+
+	codeInfo, storePrefix, err := k.getIBCContractDetails(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), len(msg.Acknowledgement.Data)), "Loading CosmWasm module: ibc-ack-packet")
+
+	env := types.NewEnv(ctx, contractAddress)
+	res, gasUsed, err := k.wasmVM.IBCPacketAck(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Packet Ack")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddress, res)
+}
+
+// OnTimeoutPacket calls the contract to let it know the packet was never received on the destination chain within
+// the timeout boundaries.
+// The contract should handle this on the application level and undo the original operation
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, contractAddress sdk.AccAddress, msg wasmvmtypes.IBCPacketTimeoutMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
+
+	codeInfo, storePrefix, err := k.getIBCContractDetails(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.IsPinnedCode(ctx, codeInfo.CodeID), len(msg.Packet.Data)), "Loading CosmWasm module: ibc-timeout-packet")
+
+	env := types.NewEnv(ctx, contractAddress)
+	res, gasUsed, err := k.wasmVM.IBCPacketTimeout(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.getWasmVMQuerier(ctx, contractAddress),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Packet Timeout")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	k.addContractStorageSize(ctx, contractAddress, storePrefix.SizeDelta())
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddress, res)
+}
+
+func (k Keeper) handleIBCBasicContractResponse(ctx sdk.Context, contractAddress sdk.AccAddress, res *wasmvmtypes.IBCBasicResponse) error {
+	return k.handleIBCContractResponse(ctx, contractAddress, res.Attributes, res.Events, res.Messages)
+}
+
+// handleIBCContractResponse emits the events and dispatches the messages of the IBC callback;
+// the callbacks have no data to return
+func (k Keeper) handleIBCContractResponse(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	attributes []wasmvmtypes.EventAttribute,
+	wasmEvents []wasmvmtypes.Event,
+	msgs []wasmvmtypes.SubMsg) error {
+
+	// consume gas for wasm events
+	ctx.GasMeter().ConsumeGas(types.EventCosts(attributes, wasmEvents), "Event Cost")
+
+	// parse wasm events to sdk events
+	events, err := types.ParseEvents(contractAddress, attributes, wasmEvents)
+	if err != nil {
+		return sdkerrors.Wrap(err, "event validation failed")
+	}
+
+	// emit events
+	ctx.EventManager().EmitEvents(events)
+
+	// dispatch submessages and messages
+	if _, err := k.dispatchMessages(ctx, contractAddress, msgs...); err != nil {
+		return sdkerrors.Wrap(err, "dispatch")
+	}
+
+	return nil
+}
+
+// getIBCContractDetails returns the details of the contract called back by the IBC
+// handlers; a frozen contract fails its callbacks like its executions
+func (k Keeper) getIBCContractDetails(ctx sdk.Context, contractAddress sdk.AccAddress) (types.CodeInfo, *types.StorageSizeStore, error) {
+	contractInfo, codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
+	if err != nil {
+		return types.CodeInfo{}, nil, err
+	}
+
+	if contractInfo.Frozen {
+		return types.CodeInfo{}, nil, sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", contractAddress)
+	}
+
+	return codeInfo, storePrefix, nil
+}
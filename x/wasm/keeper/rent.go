@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// addContractStorageSize applies the change of the stored bytes to the contract info;
+// the contract indexes are left untouched as they do not depend on the storage size.
+// A contract store set without the contract info has no size to track.
+func (k Keeper) addContractStorageSize(ctx sdk.Context, contractAddress sdk.AccAddress, delta int64) {
+	if delta == 0 {
+		return
+	}
+
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return
+	}
+
+	contractInfo.AddStorageSize(delta)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractInfoKey(contractAddress), k.cdc.MustMarshal(&contractInfo))
+}
+
+// maxStorageRentContractsPerBlock bounds the contracts a block visits to charge the storage rent
+const maxStorageRentContractsPerBlock = 100
+
+// ChargeStorageRent charges the storage rent of every contract holding some state from
+// the contract balance once per rent period; a contract whose balance does not cover
+// the rent pays what it holds and is flagged in arrears until it pays a full rent again.
+// A rent round starts at the first block of the period and visits a bounded number of
+// contracts per block, resuming from the stored cursor until all contracts are visited;
+// a period starting before the round completes does not restart it.
+func (k Keeper) ChargeStorageRent(ctx sdk.Context) {
+	period := k.StorageRentPeriod(ctx)
+	if period == 0 {
+		return
+	}
+
+	price := k.StorageRentPrice(ctx)
+	if !price.IsPositive() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.StorageRentCursorKey)
+	if cursor == nil {
+		if uint64(ctx.BlockHeight())%period != 0 {
+			return
+		}
+
+		cursor = types.ContractInfoKey
+	}
+
+	var contractInfos []types.ContractInfo
+	iter := store.Iterator(cursor, sdk.PrefixEndBytes(types.ContractInfoKey))
+	for visited := 0; iter.Valid() && visited < maxStorageRentContractsPerBlock; iter.Next() {
+		visited++
+
+		var contractInfo types.ContractInfo
+		k.cdc.MustUnmarshal(iter.Value(), &contractInfo)
+		if contractInfo.StorageSize > 0 {
+			contractInfos = append(contractInfos, contractInfo)
+		}
+	}
+
+	if iter.Valid() {
+		store.Set(types.StorageRentCursorKey, append([]byte{}, iter.Key()...))
+	} else {
+		store.Delete(types.StorageRentCursorKey)
+	}
+	iter.Close()
+
+	for _, contractInfo := range contractInfos {
+		contractAddress, err := sdk.AccAddressFromBech32(contractInfo.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		rent := price.Amount.MulInt(sdk.NewIntFromUint64(contractInfo.StorageSize)).Ceil().TruncateInt()
+		balance := k.bankKeeper.SpendableCoins(ctx, contractAddress).AmountOf(price.Denom)
+
+		paid := sdk.MinInt(rent, balance)
+		if paid.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddress, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(price.Denom, paid))); err != nil {
+				panic(err)
+			}
+		}
+
+		inArrears := paid.LT(rent)
+		event := sdk.NewEvent(
+			types.EventTypeStorageRent,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractInfo.Address),
+			sdk.NewAttribute(types.AttributeKeyRent, sdk.NewCoin(price.Denom, paid).String()),
+		)
+		if inArrears {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRentDue, sdk.NewCoin(price.Denom, rent.Sub(paid)).String()))
+		}
+
+		ctx.EventManager().EmitEvent(event)
+
+		if contractInfo.RentInArrears != inArrears {
+			contractInfo.RentInArrears = inArrears
+			k.SetContractInfo(ctx, contractAddress, contractInfo)
+		}
+	}
+}
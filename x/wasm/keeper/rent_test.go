@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func contractStoreSize(ctx sdk.Context, keeper Keeper, contractAddr sdk.AccAddress) (size uint64) {
+	iter := keeper.GetContractStoreIterator(ctx, contractAddr)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		size += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+
+	return size
+}
+
+func TestContractStorageSize(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	burnerCode, err := ioutil.ReadFile("./testdata/burner.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+	burnerCodeID, err := keeper.StoreCode(ctx, creator, burnerCode, nil)
+	require.NoError(t, err)
+
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: fred,
+	})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, creator, initMsgBz, deposit, "")
+	require.NoError(t, err)
+
+	// the instantiation counts the stored config
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.NotZero(t, contractInfo.StorageSize)
+	require.Equal(t, contractStoreSize(ctx, keeper, contractAddr), contractInfo.StorageSize)
+
+	// the set models are counted
+	keeper.SetContractStore(ctx, contractAddr, []types.Model{{Key: []byte("foo"), Value: []byte("bar")}})
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, contractStoreSize(ctx, keeper, contractAddr), contractInfo.StorageSize)
+
+	q := NewQuerier(keeper)
+	res, err := q.ContractStorageSize(sdk.WrapSDKContext(ctx), &types.QueryContractStorageSizeRequest{ContractAddress: contractAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, contractInfo.StorageSize, res.StorageSize)
+
+	_, err = q.ContractStorageSize(sdk.WrapSDKContext(ctx), &types.QueryContractStorageSizeRequest{ContractAddress: fred.String()})
+	require.Error(t, err)
+
+	// the burner migration deletes all the keys
+	_, _, payout := keyPubAddr()
+	migMsgBz, err := json.Marshal(struct {
+		Payout sdk.AccAddress `json:"payout"`
+	}{Payout: payout})
+	require.NoError(t, err)
+
+	_, err = keeper.MigrateContract(ctx, contractAddr, creator, burnerCodeID, migMsgBz)
+	require.NoError(t, err)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Zero(t, contractInfo.StorageSize)
+	require.Zero(t, contractStoreSize(ctx, keeper, contractAddr))
+}
+
+func TestChargeStorageRent(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	contractAddr := setupCronContract(t, input, deposit)
+	feeCollector := accKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	storageSize := int64(contractInfo.StorageSize)
+
+	params := keeper.GetParams(ctx)
+	params.StorageRentPrice = sdk.NewDecCoinFromDec(core.MicroBiqDenom, sdk.NewDecWithPrec(5, 1))
+	params.StorageRentPeriod = 10
+	keeper.SetParams(ctx, params)
+
+	// no rent outside of the rent period
+	keeper.ChargeStorageRent(ctx.WithBlockHeight(11))
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, contractAddr))
+
+	// the rent is rounded up
+	rent := (storageSize + 1) / 2
+	keeper.ChargeStorageRent(ctx.WithBlockHeight(10))
+	require.Equal(t, deposit.AmountOf(core.MicroBiqDenom).SubRaw(rent), bankKeeper.GetBalance(ctx, contractAddr, core.MicroBiqDenom).Amount)
+	require.Equal(t, sdk.NewInt(rent), bankKeeper.GetBalance(ctx, feeCollector, core.MicroBiqDenom).Amount)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.False(t, contractInfo.RentInArrears)
+
+	// the contract pays what it holds and falls in arrears
+	params.StorageRentPrice = sdk.NewDecCoin(core.MicroBiqDenom, sdk.NewInt(1000))
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.ChargeStorageRent(ctx.WithBlockHeight(20))
+	require.True(t, bankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+	require.Equal(t, deposit.AmountOf(core.MicroBiqDenom), bankKeeper.GetBalance(ctx, feeCollector, core.MicroBiqDenom).Amount)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.True(t, contractInfo.RentInArrears)
+
+	var rentEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeStorageRent {
+			rentEvent = event
+		}
+	}
+	require.Equal(t, contractAddr.String(), eventAttribute(rentEvent, types.AttributeKeyContractAddress))
+	require.Equal(t, sdk.NewCoin(core.MicroBiqDenom, deposit.AmountOf(core.MicroBiqDenom).SubRaw(rent)).String(), eventAttribute(rentEvent, types.AttributeKeyRent))
+	require.Equal(t, sdk.NewCoin(core.MicroBiqDenom, sdk.NewInt(1000*storageSize-100000+rent)).String(), eventAttribute(rentEvent, types.AttributeKeyRentDue))
+
+	// a full payment clears the arrears
+	params.StorageRentPrice = sdk.NewDecCoin(core.MicroBiqDenom, sdk.NewInt(1))
+	keeper.SetParams(ctx, params)
+
+	require.NoError(t, bankKeeper.SendCoins(ctx, createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit), contractAddr, deposit))
+	keeper.ChargeStorageRent(ctx.WithBlockHeight(30))
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.False(t, contractInfo.RentInArrears)
+	require.Equal(t, deposit.AmountOf(core.MicroBiqDenom).SubRaw(storageSize), bankKeeper.GetBalance(ctx, contractAddr, core.MicroBiqDenom).Amount)
+}
+
+func TestChargeStorageRentDisabled(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, bankKeeper, keeper := input.Ctx, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	contractAddr := setupCronContract(t, input, deposit)
+
+	// the default zero period disables the rent
+	require.Zero(t, keeper.StorageRentPeriod(ctx))
+	keeper.ChargeStorageRent(ctx.WithBlockHeight(100))
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, contractAddr))
+}
+
+func TestChargeStorageRentBoundedPerBlock(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	params := keeper.GetParams(ctx)
+	params.StorageRentPrice = sdk.NewDecCoin(core.MicroBiqDenom, sdk.NewInt(1))
+	params.StorageRentPeriod = 10
+	keeper.SetParams(ctx, params)
+
+	_, _, creator := keyPubAddr()
+	for i := 0; i <= maxStorageRentContractsPerBlock; i++ {
+		_, _, contractAddr := keyPubAddr()
+		keeper.SetContractInfo(ctx, contractAddr, types.NewContractInfo(1, contractAddr, creator, sdk.AccAddress{}, []byte("{}"), ""))
+		keeper.addContractStorageSize(ctx, contractAddr, 100)
+	}
+
+	rentEvents := func(height int64) (count int) {
+		ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		keeper.ChargeStorageRent(ctx)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeStorageRent {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	// the round resumes from the cursor in the next block
+	require.Equal(t, maxStorageRentContractsPerBlock, rentEvents(10))
+	require.Equal(t, 1, rentEvents(11))
+	require.Zero(t, rentEvents(12))
+
+	// a period starting before the round completes continues the round
+	require.Equal(t, maxStorageRentContractsPerBlock, rentEvents(20))
+	require.Equal(t, 1, rentEvents(30))
+	require.Zero(t, rentEvents(31))
+}
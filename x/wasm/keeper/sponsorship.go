@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// GetGasSponsorship returns the gas sponsorship of the contract
+func (k Keeper) GetGasSponsorship(ctx sdk.Context, contractAddress sdk.AccAddress) (sponsorship types.GasSponsorship, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGasSponsorshipKey(contractAddress))
+	if bz == nil {
+		return types.GasSponsorship{}, sdkerrors.Wrapf(types.ErrNotFound, "gas sponsorship of contract %s", contractAddress)
+	}
+
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, nil
+}
+
+// SetGasSponsorship stores the gas sponsorship of the contract
+func (k Keeper) SetGasSponsorship(ctx sdk.Context, sponsorship types.GasSponsorship) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGasSponsorshipKey(sponsorship.ContractAddress()), k.cdc.MustMarshal(&sponsorship))
+}
+
+// IterateGasSponsorships iterates the gas sponsorships in the order of the contract addresses
+func (k Keeper) IterateGasSponsorships(ctx sdk.Context, cb func(types.GasSponsorship) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GasSponsorshipKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sponsorship types.GasSponsorship
+		k.cdc.MustUnmarshal(iter.Value(), &sponsorship)
+		// cb returns true to stop early
+		if cb(sponsorship) {
+			break
+		}
+	}
+}
+
+// RegisterGasSponsorship makes the contract pay the fees of the txs executing it within
+// the limits; an existing sponsorship is replaced and keeps the fees already spent
+func (k Keeper) RegisterGasSponsorship(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	userLimit sdk.Coins,
+	userPeriod uint64,
+	blockLimit sdk.Coins) error {
+	if _, err := k.GetContractInfo(ctx, contractAddress); err != nil {
+		return err
+	}
+
+	sponsorship := types.NewGasSponsorship(contractAddress, userLimit, userPeriod, blockLimit)
+	if err := sponsorship.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.SetGasSponsorship(ctx, sponsorship)
+	return nil
+}
+
+// RemoveGasSponsorship stops the contract paying the fees and clears the fees spent
+func (k Keeper) RemoveGasSponsorship(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if _, err := k.GetGasSponsorship(ctx, contractAddress); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGasSponsorshipKey(contractAddress))
+	store.Delete(types.GetBlockSpendingKey(contractAddress))
+
+	// collect the keys first, the deletion invalidates the iterator
+	var userKeys [][]byte
+	prefixStore := prefix.NewStore(store, types.GetUserSpendingsPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		userKeys = append(userKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range userKeys {
+		prefixStore.Delete(key)
+	}
+
+	return nil
+}
+
+// GetUserSpending returns the fee sponsored by the contract for the user in the
+// current user period, which is reset once the period has elapsed
+func (k Keeper) GetUserSpending(ctx sdk.Context, sponsorship types.GasSponsorship, user sdk.AccAddress) types.SponsoredSpending {
+	spending := k.getSponsoredSpending(ctx, types.GetUserSpendingKey(sponsorship.ContractAddress(), user))
+	if spending.StartHeight == 0 ||
+		(sponsorship.UserPeriod != 0 && ctx.BlockHeight() >= spending.StartHeight+int64(sponsorship.UserPeriod)) {
+		return types.SponsoredSpending{StartHeight: ctx.BlockHeight(), Spent: sdk.Coins{}}
+	}
+
+	return spending
+}
+
+// GetBlockSpending returns the fee sponsored by the contract within the current block
+func (k Keeper) GetBlockSpending(ctx sdk.Context, contractAddress sdk.AccAddress) types.SponsoredSpending {
+	spending := k.getSponsoredSpending(ctx, types.GetBlockSpendingKey(contractAddress))
+	if spending.StartHeight != ctx.BlockHeight() {
+		return types.SponsoredSpending{StartHeight: ctx.BlockHeight(), Spent: sdk.Coins{}}
+	}
+
+	return spending
+}
+
+func (k Keeper) getSponsoredSpending(ctx sdk.Context, key []byte) (spending types.SponsoredSpending) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SponsoredSpending{}
+	}
+
+	k.cdc.MustUnmarshal(bz, &spending)
+	return spending
+}
+
+// UseGasSponsorship counts the fee of the user against the limits of the contract
+// sponsorship; the fee itself is paid from the contract by the ante handler.
+// The txs of a frozen contract are not sponsored as their executions fail.
+func (k Keeper) UseGasSponsorship(ctx sdk.Context, contractAddress, user sdk.AccAddress, fee sdk.Coins) error {
+	sponsorship, err := k.GetGasSponsorship(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contractInfo.Frozen {
+		return sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", contractAddress)
+	}
+
+	userSpending := k.GetUserSpending(ctx, sponsorship, user)
+	userSpending.Spent = userSpending.Spent.Add(fee...)
+	if !userSpending.Spent.IsAllLTE(sponsorship.UserLimit) {
+		return sdkerrors.Wrapf(types.ErrGasSponsorshipLimit, "user %s spent %s over the limit %s", user, userSpending.Spent, sponsorship.UserLimit)
+	}
+
+	blockSpending := k.GetBlockSpending(ctx, contractAddress)
+	blockSpending.Spent = blockSpending.Spent.Add(fee...)
+	if !blockSpending.Spent.IsAllLTE(sponsorship.BlockLimit) {
+		return sdkerrors.Wrapf(types.ErrGasSponsorshipLimit, "block spent %s over the limit %s", blockSpending.Spent, sponsorship.BlockLimit)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUserSpendingKey(contractAddress, user), k.cdc.MustMarshal(&userSpending))
+	store.Set(types.GetBlockSpendingKey(contractAddress), k.cdc.MustMarshal(&blockSpending))
+
+	return nil
+}
+
+// SponsorFee pays the fee of the user from the contract within the limits of its
+// gas sponsorship; the fee is sent to the user, which pays it on to the fee collector
+func (k Keeper) SponsorFee(ctx sdk.Context, contractAddress, user sdk.AccAddress, fee sdk.Coins) error {
+	if err := k.UseGasSponsorship(ctx, contractAddress, user, fee); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, contractAddress, user, fee); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorFee,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
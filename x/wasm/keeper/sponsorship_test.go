@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func TestRegisterGasSponsorship(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	contractAddr := setupCronContract(t, input, deposit)
+	limit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+
+	// only a contract sponsors the gas
+	_, _, bob := keyPubAddr()
+	require.Error(t, keeper.RegisterGasSponsorship(ctx, bob, limit, 10, limit))
+
+	// the limits must be positive
+	require.Error(t, keeper.RegisterGasSponsorship(ctx, contractAddr, sdk.Coins{}, 10, limit))
+
+	require.NoError(t, keeper.RegisterGasSponsorship(ctx, contractAddr, limit, 10, limit))
+	sponsorship, err := keeper.GetGasSponsorship(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, types.NewGasSponsorship(contractAddr, limit, 10, limit), sponsorship)
+
+	q := NewQuerier(keeper)
+	res, err := q.GasSponsorship(sdk.WrapSDKContext(ctx), &types.QueryGasSponsorshipRequest{ContractAddress: contractAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sponsorship, res.GasSponsorship)
+
+	// the removal clears the spendings
+	_, _, user := keyPubAddr()
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, user, limit))
+	require.NoError(t, keeper.RemoveGasSponsorship(ctx, contractAddr))
+	require.Error(t, keeper.RemoveGasSponsorship(ctx, contractAddr))
+
+	_, err = q.GasSponsorship(sdk.WrapSDKContext(ctx), &types.QueryGasSponsorshipRequest{ContractAddress: contractAddr.String()})
+	require.Error(t, err)
+
+	require.NoError(t, keeper.RegisterGasSponsorship(ctx, contractAddr, limit, 10, limit))
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, user, limit))
+}
+
+func TestUseGasSponsorship(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	contractAddr := setupCronContract(t, input, deposit)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100))
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	// not sponsored
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee), types.ErrNotFound)
+
+	userLimit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 200))
+	blockLimit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 300))
+	require.NoError(t, keeper.RegisterGasSponsorship(ctx, contractAddr, userLimit, 10, blockLimit))
+
+	// other denoms are not sponsored
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, sdk.NewCoins(sdk.NewInt64Coin(core.MicroBSDRDenom, 1))), types.ErrGasSponsorshipLimit)
+
+	// the user limit
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee))
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee))
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee), types.ErrGasSponsorshipLimit)
+
+	// the block limit
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, bob, fee))
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx, contractAddr, bob, fee), types.ErrGasSponsorshipLimit)
+	require.Equal(t, fee, keeper.GetUserSpending(ctx, types.NewGasSponsorship(contractAddr, userLimit, 10, blockLimit), bob).Spent)
+
+	// the block spending is reset on the next block, but not the user spending
+	ctx = ctx.WithBlockHeight(101)
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee), types.ErrGasSponsorshipLimit)
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, bob, fee))
+
+	// the user spending is reset after the user period
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee))
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee))
+
+	// a frozen contract is not sponsored
+	ctx = ctx.WithBlockHeight(120)
+	require.NoError(t, keeper.SetContractFrozen(ctx, contractAddr, true))
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx, contractAddr, bob, fee), types.ErrContractFrozen)
+}
+
+func TestUseGasSponsorshipWithoutUserPeriod(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	contractAddr := setupCronContract(t, input, deposit)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100))
+
+	require.NoError(t, keeper.RegisterGasSponsorship(ctx, contractAddr, fee, 0, deposit))
+
+	_, _, alice := keyPubAddr()
+	require.NoError(t, keeper.UseGasSponsorship(ctx, contractAddr, alice, fee))
+
+	// the user spending is never reset
+	require.ErrorIs(t, keeper.UseGasSponsorship(ctx.WithBlockHeight(1000000), contractAddr, alice, fee), types.ErrGasSponsorshipLimit)
+}
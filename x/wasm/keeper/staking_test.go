@@ -111,7 +111,7 @@ func TestInitializeStaking(t *testing.T) {
 	// upload staking derivative code
 	stakingCode, err := ioutil.ReadFile("./testdata/staking.wasm")
 	require.NoError(t, err)
-	stakingID, err := keeper.StoreCode(ctx, creatorAddr, stakingCode)
+	stakingID, err := keeper.StoreCode(ctx, creatorAddr, stakingCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), stakingID)
 
@@ -127,7 +127,7 @@ func TestInitializeStaking(t *testing.T) {
 	initBz, err := json.Marshal(&initMsg)
 	require.NoError(t, err)
 
-	stakingAddr, _, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, sdk.AccAddress{}, initBz, nil)
+	stakingAddr, _, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, sdk.AccAddress{}, initBz, nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, stakingAddr)
 
@@ -147,7 +147,7 @@ func TestInitializeStaking(t *testing.T) {
 	badBz, err := json.Marshal(&badInitMsg)
 	require.NoError(t, err)
 
-	_, _, err = keeper.InstantiateContract(ctx, stakingID, creatorAddr, sdk.AccAddress{}, badBz, nil)
+	_, _, err = keeper.InstantiateContract(ctx, stakingID, creatorAddr, sdk.AccAddress{}, badBz, nil, "")
 	require.Error(t, err)
 
 	// no changes to bonding shares
@@ -179,7 +179,7 @@ func initializeStaking(t *testing.T, input TestInput) InitInfo {
 	// upload staking derivative code
 	stakingCode, err := ioutil.ReadFile("./testdata/staking.wasm")
 	require.NoError(t, err)
-	stakingID, err := keeper.StoreCode(ctx, creatorAddr, stakingCode)
+	stakingID, err := keeper.StoreCode(ctx, creatorAddr, stakingCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), stakingID)
 
@@ -195,7 +195,7 @@ func initializeStaking(t *testing.T, input TestInput) InitInfo {
 	initBz, err := json.Marshal(&initMsg)
 	require.NoError(t, err)
 
-	stakingAddr, _, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, sdk.AccAddress{}, initBz, nil)
+	stakingAddr, _, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, sdk.AccAddress{}, initBz, nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, stakingAddr)
 
@@ -433,12 +433,12 @@ func TestQueryStakingInfo(t *testing.T) {
 	// upload mask code
 	maskCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
 	require.NoError(t, err)
-	maskID, err := keeper.StoreCode(ctx, creator, maskCode)
+	maskID, err := keeper.StoreCode(ctx, creator, maskCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(2), maskID)
 
 	// creator instantiates a contract and gives it tokens
-	maskAddr, _, err := keeper.InstantiateContract(ctx, maskID, creator, sdk.AccAddress{}, []byte("{}"), nil)
+	maskAddr, _, err := keeper.InstantiateContract(ctx, maskID, creator, sdk.AccAddress{}, []byte("{}"), nil, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, maskAddr)
 
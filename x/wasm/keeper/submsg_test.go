@@ -35,12 +35,12 @@ func TestDispatchSubMsgSuccessCase(t *testing.T) {
 	// upload code
 	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
 	require.NoError(t, err)
-	codeID, err := keeper.StoreCode(ctx, creator, reflectCode)
+	codeID, err := keeper.StoreCode(ctx, creator, reflectCode, nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), codeID)
 
 	// creator instantiates a contract and gives it tokens
-	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), contractStart)
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), contractStart, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, contractAddr)
 
@@ -147,13 +147,13 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 	// upload code
 	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
 	require.NoError(t, err)
-	reflectID, err := keeper.StoreCode(ctx, uploader, reflectCode)
+	reflectID, err := keeper.StoreCode(ctx, uploader, reflectCode, nil)
 	require.NoError(t, err)
 
 	// create hackatom contract for testing (for infinite loop)
 	hackatomCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
-	hackatomID, err := keeper.StoreCode(ctx, uploader, hackatomCode)
+	hackatomID, err := keeper.StoreCode(ctx, uploader, hackatomCode, nil)
 	require.NoError(t, err)
 	_, _, bob := keyPubAddr()
 	_, _, fred := keyPubAddr()
@@ -163,7 +163,7 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 	}
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
-	hackatomAddr, _, err := keeper.InstantiateContract(ctx, hackatomID, uploader, sdk.AccAddress{}, initMsgBz, contractStart)
+	hackatomAddr, _, err := keeper.InstantiateContract(ctx, hackatomID, uploader, sdk.AccAddress{}, initMsgBz, contractStart, "")
 	require.NoError(t, err)
 
 	validBankSend := func(contract, emptyAccount string) wasmvmtypes.CosmosMsg {
@@ -274,14 +274,14 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			submsgID: 5,
 			msg:      validBankSend,
 			// note we charge another 40k for the reply call
-			resultAssertions: []assertion{assertReturnedEvents(5), assertGasUsed(134000, 136000)},
+			resultAssertions: []assertion{assertReturnedEvents(5), assertGasUsed(142000, 144000)},
 		},
 		"not enough tokens": {
 			submsgID:    6,
 			msg:         invalidBankSend,
 			subMsgError: true,
 			// uses less gas than the send tokens (cost of bank transfer)
-			resultAssertions: []assertion{assertGasUsed(100000, 101000), assertErrorString("insufficient funds")},
+			resultAssertions: []assertion{assertGasUsed(107000, 108000), assertErrorString("insufficient funds")},
 		},
 		"out of gas panic with no gas limit": {
 			submsgID:        7,
@@ -294,7 +294,7 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			msg:      validBankSend,
 			gasLimit: &subGasLimit,
 			// uses same gas as call without limit
-			resultAssertions: []assertion{assertReturnedEvents(5), assertGasUsed(134000, 136000)},
+			resultAssertions: []assertion{assertReturnedEvents(5), assertGasUsed(142000, 144000)},
 		},
 		"not enough tokens with limit": {
 			submsgID:    16,
@@ -302,15 +302,15 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			subMsgError: true,
 			gasLimit:    &subGasLimit,
 			// uses same gas as call without limit
-			resultAssertions: []assertion{assertGasUsed(100000, 101000), assertErrorString("insufficient funds")},
+			resultAssertions: []assertion{assertGasUsed(107000, 108000), assertErrorString("insufficient funds")},
 		},
 		"out of gas caught with gas limit": {
 			submsgID:    17,
 			msg:         infiniteLoop,
 			subMsgError: true,
 			gasLimit:    &subGasLimit,
-			// uses all the subGasLimit, plus the 92k or so for the main contract
-			resultAssertions: []assertion{assertGasUsed(subGasLimit+93000, subGasLimit+95000), assertErrorString("out of gas")},
+			// uses all the subGasLimit, plus the 101k or so for the main contract
+			resultAssertions: []assertion{assertGasUsed(subGasLimit+101000, subGasLimit+103000), assertErrorString("out of gas")},
 		},
 		"instantiate contract gets address in data and events": {
 			submsgID:         21,
@@ -324,7 +324,7 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, contractStart)
 			_, _, empty := keyPubAddr()
 
-			contractAddr, _, err := keeper.InstantiateContract(ctx, reflectID, creator, sdk.AccAddress{}, []byte("{}"), contractStart)
+			contractAddr, _, err := keeper.InstantiateContract(ctx, reflectID, creator, sdk.AccAddress{}, []byte("{}"), contractStart, "")
 			require.NoError(t, err)
 
 			msg := tc.msg(contractAddr.String(), empty.String())
@@ -403,11 +403,11 @@ func TestDispatchSubMsgConditionalReplyOn(t *testing.T) {
 	// upload code
 	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
 	require.NoError(t, err)
-	codeID, err := keeper.StoreCode(ctx, creator, reflectCode)
+	codeID, err := keeper.StoreCode(ctx, creator, reflectCode, nil)
 	require.NoError(t, err)
 
 	// creator instantiates a contract and gives it tokens
-	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), contractStart)
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), contractStart, "")
 	require.NoError(t, err)
 
 	goodSend := wasmvmtypes.CosmosMsg{
@@ -517,3 +517,61 @@ func TestDispatchSubMsgConditionalReplyOn(t *testing.T) {
 		})
 	}
 }
+
+func TestDispatchSubMsgToFrozenContract(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper, bankKeeper := input.Ctx, input.AccKeeper, input.WasmKeeper, input.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.StoreCode(ctx, creator, reflectCode, nil)
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), nil, "")
+	require.NoError(t, err)
+	frozenAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), nil, "")
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetContractFrozen(ctx, frozenAddr, true))
+
+	// the contract executes the frozen contract in a submessage
+	reflectSend := ReflectHandleMsg{
+		ReflectSubMsg: &reflectSubPayload{
+			Msgs: []wasmvmtypes.SubMsg{{
+				ID: 7,
+				Msg: wasmvmtypes.CosmosMsg{
+					Wasm: &wasmvmtypes.WasmMsg{
+						Execute: &wasmvmtypes.ExecuteMsg{
+							ContractAddr: frozenAddr.String(),
+							Msg:          []byte(`{"reflect_msg":{"msgs":[]}}`),
+							Funds:        wasmvmtypes.Coins{},
+						},
+					},
+				},
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+		},
+	}
+	reflectSendBz, err := json.Marshal(reflectSend)
+	require.NoError(t, err)
+	_, err = keeper.ExecuteContract(ctx, contractAddr, creator, reflectSendBz, nil)
+	require.NoError(t, err)
+
+	queryBz, err := json.Marshal(ReflectQueryMsg{SubMsgResult: &SubCall{ID: 7}})
+	require.NoError(t, err)
+	queryRes, err := keeper.queryToContract(ctx, contractAddr, queryBz)
+	require.NoError(t, err)
+
+	var res wasmvmtypes.Reply
+	require.NoError(t, json.Unmarshal(queryRes, &res))
+	assert.Contains(t, res.Result.Err, types.ErrContractFrozen.Error())
+
+	// the frozen contract fails the execution without the reply on error
+	reflectSend.ReflectSubMsg.Msgs[0].ReplyOn = wasmvmtypes.ReplyNever
+	reflectSendBz, err = json.Marshal(reflectSend)
+	require.NoError(t, err)
+	_, err = keeper.ExecuteContract(ctx, contractAddr, creator, reflectSendBz, nil)
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+}
@@ -17,7 +17,11 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/modules/core"
+	ibchost "github.com/cosmos/ibc-go/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/modules/core/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -34,12 +38,16 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	customauth "github.com/bitwebs/iq-core/custom/auth"
 	custombank "github.com/bitwebs/iq-core/custom/bank"
@@ -147,11 +155,18 @@ type TestInput struct {
 	OracleKeeper       oraclekeeper.Keeper
 	MarketKeeper       marketkeeper.Keeper
 	TreasuryKeeper     treasurykeeper.Keeper
+	CapabilityKeeper   *capabilitykeeper.Keeper
+	IBCKeeper          *ibckeeper.Keeper
 	WasmKeeper         Keeper
 }
 
 // CreateTestInput nolint
 func CreateTestInput(t *testing.T) TestInput {
+	return CreateTestInputWithConfig(t, config.DefaultConfig())
+}
+
+// CreateTestInputWithConfig nolint
+func CreateTestInputWithConfig(t *testing.T, wasmConfig *config.Config) TestInput {
 	tempDir := t.TempDir()
 
 	keyContract := sdk.NewKVStoreKey(types.StoreKey)
@@ -164,6 +179,11 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(treasurytypes.StoreKey)
+	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	memKeyCapability := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)[capabilitytypes.MemStoreKey]
+	keyUpgrade := sdk.NewKVStoreKey(upgradetypes.StoreKey)
+	keyIBC := sdk.NewKVStoreKey(ibchost.StoreKey)
+	keyTransfer := sdk.NewKVStoreKey(ibctransfertypes.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -181,6 +201,11 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCapability, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(memKeyCapability, sdk.StoreTypeMemory, nil)
+	ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyIBC, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTransfer, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -204,6 +229,8 @@ func CreateTestInput(t *testing.T) TestInput {
 		markettypes.ModuleName:         {authtypes.Burner, authtypes.Minter},
 		treasurytypes.ModuleName:       {authtypes.Minter},
 		treasurytypes.BurnModuleName:   {authtypes.Burner},
+		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		types.ModuleName:               nil,
 	}
 
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams, tkeyParams)
@@ -300,6 +327,25 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	treasuryKeeper.SetParams(ctx, treasurytypes.DefaultParams())
 
+	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, keyCapability, memKeyCapability)
+	scopedIBCKeeper := capabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	scopedTransferKeeper := capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedWasmKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
+	capabilityKeeper.Seal()
+	capabilityKeeper.InitializeIndex(ctx, 1)
+
+	upgradeKeeper := upgradekeeper.NewKeeper(map[int64]bool{}, keyUpgrade, appCodec, tempDir, nil)
+	ibcKeeper := ibckeeper.NewKeeper(
+		appCodec, keyIBC, paramsKeeper.Subspace(ibchost.ModuleName),
+		stakingKeeper, upgradeKeeper, scopedIBCKeeper,
+	)
+	transferKeeper := ibctransferkeeper.NewKeeper(
+		appCodec, keyTransfer, paramsKeeper.Subspace(ibctransfertypes.ModuleName),
+		ibcKeeper.ChannelKeeper, &ibcKeeper.PortKeeper,
+		accountKeeper, bankKeeper, scopedTransferKeeper,
+	)
+	transferKeeper.SetPort(ctx, ibctransfertypes.PortID)
+
 	router := baseapp.NewMsgServiceRouter()
 	querier := baseapp.NewGRPCQueryRouter()
 	banktypes.RegisterQueryServer(querier, bankKeeper)
@@ -313,11 +359,15 @@ func CreateTestInput(t *testing.T) TestInput {
 		accountKeeper,
 		bankKeeper,
 		treasuryKeeper,
+		ibcKeeper.ChannelKeeper,
+		&ibcKeeper.PortKeeper,
+		scopedWasmKeeper,
+		transferKeeper,
 		router,
 		querier,
 		types.DefaultFeatures,
 		tempDir,
-		config.DefaultConfig(),
+		wasmConfig,
 	)
 
 	router.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
@@ -341,11 +391,12 @@ func CreateTestInput(t *testing.T) TestInput {
 		types.WasmQueryRouteTreasury: treasurywasm.NewWasmQuerier(treasuryKeeper),
 		types.WasmQueryRouteWasm:     NewWasmQuerier(keeper),
 		types.WasmQueryRouteOracle:   oraclewasm.NewWasmQuerier(oracleKeeper),
-	}, NewStargateWasmQuerier(keeper))
+	}, NewStargateWasmQuerier(keeper, appCodec, DefaultStargateQueryAllowlist()))
 	keeper.RegisterMsgParsers(map[string]types.WasmMsgParserInterface{
 		types.WasmMsgParserRouteBank:         bankwasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteStaking:      stakingwasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteMarket:       marketwasm.NewWasmMsgParser(),
+		types.WasmMsgParserRouteOracle:       oraclewasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteDistribution: distrwasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteGov:          govwasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteWasm:         NewWasmMsgParser(),
@@ -364,6 +415,8 @@ func CreateTestInput(t *testing.T) TestInput {
 		oracleKeeper,
 		marketKeeper,
 		treasuryKeeper,
+		capabilityKeeper,
+		ibcKeeper,
 		keeper}
 }
 
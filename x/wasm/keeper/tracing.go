@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+// contractTracer writes the traces of the contract calls as JSON lines;
+// it only exists when the contract tracing mode is enabled
+type contractTracer struct {
+	mtx    sync.Mutex
+	writer io.Writer
+}
+
+// newContractTracer opens the trace file to append the traces to
+func newContractTracer(homePath string, traceFile string) (*contractTracer, error) {
+	if !filepath.IsAbs(traceFile) {
+		traceFile = filepath.Join(homePath, traceFile)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(traceFile), 0o755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(traceFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractTracer{writer: file}, nil
+}
+
+// close closes the trace file
+func (t *contractTracer) close() error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if closer, ok := t.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+func (t *contractTracer) write(trace *types.ContractTrace) error {
+	bz, err := json.Marshal(trace)
+	if err != nil {
+		return err
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	_, err = t.writer.Write(append(bz, '\n'))
+	return err
+}
+
+// startContractTrace starts the trace of the contract call and returns the contract store
+// recording its accesses to the trace; the trace is nil when the tracing mode is disabled.
+// Only the calls of the block execution are traced: the check txs, the simulations and the
+// queries all run on check state contexts.
+func (k Keeper) startContractTrace(
+	ctx sdk.Context,
+	call string,
+	contractAddress sdk.AccAddress,
+	contractStore storetypes.KVStore) (*types.ContractTrace, storetypes.KVStore) {
+	if k.tracer == nil || ctx.IsCheckTx() {
+		return nil, contractStore
+	}
+
+	trace := &types.ContractTrace{
+		Height:   ctx.BlockHeight(),
+		Call:     call,
+		Contract: contractAddress.String(),
+		Depth:    types.GetSubMsgDepth(ctx),
+	}
+
+	if txBytes := ctx.TxBytes(); len(txBytes) != 0 {
+		trace.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+
+	return trace, types.NewTracingStore(contractStore, ctx.GasMeter(), trace)
+}
+
+// finishContractTrace records the result of the contract call and writes the trace;
+// the write failures are only logged, as the tracing must not affect the execution
+func (k Keeper) finishContractTrace(ctx sdk.Context, trace *types.ContractTrace, wasmVMGas uint64, err error) {
+	if trace == nil {
+		return
+	}
+
+	trace.WasmVMGas = types.FromWasmVMGas(wasmVMGas)
+	if err != nil {
+		trace.Error = err.Error()
+	}
+
+	if err := k.tracer.write(trace); err != nil {
+		k.Logger(ctx).Error("failed to write contract trace", "err", err)
+	}
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/config"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func createTracingTestInput(t *testing.T) (TestInput, string) {
+	traceFile := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	wasmConfig := config.DefaultConfig()
+	wasmConfig.ContractTracingMode = true
+	wasmConfig.ContractTraceFile = traceFile
+
+	return CreateTestInputWithConfig(t, wasmConfig), traceFile
+}
+
+func readContractTraces(t *testing.T, traceFile string) []types.ContractTrace {
+	file, err := os.Open(traceFile)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var traces []types.ContractTrace
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var trace types.ContractTrace
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &trace))
+		traces = append(traces, trace)
+	}
+	require.NoError(t, scanner.Err())
+
+	return traces
+}
+
+func TestContractTracing(t *testing.T) {
+	input, traceFile := createTracingTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	fred := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit, "")
+	require.NoError(t, err)
+
+	_, err = keeper.queryToContract(ctx, addr, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+
+	_, err = keeper.ExecuteContract(ctx, addr, creator, []byte(`{"release":{}}`), nil)
+	require.Error(t, err)
+
+	traces := readContractTraces(t, traceFile)
+	require.Len(t, traces, 3)
+
+	// instantiate writes the contract config
+	require.Equal(t, types.TraceCallInstantiate, traces[0].Call)
+	require.Equal(t, addr.String(), traces[0].Contract)
+	require.Equal(t, ctx.BlockHeight(), traces[0].Height)
+	require.Equal(t, uint32(0), traces[0].Depth)
+	require.NotZero(t, traces[0].WasmVMGas)
+	require.NotZero(t, traces[0].StoreGas)
+	require.NotZero(t, traces[0].StoreWrites)
+	require.Empty(t, traces[0].Error)
+
+	// query only reads the contract config
+	require.Equal(t, types.TraceCallQuery, traces[1].Call)
+	require.NotZero(t, traces[1].StoreReads)
+	require.Zero(t, traces[1].StoreWrites)
+
+	// execute by the non verifier fails
+	require.Equal(t, types.TraceCallExecute, traces[2].Call)
+	require.NotEmpty(t, traces[2].Error)
+
+	// the calls on check state contexts are not traced
+	_, err = keeper.queryToContract(ctx.WithIsCheckTx(true), addr, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	require.Len(t, readContractTraces(t, traceFile), 3)
+
+	require.NoError(t, keeper.Close())
+}
+
+func TestContractTracingSubMsgDepth(t *testing.T) {
+	input, traceFile := createTracingTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.StoreCode(ctx, creator, reflectCode, nil)
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), nil, "")
+	require.NoError(t, err)
+
+	// the contract executes itself in a submessage and handles the reply
+	innerBz, err := json.Marshal(ReflectHandleMsg{
+		ReflectSubMsg: &reflectSubPayload{Msgs: []wasmvmtypes.SubMsg{}},
+	})
+	require.NoError(t, err)
+	reflectBz, err := json.Marshal(ReflectHandleMsg{
+		ReflectSubMsg: &reflectSubPayload{
+			Msgs: []wasmvmtypes.SubMsg{{
+				ID: 1,
+				Msg: wasmvmtypes.CosmosMsg{
+					Wasm: &wasmvmtypes.WasmMsg{
+						Execute: &wasmvmtypes.ExecuteMsg{
+							ContractAddr: contractAddr.String(),
+							Msg:          innerBz,
+						},
+					},
+				},
+				ReplyOn: wasmvmtypes.ReplyAlways,
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = keeper.ExecuteContract(ctx, contractAddr, creator, reflectBz, nil)
+	require.NoError(t, err)
+
+	// skip the instantiate trace
+	traces := readContractTraces(t, traceFile)
+	require.Len(t, traces, 4)
+
+	require.Equal(t, types.TraceCallExecute, traces[1].Call)
+	require.Equal(t, uint32(0), traces[1].Depth)
+	require.Equal(t, types.TraceCallExecute, traces[2].Call)
+	require.Equal(t, uint32(1), traces[2].Depth)
+	require.Equal(t, types.TraceCallReply, traces[3].Call)
+	require.Equal(t, uint32(0), traces[3].Depth)
+}
+
+func TestContractTracingDisabled(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+	require.Nil(t, keeper.tracer)
+
+	_, _, addr := keyPubAddr()
+	store := ctx.KVStore(keeper.storeKey)
+	trace, contractStore := keeper.startContractTrace(ctx, types.TraceCallExecute, addr, store)
+	require.Nil(t, trace)
+	require.Equal(t, store, contractStore)
+
+	// no-op without the trace
+	keeper.finishContractTrace(ctx, trace, 0, nil)
+}
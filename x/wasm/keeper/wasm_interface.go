@@ -62,6 +62,7 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.Cosm
 			msg.Instantiate.CodeID,
 			msg.Instantiate.Msg,
 			coins,
+			msg.Instantiate.Label,
 		)
 
 		return cosmosMsg, cosmosMsg.ValidateBasic()
@@ -106,9 +107,128 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.Cosm
 	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of Wasm")
 }
 
+// Instantiate2Msg is the custom msg for instantiating a contract
+// at a predictable address
+type Instantiate2Msg struct {
+	Admin  string             `json:"admin,omitempty"`
+	CodeID uint64             `json:"code_id"`
+	Msg    json.RawMessage    `json:"msg"`
+	Funds  []wasmvmtypes.Coin `json:"funds"`
+	Salt   []byte             `json:"salt"`
+	Label  string             `json:"label,omitempty"`
+}
+
+// RegisterCronJobMsg is the custom msg for scheduling a callback of the
+// contract; the msg is passed to the sudo entry point of the contract
+type RegisterCronJobMsg struct {
+	Msg       json.RawMessage `json:"msg"`
+	Height    int64           `json:"height"`
+	Interval  uint64          `json:"interval,omitempty"`
+	GasLimit  uint64          `json:"gas_limit"`
+	GasBudget uint64          `json:"gas_budget"`
+}
+
+// CancelCronJobMsg is the custom msg for removing a cron job of the contract
+type CancelCronJobMsg struct {
+	JobID uint64 `json:"job_id"`
+}
+
+// SetGasSponsorshipMsg is the custom msg for paying the fees of the txs
+// executing the contract within the limits
+type SetGasSponsorshipMsg struct {
+	UserLimit  []wasmvmtypes.Coin `json:"user_limit"`
+	UserPeriod uint64             `json:"user_period,omitempty"`
+	BlockLimit []wasmvmtypes.Coin `json:"block_limit"`
+}
+
+// RemoveGasSponsorshipMsg is the custom msg for stopping the gas sponsorship of the contract
+type RemoveGasSponsorshipMsg struct{}
+
+// CosmosMsg is the custom msg of wasm module, which is not
+// supported by wasmvm WasmMsg yet
+type CosmosMsg struct {
+	Instantiate2    *Instantiate2Msg    `json:"instantiate2,omitempty"`
+	RegisterCronJob *RegisterCronJobMsg `json:"register_cron_job,omitempty"`
+	CancelCronJob   *CancelCronJobMsg   `json:"cancel_cron_job,omitempty"`
+
+	SetGasSponsorship    *SetGasSponsorshipMsg    `json:"set_gas_sponsorship,omitempty"`
+	RemoveGasSponsorship *RemoveGasSponsorshipMsg `json:"remove_gas_sponsorship,omitempty"`
+}
+
 // ParseCustom implements custom parser
 func (parser WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) (sdk.Msg, error) {
-	return nil, nil
+	var sdkMsg CosmosMsg
+	err := json.Unmarshal(data, &sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse wasm custom msg")
+	}
+
+	if sdkMsg.Instantiate2 != nil {
+		coins, err := types.ParseToCoins(sdkMsg.Instantiate2.Funds)
+		if err != nil {
+			return nil, err
+		}
+
+		adminAddr := sdk.AccAddress{}
+		if sdkMsg.Instantiate2.Admin != "" {
+			adminAddr, err = sdk.AccAddressFromBech32(sdkMsg.Instantiate2.Admin)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		cosmosMsg := types.NewMsgInstantiateContract2(
+			contractAddr,
+			adminAddr,
+			sdkMsg.Instantiate2.CodeID,
+			sdkMsg.Instantiate2.Msg,
+			coins,
+			sdkMsg.Instantiate2.Salt,
+			sdkMsg.Instantiate2.Label,
+		)
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	if sdkMsg.RegisterCronJob != nil {
+		cosmosMsg := types.NewMsgRegisterCronJob(
+			contractAddr,
+			sdkMsg.RegisterCronJob.Msg,
+			sdkMsg.RegisterCronJob.Height,
+			sdkMsg.RegisterCronJob.Interval,
+			sdkMsg.RegisterCronJob.GasLimit,
+			sdkMsg.RegisterCronJob.GasBudget,
+		)
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	if sdkMsg.CancelCronJob != nil {
+		cosmosMsg := types.NewMsgCancelCronJob(contractAddr, sdkMsg.CancelCronJob.JobID)
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	if sdkMsg.SetGasSponsorship != nil {
+		userLimit, err := types.ParseToCoins(sdkMsg.SetGasSponsorship.UserLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		blockLimit, err := types.ParseToCoins(sdkMsg.SetGasSponsorship.BlockLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		cosmosMsg := types.NewMsgSetGasSponsorship(contractAddr, userLimit, sdkMsg.SetGasSponsorship.UserPeriod, blockLimit)
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	if sdkMsg.RemoveGasSponsorship != nil {
+		cosmosMsg := types.NewMsgRemoveGasSponsorship(contractAddr)
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of Wasm")
 }
 
 // WasmQuerier - wasm query interface for wasm contract
@@ -160,6 +280,7 @@ type ContractInfoQueryResponse struct {
 	Creator string `json:"creator"`
 	Admin   string `json:"admin,omitempty"`
 	CodeID  uint64 `json:"code_id"`
+	Frozen  bool   `json:"frozen,omitempty"`
 }
 
 // QueryCustom implements custom query interface
@@ -187,6 +308,7 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 			Creator: contractInfo.Creator,
 			Admin:   contractInfo.Admin,
 			CodeID:  contractInfo.CodeID,
+			Frozen:  contractInfo.Frozen,
 		})
 
 		if err != nil {
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+var _ types.IBCWasmMsgParserInterface = IBCWasmMsgParser{}
+var _ types.IBCWasmQuerierInterface = IBCWasmQuerier{}
+
+// IBCWasmMsgParser - wasm msg parser for ibc msgs
+type IBCWasmMsgParser struct {
+	portSource types.ICS20TransferPortSource
+}
+
+// NewIBCWasmMsgParser returns ibc wasm msg parser
+func NewIBCWasmMsgParser(portSource types.ICS20TransferPortSource) IBCWasmMsgParser {
+	return IBCWasmMsgParser{portSource}
+}
+
+// Parse implements ibc msg parser; the packets sent by the contract
+// are not sdk msgs, so they are sent by the keeper on dispatch
+func (parser IBCWasmMsgParser) Parse(ctx sdk.Context, contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.CosmosMsg) (sdk.Msg, error) {
+	msg := wasmMsg.IBC
+
+	if msg.Transfer != nil {
+		coin, err := types.ParseToCoin(msg.Transfer.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		cosmosMsg := ibctransfertypes.NewMsgTransfer(
+			parser.portSource.GetPort(ctx),
+			msg.Transfer.ChannelID,
+			coin,
+			contractAddr.String(),
+			msg.Transfer.ToAddress,
+			types.ParseToTimeoutHeight(msg.Transfer.Timeout.Block),
+			msg.Transfer.Timeout.Timestamp,
+		)
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	if msg.CloseChannel != nil {
+		cosmosMsg := channeltypes.NewMsgChannelCloseInit(
+			types.PortIDForContract(contractAddr),
+			msg.CloseChannel.ChannelID,
+			contractAddr.String(),
+		)
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of IBC")
+}
+
+// IBCWasmQuerier - wasm query interface for ibc
+type IBCWasmQuerier struct {
+	keeper Keeper
+}
+
+// NewIBCWasmQuerier returns ibc wasm querier
+func NewIBCWasmQuerier(keeper Keeper) IBCWasmQuerier {
+	return IBCWasmQuerier{keeper}
+}
+
+// Query - implement query function; only the open channels are visible to the contracts
+func (querier IBCWasmQuerier) Query(ctx sdk.Context, contractAddr sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+	if request.IBC.PortID != nil {
+		contractInfo, err := querier.keeper.GetContractInfo(ctx, contractAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(wasmvmtypes.PortIDResponse{PortID: contractInfo.IBCPortID})
+	}
+
+	if request.IBC.ListChannels != nil {
+		portID := request.IBC.ListChannels.PortID
+		if portID == "" {
+			contractInfo, err := querier.keeper.GetContractInfo(ctx, contractAddr)
+			if err != nil {
+				return nil, err
+			}
+
+			portID = contractInfo.IBCPortID
+		}
+
+		channels := make(wasmvmtypes.IBCChannels, 0)
+		if portID != "" {
+			querier.keeper.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+				if channel.PortId == portID && channel.State == channeltypes.OPEN {
+					channels = append(channels, types.NewIBCChannel(
+						channel.PortId,
+						channel.ChannelId,
+						channeltypes.NewChannel(
+							channel.State,
+							channel.Ordering,
+							channel.Counterparty,
+							channel.ConnectionHops,
+							channel.Version,
+						),
+					))
+				}
+
+				return false
+			})
+		}
+
+		return json.Marshal(wasmvmtypes.ListChannelsResponse{Channels: channels})
+	}
+
+	if request.IBC.Channel != nil {
+		portID := request.IBC.Channel.PortID
+		if portID == "" {
+			contractInfo, err := querier.keeper.GetContractInfo(ctx, contractAddr)
+			if err != nil {
+				return nil, err
+			}
+
+			portID = contractInfo.IBCPortID
+		}
+
+		var res wasmvmtypes.ChannelResponse
+		channel, found := querier.keeper.channelKeeper.GetChannel(ctx, portID, request.IBC.Channel.ChannelID)
+		if found && channel.State == channeltypes.OPEN {
+			ibcChannel := types.NewIBCChannel(portID, request.IBC.Channel.ChannelID, channel)
+			res.Channel = &ibcChannel
+		}
+
+		return json.Marshal(res)
+	}
+
+	return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown IBC variant"}
+}
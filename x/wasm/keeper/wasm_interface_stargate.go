@@ -2,16 +2,23 @@ package keeper
 
 import (
 	"fmt"
-	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
+	markettypes "github.com/bitwebs/iq-core/x/market/types"
+	oracletypes "github.com/bitwebs/iq-core/x/oracle/types"
+	treasurytypes "github.com/bitwebs/iq-core/x/treasury/types"
 	"github.com/bitwebs/iq-core/x/wasm/types"
 )
 
@@ -49,27 +56,49 @@ func (parser StargateWasmMsgParser) Parse(wasmMsg wasmvmtypes.CosmosMsg) (sdk.Ms
 	return cosmosMsg, nil
 }
 
-// StargateWasmQuerier - wasm query interface for wasm contract
+// StargateWasmQuerier - wasm query interface for wasm contract;
+// only the query paths in the allowlist are routed
 type StargateWasmQuerier struct {
-	keeper Keeper
+	keeper    Keeper
+	cdc       codec.Codec
+	allowlist types.StargateQueryAllowlist
 }
 
 // NewStargateWasmQuerier returns stargate wasm querier
-func NewStargateWasmQuerier(keeper Keeper) StargateWasmQuerier {
-	return StargateWasmQuerier{keeper}
+func NewStargateWasmQuerier(keeper Keeper, cdc codec.Codec, allowlist types.StargateQueryAllowlist) StargateWasmQuerier {
+	return StargateWasmQuerier{keeper, cdc, allowlist}
 }
 
-var queryBlackList = []string{
-	"/cosmos.tx",
-	"/cosmos.base.tendermint",
+// DefaultStargateQueryAllowlist returns the deterministic queries allowed from the contracts by default;
+// app.go can extend the returned allowlist before creating the querier
+func DefaultStargateQueryAllowlist() types.StargateQueryAllowlist {
+	return types.StargateQueryAllowlist{
+		"/cosmos.auth.v1beta1.Query/Account": types.NewStargateQuery(func() codec.ProtoMarshaler { return &authtypes.QueryAccountResponse{} }),
+
+		"/cosmos.bank.v1beta1.Query/Balance":     types.NewStargateQuery(func() codec.ProtoMarshaler { return &banktypes.QueryBalanceResponse{} }),
+		"/cosmos.bank.v1beta1.Query/AllBalances": types.NewStargateQuery(func() codec.ProtoMarshaler { return &banktypes.QueryAllBalancesResponse{} }),
+		"/cosmos.bank.v1beta1.Query/SupplyOf":    types.NewStargateQuery(func() codec.ProtoMarshaler { return &banktypes.QuerySupplyOfResponse{} }),
+
+		"/cosmos.staking.v1beta1.Query/Validator":  types.NewStargateQuery(func() codec.ProtoMarshaler { return &stakingtypes.QueryValidatorResponse{} }),
+		"/cosmos.staking.v1beta1.Query/Delegation": types.NewStargateQuery(func() codec.ProtoMarshaler { return &stakingtypes.QueryDelegationResponse{} }),
+		"/cosmos.staking.v1beta1.Query/Params":     types.NewStargateQuery(func() codec.ProtoMarshaler { return &stakingtypes.QueryParamsResponse{} }),
+
+		"/ibc.applications.transfer.v1.Query/DenomTrace": types.NewStargateQuery(func() codec.ProtoMarshaler { return &ibctransfertypes.QueryDenomTraceResponse{} }),
+
+		"/iq.oracle.v1beta1.Query/ExchangeRate":  types.NewStargateQuery(func() codec.ProtoMarshaler { return &oracletypes.QueryExchangeRateResponse{} }),
+		"/iq.oracle.v1beta1.Query/ExchangeRates": types.NewStargateQuery(func() codec.ProtoMarshaler { return &oracletypes.QueryExchangeRatesResponse{} }),
+		"/iq.market.v1beta1.Query/Swap":          types.NewStargateQuery(func() codec.ProtoMarshaler { return &markettypes.QuerySwapResponse{} }),
+		"/iq.treasury.v1beta1.Query/TaxRate":     types.NewStargateQuery(func() codec.ProtoMarshaler { return &treasurytypes.QueryTaxRateResponse{} }),
+		"/iq.treasury.v1beta1.Query/TaxCap":      types.NewStargateQuery(func() codec.ProtoMarshaler { return &treasurytypes.QueryTaxCapResponse{} }),
+		"/iq.wasm.v1beta1.Query/ContractInfo":    types.NewStargateQuery(func() codec.ProtoMarshaler { return &types.QueryContractInfoResponse{} }),
+	}
 }
 
 // Query - implement query function
 func (querier StargateWasmQuerier) Query(ctx sdk.Context, request wasmvmtypes.QueryRequest) ([]byte, error) {
-	for _, b := range queryBlackList {
-		if strings.Contains(request.Stargate.Path, b) {
-			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Stargate.Path)}
-		}
+	query, ok := querier.allowlist[request.Stargate.Path]
+	if !ok {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Stargate.Path)}
 	}
 
 	route := querier.keeper.queryRouter.Route(request.Stargate.Path)
@@ -77,6 +106,8 @@ func (querier StargateWasmQuerier) Query(ctx sdk.Context, request wasmvmtypes.Qu
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Stargate.Path)}
 	}
 
+	ctx.GasMeter().ConsumeGas(query.GasCost, "Stargate query")
+
 	res, err := route(ctx, abci.RequestQuery{
 		Data: request.Stargate.Data,
 		Path: request.Stargate.Path,
@@ -86,5 +117,15 @@ func (querier StargateWasmQuerier) Query(ctx sdk.Context, request wasmvmtypes.Qu
 		return nil, err
 	}
 
-	return res.Value, nil
+	// re-encode the response, so the bytes don't depend on the encoding of the query server
+	response := query.NewResponse()
+	if err := querier.cdc.Unmarshal(res.Value, response); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMsg, "failed to decode '%s' response: %s", request.Stargate.Path, err)
+	}
+
+	if query.Encoding == types.StargateQueryEncodingJSON {
+		return querier.cdc.MarshalJSON(response)
+	}
+
+	return querier.cdc.Marshal(response)
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	core "github.com/bitwebs/iq-core/types"
+	"github.com/bitwebs/iq-core/x/wasm/types"
+)
+
+func TestStargateWasmQuerier(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper, bankKeeper := input.Ctx, input.AccKeeper, input.WasmKeeper, input.BankKeeper
+	cdc := MakeEncodingConfig(t).Marshaler
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1000))
+	addr := createFakeFundedAccount(ctx, accKeeper, bankKeeper, balance)
+
+	reqBz, err := cdc.Marshal(&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: core.MicroBiqDenom})
+	require.NoError(t, err)
+
+	request := wasmvmtypes.QueryRequest{
+		Stargate: &wasmvmtypes.StargateQuery{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: reqBz,
+		},
+	}
+
+	// protobuf encoding with the default gas cost
+	allowlist := DefaultStargateQueryAllowlist()
+	querier := NewStargateWasmQuerier(keeper, cdc, allowlist)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	bz, err := querier.Query(ctx, request)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, types.DefaultStargateQueryGasCost)
+
+	var res banktypes.QueryBalanceResponse
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, balance[0], *res.Balance)
+
+	// JSON encoding with the custom gas cost
+	allowlist.Add(request.Stargate.Path, allowlist[request.Stargate.Path].WithJSONEncoding().WithGasCost(100_000))
+
+	gasBefore = ctx.GasMeter().GasConsumed()
+	bz, err = querier.Query(ctx, request)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, uint64(100_000))
+
+	res = banktypes.QueryBalanceResponse{}
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, balance[0], *res.Balance)
+
+	// removed path is rejected
+	allowlist.Remove(request.Stargate.Path)
+	_, err = querier.Query(ctx, request)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "path is not allowed from the contract")
+}
@@ -173,6 +173,72 @@ func TestEcoding(t *testing.T) {
 	}
 }
 
+func TestParseCustomInstantiate2(t *testing.T) {
+	sender := Addrs[0]
+	admin := Addrs[1]
+
+	parser := NewWasmMsgParser()
+	res, err := parser.ParseCustom(sender, []byte(`{"instantiate2":{"admin":"`+admin.String()+`","code_id":7,"msg":{},"funds":[{"denom":"`+core.MicroBiqDenom+`","amount":"1234"}],"salt":"c2FsdA=="}}`))
+	require.NoError(t, err)
+	assert.Equal(t, &types.MsgInstantiateContract2{
+		Sender:    sender.String(),
+		Admin:     admin.String(),
+		CodeID:    7,
+		InitMsg:   []byte("{}"),
+		InitCoins: sdk.NewCoins(sdk.NewInt64Coin(core.MicroBiqDenom, 1234)),
+		Salt:      []byte("salt"),
+	}, res)
+
+	// empty salt
+	_, err = parser.ParseCustom(sender, []byte(`{"instantiate2":{"code_id":7,"msg":{},"funds":[]}}`))
+	require.Error(t, err)
+
+	// unknown variant
+	_, err = parser.ParseCustom(sender, []byte(`{"unknown":{}}`))
+	require.Error(t, err)
+}
+
+func TestParseCustomCronJob(t *testing.T) {
+	contract := Addrs[0]
+
+	parser := NewWasmMsgParser()
+	res, err := parser.ParseCustom(contract, []byte(`{"register_cron_job":{"msg":{"tick":{}},"height":100,"interval":10,"gas_limit":200000,"gas_budget":1000000}}`))
+	require.NoError(t, err)
+	assert.Equal(t, types.NewMsgRegisterCronJob(contract, []byte(`{"tick":{}}`), 100, 10, 200000, 1000000), res)
+
+	// budget below the gas limit
+	_, err = parser.ParseCustom(contract, []byte(`{"register_cron_job":{"msg":{},"height":100,"gas_limit":200000,"gas_budget":100000}}`))
+	require.Error(t, err)
+
+	res, err = parser.ParseCustom(contract, []byte(`{"cancel_cron_job":{"job_id":3}}`))
+	require.NoError(t, err)
+	assert.Equal(t, types.NewMsgCancelCronJob(contract, 3), res)
+
+	// empty job id
+	_, err = parser.ParseCustom(contract, []byte(`{"cancel_cron_job":{}}`))
+	require.Error(t, err)
+}
+
+func TestParseCustomGasSponsorship(t *testing.T) {
+	contract := Addrs[0]
+
+	parser := NewWasmMsgParser()
+	res, err := parser.ParseCustom(contract, []byte(`{"set_gas_sponsorship":{"user_limit":[{"denom":"ubiq","amount":"1000"}],"user_period":100,"block_limit":[{"denom":"ubiq","amount":"10000"}]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, types.NewMsgSetGasSponsorship(contract,
+		sdk.NewCoins(sdk.NewInt64Coin("ubiq", 1000)), 100,
+		sdk.NewCoins(sdk.NewInt64Coin("ubiq", 10000)),
+	), res)
+
+	// empty block limit
+	_, err = parser.ParseCustom(contract, []byte(`{"set_gas_sponsorship":{"user_limit":[{"denom":"ubiq","amount":"1000"}],"block_limit":[]}}`))
+	require.Error(t, err)
+
+	res, err = parser.ParseCustom(contract, []byte(`{"remove_gas_sponsorship":{}}`))
+	require.NoError(t, err)
+	assert.Equal(t, types.NewMsgRemoveGasSponsorship(contract), res)
+}
+
 func TestQueryRaw(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -212,7 +278,7 @@ func TestQueryRaw(t *testing.T) {
 func TestQueryContractInfo(t *testing.T) {
 	input := CreateTestInput(t)
 
-	input.WasmKeeper.SetContractInfo(input.Ctx, Addrs[0], types.NewContractInfo(1, Addrs[0], Addrs[1], sdk.AccAddress{}, []byte{}))
+	input.WasmKeeper.SetContractInfo(input.Ctx, Addrs[0], types.NewContractInfo(1, Addrs[0], Addrs[1], sdk.AccAddress{}, []byte{}, ""))
 
 	bz, err := json.Marshal(CosmosQuery{
 		ContractInfo: &ContractInfoQueryParams{
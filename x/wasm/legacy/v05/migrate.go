@@ -19,9 +19,10 @@ func Migrate(
 	for i, c := range wasmGenState.Codes {
 		codes[i] = v05wasm.Code{
 			CodeInfo: v05wasm.CodeInfo{
-				CodeID:   c.CodeInfo.CodeID,
-				CodeHash: []byte{},
-				Creator:  c.CodeInfo.Creator.String(),
+				CodeID:            c.CodeInfo.CodeID,
+				CodeHash:          []byte{},
+				Creator:           c.CodeInfo.Creator.String(),
+				InstantiateConfig: v05wasm.AllowEverybody,
 			},
 			CodeBytes: []byte{},
 		}
@@ -59,6 +60,7 @@ func Migrate(
 			MaxContractSize:    v05wasm.DefaultMaxContractSize,
 			MaxContractMsgSize: v05wasm.DefaultMaxContractMsgSize,
 			MaxContractGas:     v05wasm.DefaultMaxContractGas,
+			CodeUploadAccess:   v05wasm.AllowEverybody,
 		},
 		Codes:          codes,
 		Contracts:      contracts,
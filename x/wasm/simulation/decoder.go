@@ -36,6 +36,27 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("%v\n%v", contractInfoA, contractInfoB)
 		case bytes.Equal(kvA.Key[:1], types.ContractStoreKey):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.ContractsByCodeKey),
+			bytes.Equal(kvA.Key[:1], types.ContractsByCreatorKey),
+			bytes.Equal(kvA.Key[:1], types.ContractsByAdminKey),
+			bytes.Equal(kvA.Key[:1], types.PinnedCodeIndexKey),
+			bytes.Equal(kvA.Key[:1], types.CronQueueKey),
+			bytes.Equal(kvA.Key[:1], types.CronJobsByContractKey):
+			return fmt.Sprintf("%v\n%v", kvA.Key, kvB.Key)
+		case bytes.Equal(kvA.Key[:1], types.ContractHistoryKey):
+			var entryA, entryB types.ContractHistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+		case bytes.Equal(kvA.Key[:1], types.LastCronJobIDKey):
+			lastCronJobIDA := binary.BigEndian.Uint64(kvA.Value)
+			lastCronJobIDB := binary.BigEndian.Uint64(kvB.Value)
+			return fmt.Sprintf("lastCronJobIDA: %d\nlastCronJobIDB: %d", lastCronJobIDA, lastCronJobIDB)
+		case bytes.Equal(kvA.Key[:1], types.CronJobKey):
+			var jobA, jobB types.CronJob
+			cdc.MustUnmarshal(kvA.Value, &jobA)
+			cdc.MustUnmarshal(kvB.Value, &jobB)
+			return fmt.Sprintf("%v\n%v", jobA, jobB)
 		default:
 			panic(fmt.Sprintf("invalid wasm key prefix %X", kvA.Key[:1]))
 		}
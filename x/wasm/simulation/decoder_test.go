@@ -32,10 +32,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(lastCodeIDbz, 123)
 	binary.LittleEndian.PutUint64(lastInstanceIDbz, 456)
 
-	codeInfo := types.NewCodeInfo(1, []byte{1, 2, 3}, creatorAddr)
-	contractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, creatorAddr, []byte{4, 5, 6})
-	emptyAdminContractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, sdk.AccAddress{}, []byte{4, 5, 6})
+	codeInfo := types.NewCodeInfo(1, []byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
+	contractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, creatorAddr, []byte{4, 5, 6}, "")
+	emptyAdminContractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, sdk.AccAddress{}, []byte{4, 5, 6}, "")
 	contractStore := []byte{7, 8, 9}
+	cronJob := types.NewCronJob(1, contractAddr, []byte("{}"), 10, 5, 100, 1000, sdk.NewInt64Coin("ubiq", 150))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -45,6 +46,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.ContractInfoKey, Value: cdc.MustMarshal(&contractInfo)},
 			{Key: append(types.ContractInfoKey, 0x1), Value: cdc.MustMarshal(&emptyAdminContractInfo)},
 			{Key: types.ContractStoreKey, Value: contractStore},
+			{Key: types.LastCronJobIDKey, Value: sdk.Uint64ToBigEndian(789)},
+			{Key: types.GetCronJobKey(1), Value: cdc.MustMarshal(&cronJob)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -59,6 +62,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ContractInfo", fmt.Sprintf("%v\n%v", contractInfo, contractInfo)},
 		{"ContractInfo", fmt.Sprintf("%v\n%v", emptyAdminContractInfo, emptyAdminContractInfo)},
 		{"ContractStore", fmt.Sprintf("%v\n%v", contractStore, contractStore)},
+		{"LastCronJobID", "lastCronJobIDA: 789\nlastCronJobIDB: 789"},
+		{"CronJob", fmt.Sprintf("%v\n%v", cronJob, cronJob)},
 		{"other", ""},
 	}
 
@@ -73,11 +73,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 			MaxContractSize:    maxContractSize,
 			MaxContractGas:     maxContractGas,
 			MaxContractMsgSize: maxContractMsgSize,
+			CodeUploadAccess:   types.AllowEverybody,
+			CronGasPrice:       types.DefaultCronGasPrice,
+			MaxCronGasPerBlock: types.DefaultMaxCronGasPerBlock,
+			StorageRentPrice:   types.DefaultStorageRentPrice,
+			StorageRentPeriod:  types.DefaultStorageRentPeriod,
 		},
 		0,
 		0,
 		[]types.Code{},
 		[]types.Contract{},
+		0,
+		[]types.CronJob{},
+		[]types.GasSponsorship{},
 	)
 
 	bz, err := json.MarshalIndent(&wasmGenesis.Params, "", " ")
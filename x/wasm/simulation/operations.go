@@ -219,7 +219,7 @@ func SimulateMsgInstantiateContract(
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInstantiateContract, "code not exists yet"), nil, nil
 		}
 
-		msg := types.NewMsgInstantiateContract(fredAcc.Address, fredAcc.Address, 1, initMsgBz, nil)
+		msg := types.NewMsgInstantiateContract(fredAcc.Address, fredAcc.Address, 1, initMsgBz, nil, "")
 
 		txGen := simappparams.MakeTestEncodingConfig().TxConfig
 		tx, err := helpers.GenTx(
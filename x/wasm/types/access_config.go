@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// AllowEverybody is the access config allowing every address
+	AllowEverybody = AccessConfig{Permission: AccessTypeEverybody}
+
+	// AllowNobody is the access config forbidding every address;
+	// only governance proposals bypass it
+	AllowNobody = AccessConfig{Permission: AccessTypeNobody}
+)
+
+// NewOnlyAddressesAccessConfig creates an access config allowing the given addresses only
+func NewOnlyAddressesAccessConfig(addrs ...sdk.AccAddress) AccessConfig {
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+
+	return AccessConfig{Permission: AccessTypeOnlyAddresses, Addresses: addresses}
+}
+
+// ValidateBasic performs a stateless validation of the access config
+func (a AccessConfig) ValidateBasic() error {
+	switch a.Permission {
+	case AccessTypeNobody, AccessTypeEverybody:
+		if len(a.Addresses) != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "addresses must be empty for %s", a.Permission)
+		}
+	case AccessTypeOnlyAddresses:
+		if len(a.Addresses) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "addresses cannot be empty")
+		}
+
+		seen := make(map[string]bool, len(a.Addresses))
+		for _, addr := range a.Addresses {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+			}
+
+			if seen[addr] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", addr)
+			}
+			seen[addr] = true
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown access type %s", a.Permission)
+	}
+
+	return nil
+}
+
+// Allowed returns whether the given address is allowed by the access config
+func (a AccessConfig) Allowed(addr sdk.AccAddress) bool {
+	switch a.Permission {
+	case AccessTypeEverybody:
+		return true
+	case AccessTypeOnlyAddresses:
+		for _, allowed := range a.Addresses {
+			if allowed == addr.String() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -6,6 +6,9 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customgovtypes "github.com/bitwebs/iq-core/custom/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the wasm types and interface
@@ -13,10 +16,24 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStoreCode{}, "wasm/MsgStoreCode", nil)
 	cdc.RegisterConcrete(&MsgMigrateCode{}, "wasm/MsgMigrateCode", nil)
 	cdc.RegisterConcrete(&MsgInstantiateContract{}, "wasm/MsgInstantiateContract", nil)
+	cdc.RegisterConcrete(&MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2", nil)
 	cdc.RegisterConcrete(&MsgExecuteContract{}, "wasm/MsgExecuteContract", nil)
 	cdc.RegisterConcrete(&MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateContractAdmin{}, "wasm/MsgUpdateContractAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearContractAdmin{}, "wasm/MsgClearContractAdmin", nil)
+	cdc.RegisterConcrete(&MsgRegisterCronJob{}, "wasm/MsgRegisterCronJob", nil)
+	cdc.RegisterConcrete(&MsgCancelCronJob{}, "wasm/MsgCancelCronJob", nil)
+	cdc.RegisterConcrete(&MsgSetGasSponsorship{}, "wasm/MsgSetGasSponsorship", nil)
+	cdc.RegisterConcrete(&MsgRemoveGasSponsorship{}, "wasm/MsgRemoveGasSponsorship", nil)
+	cdc.RegisterConcrete(&StoreCodeProposal{}, "wasm/StoreCodeProposal", nil)
+	cdc.RegisterConcrete(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal", nil)
+	cdc.RegisterConcrete(&MigrateContractProposal{}, "wasm/MigrateContractProposal", nil)
+	cdc.RegisterConcrete(&UpdateAdminProposal{}, "wasm/UpdateAdminProposal", nil)
+	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
+	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
+	cdc.RegisterConcrete(&SudoContractProposal{}, "wasm/SudoContractProposal", nil)
+	cdc.RegisterConcrete(&FreezeContractProposal{}, "wasm/FreezeContractProposal", nil)
+	cdc.RegisterConcrete(&UnfreezeContractProposal{}, "wasm/UnfreezeContractProposal", nil)
 }
 
 // RegisterInterfaces registers the x/market interfaces types with the interface registry
@@ -25,10 +42,27 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgStoreCode{},
 		&MsgMigrateCode{},
 		&MsgInstantiateContract{},
+		&MsgInstantiateContract2{},
 		&MsgExecuteContract{},
 		&MsgMigrateContract{},
 		&MsgUpdateContractAdmin{},
 		&MsgClearContractAdmin{},
+		&MsgRegisterCronJob{},
+		&MsgCancelCronJob{},
+		&MsgSetGasSponsorship{},
+		&MsgRemoveGasSponsorship{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&StoreCodeProposal{},
+		&InstantiateContractProposal{},
+		&MigrateContractProposal{},
+		&UpdateAdminProposal{},
+		&PinCodesProposal{},
+		&UnpinCodesProposal{},
+		&SudoContractProposal{},
+		&FreezeContractProposal{},
+		&UnfreezeContractProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -50,4 +84,14 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	customgovtypes.RegisterProposalTypeCodec(&StoreCodeProposal{}, "wasm/StoreCodeProposal")
+	customgovtypes.RegisterProposalTypeCodec(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
+	customgovtypes.RegisterProposalTypeCodec(&MigrateContractProposal{}, "wasm/MigrateContractProposal")
+	customgovtypes.RegisterProposalTypeCodec(&UpdateAdminProposal{}, "wasm/UpdateAdminProposal")
+	customgovtypes.RegisterProposalTypeCodec(&PinCodesProposal{}, "wasm/PinCodesProposal")
+	customgovtypes.RegisterProposalTypeCodec(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
+	customgovtypes.RegisterProposalTypeCodec(&SudoContractProposal{}, "wasm/SudoContractProposal")
+	customgovtypes.RegisterProposalTypeCodec(&FreezeContractProposal{}, "wasm/FreezeContractProposal")
+	customgovtypes.RegisterProposalTypeCodec(&UnfreezeContractProposal{}, "wasm/UnfreezeContractProposal")
 }
@@ -7,19 +7,21 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewCodeInfo fills a new Contract struct
-func NewCodeInfo(codeID uint64, codeHash []byte, creator sdk.AccAddress) CodeInfo {
+func NewCodeInfo(codeID uint64, codeHash []byte, creator sdk.AccAddress, instantiateConfig AccessConfig) CodeInfo {
 	return CodeInfo{
-		CodeID:   codeID,
-		CodeHash: codeHash,
-		Creator:  creator.String(),
+		CodeID:            codeID,
+		CodeHash:          codeHash,
+		Creator:           creator.String(),
+		InstantiateConfig: instantiateConfig,
 	}
 }
 
 // NewContractInfo creates a new instance of a given WASM contract info
-func NewContractInfo(codeID uint64, address, creator, admin sdk.AccAddress, initMsg []byte) ContractInfo {
+func NewContractInfo(codeID uint64, address, creator, admin sdk.AccAddress, initMsg []byte, label string) ContractInfo {
 	var adminAddr string
 	if !admin.Empty() {
 		adminAddr = admin.String()
@@ -31,9 +33,51 @@ func NewContractInfo(codeID uint64, address, creator, admin sdk.AccAddress, init
 		Creator: creator.String(),
 		Admin:   adminAddr,
 		InitMsg: initMsg,
+		Label:   label,
 	}
 }
 
+// AddStorageSize applies the change of the stored bytes to the storage size of the contract
+func (c *ContractInfo) AddStorageSize(delta int64) {
+	if delta < 0 && uint64(-delta) > c.StorageSize {
+		c.StorageSize = 0
+		return
+	}
+
+	c.StorageSize = uint64(int64(c.StorageSize) + delta)
+}
+
+// NewContractHistoryEntry creates a new ContractHistoryEntry instance
+func NewContractHistoryEntry(operation ContractHistoryOperationType, codeID uint64, height int64, sender sdk.AccAddress, admin string, msg []byte) ContractHistoryEntry {
+	var senderAddr string
+	if !sender.Empty() {
+		senderAddr = sender.String()
+	}
+
+	return ContractHistoryEntry{
+		Operation: operation,
+		CodeID:    codeID,
+		Height:    height,
+		Sender:    senderAddr,
+		Admin:     admin,
+		Msg:       msg,
+	}
+}
+
+// ValidateBasic performs basic validation of the contract history entry
+func (entry ContractHistoryEntry) ValidateBasic() error {
+	if _, ok := ContractHistoryOperationType_name[int32(entry.Operation)]; !ok ||
+		entry.Operation == ContractHistoryOperationTypeUnspecified {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid contract history operation %d", entry.Operation)
+	}
+
+	if entry.Height < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract history height cannot be negative")
+	}
+
+	return nil
+}
+
 // NewEnv initializes the environment for a contract instance
 func NewEnv(ctx sdk.Context, contractAddr sdk.AccAddress) wasmvmtypes.Env {
 	env := wasmvmtypes.Env{
@@ -78,6 +122,32 @@ func GenerateContractAddress(codeID uint64, instanceID uint64) sdk.AccAddress {
 	return addrFromUint64(contractID)
 }
 
+// MaxSaltSize is the maximum byte size of the salt used to derive a contract address
+const MaxSaltSize = 64
+
+// MaxLabelSize is the maximum byte size of a contract label
+const MaxLabelSize = 128
+
+// GenerateContractAddress2 generates a predictable contract address
+// from the code hash, the creator address and a user supplied salt.
+// Each input is length prefixed so that different inputs can not
+// produce the same preimage.
+func GenerateContractAddress2(codeHash []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+	key := []byte("instantiate2")
+	for _, bz := range [][]byte{codeHash, creator, salt} {
+		key = append(key, uint64LengthPrefix(bz)...)
+	}
+
+	return sdk.AccAddress(crypto.AddressHash(key))
+}
+
+// uint64LengthPrefix prepends the big endian encoded length of the bytes
+func uint64LengthPrefix(bz []byte) []byte {
+	prefix := make([]byte, 8, 8+len(bz))
+	binary.BigEndian.PutUint64(prefix, uint64(len(bz)))
+	return append(prefix, bz...)
+}
+
 func addrFromUint64(id uint64) sdk.AccAddress {
 	addr := make([]byte, 20)
 	addr[0] = 'C'
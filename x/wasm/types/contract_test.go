@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenerateContractAddress2(t *testing.T) {
+	codeHash := []byte("code_hash_______________________")
+	creator := sdk.AccAddress([]byte("addr1_______________"))
+	salt := []byte("salt")
+
+	addr := GenerateContractAddress2(codeHash, creator, salt)
+	require.Len(t, addr, 20)
+
+	// deterministic for the same inputs
+	require.Equal(t, addr, GenerateContractAddress2(codeHash, creator, salt))
+
+	// every input affects the address
+	require.NotEqual(t, addr, GenerateContractAddress2([]byte("other_code_hash_________________"), creator, salt))
+	require.NotEqual(t, addr, GenerateContractAddress2(codeHash, sdk.AccAddress([]byte("addr2_______________")), salt))
+	require.NotEqual(t, addr, GenerateContractAddress2(codeHash, creator, []byte("other_salt")))
+
+	// inputs are length prefixed, so moving bytes across them changes the address
+	require.NotEqual(t, addr, GenerateContractAddress2(codeHash, creator, append([]byte{}, salt[1:]...)))
+}
+
+func TestContractInfoAddStorageSize(t *testing.T) {
+	contractInfo := ContractInfo{StorageSize: 10}
+
+	contractInfo.AddStorageSize(5)
+	require.Equal(t, uint64(15), contractInfo.StorageSize)
+
+	contractInfo.AddStorageSize(-15)
+	require.Equal(t, uint64(0), contractInfo.StorageSize)
+
+	// the size never underflows
+	contractInfo.AddStorageSize(-1)
+	require.Equal(t, uint64(0), contractInfo.StorageSize)
+}
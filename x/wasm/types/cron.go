@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewCronJob creates a new CronJob instance
+func NewCronJob(jobID uint64, contract sdk.AccAddress, msg []byte, height int64, interval, gasLimit, gasBudget uint64, escrow sdk.Coin) CronJob {
+	return CronJob{
+		ID:         jobID,
+		Contract:   contract.String(),
+		Msg:        msg,
+		NextHeight: height,
+		Interval:   interval,
+		GasLimit:   gasLimit,
+		GasBudget:  gasBudget,
+		Escrow:     escrow,
+	}
+}
+
+// NewCronEscrow returns the fee escrowed for the gas budget at the gas price, rounded up
+func NewCronEscrow(gasPrice sdk.DecCoin, gasBudget uint64) sdk.Coin {
+	amount := gasPrice.Amount.MulInt(sdk.NewIntFromUint64(gasBudget)).Ceil().TruncateInt()
+	return sdk.NewCoin(gasPrice.Denom, amount)
+}
+
+// ContractAddress returns the address of the contract called back;
+// it panics on an invalid address, which is rejected on the registration
+func (job CronJob) ContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(job.Contract)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// ReserveExecution takes the gas limit of an execution from the gas budget
+// and returns the share of the escrow reserved for it
+func (job *CronJob) ReserveExecution() sdk.Coin {
+	reserved := job.Escrow.Amount.Mul(sdk.NewIntFromUint64(job.GasLimit)).Quo(sdk.NewIntFromUint64(job.GasBudget))
+	job.GasBudget -= job.GasLimit
+	job.Escrow.Amount = job.Escrow.Amount.Sub(reserved)
+
+	return sdk.NewCoin(job.Escrow.Denom, reserved)
+}
+
+// ValidateBasic checks the cron job
+func (job CronJob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(job.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if !json.Valid(job.Msg) {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "msg is invalid json")
+	}
+
+	if job.NextHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "next height must be positive")
+	}
+
+	if job.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "gas limit cannot be zero")
+	}
+
+	if err := job.Escrow.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCronJob, err.Error())
+	}
+
+	return nil
+}
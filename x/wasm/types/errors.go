@@ -24,4 +24,13 @@ var (
 	ErrExceedMaxContractDataSize = sdkerrors.Register(ModuleName, 17, "exceeds max contract data size limit")
 	ErrReplyFailed               = sdkerrors.Register(ModuleName, 18, "reply wasm contract failed")
 	ErrExceedMaxQueryDepth       = sdkerrors.Register(ModuleName, 19, "exceed max query depth")
+	ErrPinContractFailed         = sdkerrors.Register(ModuleName, 20, "pinning contract failed")
+	ErrUnpinContractFailed       = sdkerrors.Register(ModuleName, 21, "unpinning contract failed")
+	ErrInvalidIBCPort            = sdkerrors.Register(ModuleName, 22, "invalid IBC port")
+	ErrIBCCallbackFailed         = sdkerrors.Register(ModuleName, 23, "IBC contract callback failed")
+	ErrSudoFailed                = sdkerrors.Register(ModuleName, 24, "sudo wasm contract failed")
+	ErrInvalidCronJob            = sdkerrors.Register(ModuleName, 25, "invalid cron job")
+	ErrContractFrozen            = sdkerrors.Register(ModuleName, 26, "contract is frozen")
+	ErrInvalidGasSponsorship     = sdkerrors.Register(ModuleName, 27, "invalid gas sponsorship")
+	ErrGasSponsorshipLimit       = sdkerrors.Register(ModuleName, 28, "gas sponsorship limit exceeded")
 )
@@ -3,14 +3,28 @@ package types
 
 // Wasm module event types
 const (
-	EventTypeStoreCode           = "store_code"
-	EventTypeMigrateCode         = "migrate_code"
-	EventTypeInstantiateContract = "instantiate_contract"
-	EventTypeExecuteContract     = "execute_contract"
-	EventTypeMigrateContract     = "migrate_contract"
-	EventTypeUpdateContractAdmin = "update_contract_admin"
-	EventTypeClearContractAdmin  = "clear_contract_admin"
-	EventTypeWasmPrefix          = "wasm"
+	EventTypeStoreCode            = "store_code"
+	EventTypeMigrateCode          = "migrate_code"
+	EventTypeInstantiateContract  = "instantiate_contract"
+	EventTypeExecuteContract      = "execute_contract"
+	EventTypeMigrateContract      = "migrate_contract"
+	EventTypeUpdateContractAdmin  = "update_contract_admin"
+	EventTypeClearContractAdmin   = "clear_contract_admin"
+	EventTypePinCode              = "pin_code"
+	EventTypeUnpinCode            = "unpin_code"
+	EventTypeRegisterCronJob      = "register_cron_job"
+	EventTypeCancelCronJob        = "cancel_cron_job"
+	EventTypeExecuteCronJob       = "execute_cron_job"
+	EventTypeCronJobSkipped       = "cron_job_skipped"
+	EventTypeCronJobFailed        = "cron_job_failed"
+	EventTypeSudoContract         = "sudo_contract"
+	EventTypeFreezeContract       = "freeze_contract"
+	EventTypeUnfreezeContract     = "unfreeze_contract"
+	EventTypeStorageRent          = "storage_rent"
+	EventTypeSetGasSponsorship    = "set_gas_sponsorship"
+	EventTypeRemoveGasSponsorship = "remove_gas_sponsorship"
+	EventTypeSponsorFee           = "sponsor_fee"
+	EventTypeWasmPrefix           = "wasm"
 
 	// Deprecated
 	EventTypeFromContract = "from_contract"
@@ -21,6 +35,21 @@ const (
 	AttributeKeyContractID      = "contract_id"
 	AttributeKeyAdmin           = "admin"
 	AttributeKeyCreator         = "creator"
+	AttributeKeyCronJobID       = "cron_job_id"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyReason          = "reason"
+	AttributeKeyError           = "error"
+	AttributeKeyRent            = "rent"
+	AttributeKeyRentDue         = "rent_due"
+	AttributeKeyUser            = "user"
+	AttributeKeyFee             = "fee"
+	AttributeKeyUserLimit       = "user_limit"
+	AttributeKeyUserPeriod      = "user_period"
+	AttributeKeyBlockLimit      = "block_limit"
+
+	AttributeValueBlockGasLimit        = "block_gas_limit"
+	AttributeValueExceedsBlockGasLimit = "exceeds_block_gas_limit"
+	AttributeValueInsufficientGas      = "insufficient_gas_budget"
 
 	AttributeValueCategory = ModuleName
 )
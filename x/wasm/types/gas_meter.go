@@ -12,6 +12,7 @@ const (
 
 	compileCostPerByte             = uint64(2)       // sdk gas cost per bytes
 	instantiateCost                = uint64(40_000)  // sdk gas cost for executing wasmVM engine
+	pinnedInstantiateCost          = uint64(2_500)   // sdk gas cost for executing wasmVM engine with a pinned code
 	registerCost                   = uint64(160_000) // sdk gas cost for creating contract
 	humanizeCost                   = uint64(5)       // sdk gas cost to convert canonical address to human address
 	canonicalizeCost               = uint64(4)       // sdk gas cost to convert human address to canonical address
@@ -40,9 +41,14 @@ func CompileCosts(byteLength int) sdk.Gas {
 	return sdk.NewUint(compileCostPerByte).MulUint64(uint64(byteLength)).Uint64()
 }
 
-// InstantiateContractCosts costs when interacting with a wasm contract
-func InstantiateContractCosts(msgLen int) sdk.Gas {
+// InstantiateContractCosts costs when interacting with a wasm contract;
+// the pinned codes are discounted as they are already loaded in the wasmvm cache
+func InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
 	dataCosts := sdk.NewUint(sdk.Gas(msgLen)).MulUint64(contractMessageDataCostPerByte)
+	if pinned {
+		return dataCosts.AddUint64(pinnedInstantiateCost).Uint64()
+	}
+
 	return dataCosts.AddUint64(instantiateCost).Uint64()
 }
 
@@ -52,7 +58,7 @@ func RegisterContractCosts() sdk.Gas {
 }
 
 // ReplyCosts costs to to handle a message reply
-func ReplyCosts(reply wasmvmtypes.Reply) sdk.Gas {
+func ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
 	msgLen := len(reply.Result.Err)
 
 	eventGas := sdk.NewUint(0)
@@ -68,7 +74,7 @@ func ReplyCosts(reply wasmvmtypes.Reply) sdk.Gas {
 		eventGas = eventGas.AddUint64(eventAttributeCosts(attrs))
 	}
 
-	return eventGas.AddUint64(InstantiateContractCosts(msgLen)).Uint64()
+	return eventGas.AddUint64(InstantiateContractCosts(pinned, msgLen)).Uint64()
 }
 
 // EventCosts costs to persist an event
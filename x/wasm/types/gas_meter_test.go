@@ -31,8 +31,12 @@ func TestCompileCosts(t *testing.T) {
 func TestInstantiateContractCosts(t *testing.T) {
 	msgLength := 10
 
-	cost := InstantiateContractCosts(msgLength)
+	cost := InstantiateContractCosts(false, msgLength)
 	require.Equal(t, sdk.Gas(instantiateCost+uint64(msgLength)*contractMessageDataCostPerByte), cost)
+
+	// pinned code is discounted
+	cost = InstantiateContractCosts(true, msgLength)
+	require.Equal(t, sdk.Gas(pinnedInstantiateCost+uint64(msgLength)*contractMessageDataCostPerByte), cost)
 }
 
 func TestReplyCosts(t *testing.T) {
@@ -70,7 +74,7 @@ func TestReplyCosts(t *testing.T) {
 		},
 	}
 
-	cost := ReplyCosts(reply)
+	cost := ReplyCosts(false, reply)
 
 	totalAttributesNum := eventsNum * attributesNum
 	require.Equal(t,
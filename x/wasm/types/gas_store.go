@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -24,6 +25,48 @@ func KVStore(ctx sdk.Context, key sdk.StoreKey) types.KVStore {
 	return NewStore(ctx.MultiStore().GetKVStore(key), ctx.GasMeter(), stypes.KVGasConfig())
 }
 
+// StorageSizeStore is the gas metered contract store, which counts the change of the
+// stored key and value bytes of the contract. The replaced values are looked up from
+// the metered store, so the contract calls pay for the reads of the counting.
+type StorageSizeStore struct {
+	types.KVStore
+
+	sizeDelta int64
+}
+
+var _ types.KVStore = &StorageSizeStore{}
+
+// NewStorageSizeStore returns the contract store of the prefix counting its size change
+func NewStorageSizeStore(ctx sdk.Context, key sdk.StoreKey, contractStoreKey []byte) *StorageSizeStore {
+	return &StorageSizeStore{
+		KVStore: prefix.NewStore(KVStore(ctx, key), contractStoreKey),
+	}
+}
+
+// Set implements KVStore.
+func (s *StorageSizeStore) Set(key []byte, value []byte) {
+	s.removeStoredSize(key)
+	s.KVStore.Set(key, value)
+	s.sizeDelta += int64(len(key) + len(value))
+}
+
+// Delete implements KVStore.
+func (s *StorageSizeStore) Delete(key []byte) {
+	s.removeStoredSize(key)
+	s.KVStore.Delete(key)
+}
+
+// SizeDelta returns the change of the stored bytes since the store was created
+func (s *StorageSizeStore) SizeDelta() int64 {
+	return s.sizeDelta
+}
+
+func (s *StorageSizeStore) removeStoredSize(key []byte) {
+	if value := s.KVStore.Get(key); value != nil {
+		s.sizeDelta -= int64(len(key) + len(value))
+	}
+}
+
 // Store applies gas tracking to an underlying KVStore. It implements the
 // KVStore interface.
 type Store struct {
@@ -5,10 +5,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func bz(s string) []byte { return []byte(s) }
@@ -118,3 +123,46 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestStorageSizeStore(t *testing.T) {
+	key := sdk.NewKVStoreKey(StoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()).WithGasMeter(types.NewInfiniteGasMeter())
+	contractStoreKey := GetContractStoreKey(GenerateContractAddress(1, 1))
+
+	st := NewStorageSizeStore(ctx, key, contractStoreKey)
+	st.Set(keyFmt(1), valFmt(1))
+	st.Set(keyFmt(2), valFmt(2))
+	require.Equal(t, int64(2*(len(keyFmt(1))+len(valFmt(1)))), st.SizeDelta())
+
+	// replacing a value counts only the difference
+	st.Set(keyFmt(1), bz("v"))
+	require.Equal(t, int64(len(keyFmt(1))+1+len(keyFmt(2))+len(valFmt(2))), st.SizeDelta())
+
+	// deleting a missing key changes nothing
+	st.Delete(keyFmt(3))
+	st.Delete(keyFmt(1))
+	require.Equal(t, int64(len(keyFmt(2))+len(valFmt(2))), st.SizeDelta())
+
+	// a new store counts from the stored state
+	st = NewStorageSizeStore(ctx, key, contractStoreKey)
+	st.Delete(keyFmt(2))
+	require.Equal(t, -int64(len(keyFmt(2))+len(valFmt(2))), st.SizeDelta())
+
+	// the lookup of the replaced value consumes the read gas
+	meter := types.NewGasMeter(100000)
+	ctx = ctx.WithGasMeter(meter)
+	prefix.NewStore(KVStore(ctx, key), contractStoreKey).Set(keyFmt(4), valFmt(4))
+	setGas := meter.GasConsumed()
+
+	meter = types.NewGasMeter(100000)
+	ctx = ctx.WithGasMeter(meter)
+	NewStorageSizeStore(ctx, key, contractStoreKey).Set(keyFmt(4), valFmt(4))
+
+	gasConfig := types.KVGasConfig()
+	readGas := gasConfig.ReadCostFlat + gasConfig.ReadCostPerByte*types.Gas(len(contractStoreKey)+len(keyFmt(4))+len(valFmt(4)))
+	require.Equal(t, setGas+readGas, meter.GasConsumed())
+}
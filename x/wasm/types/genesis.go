@@ -8,24 +8,30 @@ import (
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, lastCodeID, lastInstanceID uint64, codes []Code, contracts []Contract) *GenesisState {
+func NewGenesisState(params Params, lastCodeID, lastInstanceID uint64, codes []Code, contracts []Contract, lastCronJobID uint64, cronJobs []CronJob, gasSponsorships []GasSponsorship) *GenesisState {
 	return &GenesisState{
-		Params:         params,
-		LastCodeID:     lastCodeID,
-		LastInstanceID: lastInstanceID,
-		Codes:          codes,
-		Contracts:      contracts,
+		Params:          params,
+		LastCodeID:      lastCodeID,
+		LastInstanceID:  lastInstanceID,
+		Codes:           codes,
+		Contracts:       contracts,
+		LastCronJobID:   lastCronJobID,
+		CronJobs:        cronJobs,
+		GasSponsorships: gasSponsorships,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:         DefaultParams(),
-		LastCodeID:     0,
-		LastInstanceID: 0,
-		Codes:          []Code{},
-		Contracts:      []Contract{},
+		Params:          DefaultParams(),
+		LastCodeID:      0,
+		LastInstanceID:  0,
+		Codes:           []Code{},
+		Contracts:       []Contract{},
+		LastCronJobID:   0,
+		CronJobs:        []CronJob{},
+		GasSponsorships: []GasSponsorship{},
 	}
 }
 
@@ -37,8 +43,57 @@ func ValidateGenesis(data *GenesisState) error {
 		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of codes is not met with LastCodeID")
 	}
 
-	if uint64(len(data.Contracts)) != data.LastInstanceID {
-		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of contracts is not met with LastInstanceID")
+	// codes exported before the instantiate permission was introduced
+	// have no permission and are instantiable by everybody
+	for _, code := range data.Codes {
+		if code.CodeInfo.InstantiateConfig.Permission == AccessTypeUnspecified {
+			continue
+		}
+
+		if err := code.CodeInfo.InstantiateConfig.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "code %d instantiate config: %s", code.CodeInfo.CodeID, err)
+		}
+	}
+
+	// contracts instantiated with a salt do not consume an instance id
+	if uint64(len(data.Contracts)) < data.LastInstanceID {
+		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of contracts is less than LastInstanceID")
+	}
+
+	for _, contract := range data.Contracts {
+		for _, entry := range contract.ContractHistory {
+			if err := entry.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "contract %s: %s", contract.ContractInfo.Address, err)
+			}
+		}
+	}
+
+	cronJobIDs := make(map[uint64]bool, len(data.CronJobs))
+	for _, job := range data.CronJobs {
+		if job.ID == 0 || job.ID > data.LastCronJobID {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "cron job id %d is not in the range of LastCronJobID", job.ID)
+		}
+
+		if cronJobIDs[job.ID] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate cron job id %d", job.ID)
+		}
+		cronJobIDs[job.ID] = true
+
+		if err := job.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "cron job %d: %s", job.ID, err)
+		}
+	}
+
+	sponsoringContracts := make(map[string]bool, len(data.GasSponsorships))
+	for _, sponsorship := range data.GasSponsorships {
+		if sponsoringContracts[sponsorship.Contract] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate gas sponsorship of contract %s", sponsorship.Contract)
+		}
+		sponsoringContracts[sponsorship.Contract] = true
+
+		if err := sponsorship.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "gas sponsorship of contract %s: %s", sponsorship.Contract, err)
+		}
 	}
 
 	return data.Params.Validate()
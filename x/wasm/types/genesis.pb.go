@@ -26,11 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the oracle module's genesis state.
 type GenesisState struct {
-	Params         Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastCodeID     uint64     `protobuf:"varint,2,opt,name=last_code_id,json=lastCodeId,proto3" json:"last_code_id,omitempty"`
-	LastInstanceID uint64     `protobuf:"varint,3,opt,name=last_instance_id,json=lastInstanceId,proto3" json:"last_instance_id,omitempty"`
-	Codes          []Code     `protobuf:"bytes,4,rep,name=codes,proto3" json:"codes"`
-	Contracts      []Contract `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts"`
+	Params          Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastCodeID      uint64           `protobuf:"varint,2,opt,name=last_code_id,json=lastCodeId,proto3" json:"last_code_id,omitempty"`
+	LastInstanceID  uint64           `protobuf:"varint,3,opt,name=last_instance_id,json=lastInstanceId,proto3" json:"last_instance_id,omitempty"`
+	Codes           []Code           `protobuf:"bytes,4,rep,name=codes,proto3" json:"codes"`
+	Contracts       []Contract       `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts"`
+	LastCronJobID   uint64           `protobuf:"varint,6,opt,name=last_cron_job_id,json=lastCronJobId,proto3" json:"last_cron_job_id,omitempty"`
+	CronJobs        []CronJob        `protobuf:"bytes,7,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs"`
+	GasSponsorships []GasSponsorship `protobuf:"bytes,8,rep,name=gas_sponsorships,json=gasSponsorships,proto3" json:"gas_sponsorships"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -101,6 +104,27 @@ func (m *GenesisState) GetContracts() []Contract {
 	return nil
 }
 
+func (m *GenesisState) GetLastCronJobID() uint64 {
+	if m != nil {
+		return m.LastCronJobID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCronJobs() []CronJob {
+	if m != nil {
+		return m.CronJobs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGasSponsorships() []GasSponsorship {
+	if m != nil {
+		return m.GasSponsorships
+	}
+	return nil
+}
+
 // Model is a struct that holds a KV pair
 type Model struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -158,6 +182,8 @@ func (m *Model) GetValue() []byte {
 type Code struct {
 	CodeInfo  CodeInfo `protobuf:"bytes,1,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
 	CodeBytes []byte   `protobuf:"bytes,2,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	// Pinned to wasmvm cache
+	Pinned bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -207,10 +233,19 @@ func (m *Code) GetCodeBytes() []byte {
 	return nil
 }
 
+func (m *Code) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractInfo  ContractInfo `protobuf:"bytes,1,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractStore []Model      `protobuf:"bytes,2,rep,name=contract_store,json=contractStore,proto3" json:"contract_store"`
+	// ContractHistory is the append-only history of the contract
+	ContractHistory []ContractHistoryEntry `protobuf:"bytes,3,rep,name=contract_history,json=contractHistory,proto3" json:"contract_history"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -260,6 +295,13 @@ func (m *Contract) GetContractStore() []Model {
 	return nil
 }
 
+func (m *Contract) GetContractHistory() []ContractHistoryEntry {
+	if m != nil {
+		return m.ContractHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "iq.wasm.v1beta1.GenesisState")
 	proto.RegisterType((*Model)(nil), "iq.wasm.v1beta1.Model")
@@ -270,37 +312,45 @@ func init() {
 func init() { proto.RegisterFile("iq/wasm/v1beta1/genesis.proto", fileDescriptor_bd15c5bc3571c951) }
 
 var fileDescriptor_bd15c5bc3571c951 = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xa4, 0x2a, 0x53, 0x13, 0x55, 0xab, 0x1e, 0x4c, 0xd4, 0x3a, 0x56, 0x4e, 0xb9,
-	0xe0, 0xa5, 0x85, 0x03, 0x07, 0x24, 0x90, 0xa9, 0x40, 0x11, 0x20, 0x21, 0xf7, 0xc6, 0xa5, 0x5a,
-	0xaf, 0x37, 0xc1, 0xc2, 0xf6, 0x44, 0xde, 0x6d, 0x21, 0x6f, 0xc1, 0x1b, 0xf0, 0x0a, 0x3c, 0x46,
-	0x8f, 0x3d, 0x72, 0x8a, 0x90, 0xf3, 0x22, 0x68, 0xd7, 0x9b, 0x10, 0x09, 0xd3, 0xdb, 0xcc, 0xce,
-	0xf7, 0x37, 0xa3, 0x85, 0x40, 0x89, 0xaa, 0x62, 0xf4, 0x2b, 0x93, 0x05, 0xbd, 0x39, 0x4b, 0x84,
-	0x62, 0x67, 0x74, 0x2e, 0x4a, 0x21, 0x33, 0x19, 0x2e, 0x2a, 0x54, 0x48, 0x88, 0x41, 0x84, 0x1a,
-	0x11, 0x5a, 0xc4, 0xf0, 0x78, 0x8e, 0x73, 0x34, 0x63, 0xaa, 0xab, 0x06, 0x39, 0x3c, 0x6d, 0xd1,
-	0x32, 0xb4, 0x66, 0xec, 0x73, 0x94, 0x05, 0x4a, 0x9a, 0x30, 0x29, 0xb6, 0x73, 0x8e, 0x59, 0xd9,
-	0xcc, 0xc7, 0x3f, 0xf7, 0xc0, 0x7d, 0xdb, 0x58, 0x5f, 0x2a, 0xa6, 0x04, 0x79, 0x0e, 0xfb, 0x0b,
-	0x56, 0xb1, 0x42, 0x7a, 0x4e, 0xe0, 0x4c, 0x0e, 0xcf, 0x87, 0xe1, 0xbf, 0x51, 0xc2, 0x8f, 0x06,
-	0x11, 0xf5, 0x6e, 0x57, 0xa3, 0x4e, 0x6c, 0xf1, 0xe4, 0x09, 0xb8, 0x39, 0x93, 0xea, 0x8a, 0x63,
-	0x2a, 0xae, 0xb2, 0xd4, 0xdb, 0x0b, 0x9c, 0x49, 0x2f, 0x1a, 0xd4, 0xab, 0x11, 0xbc, 0x67, 0x52,
-	0xbd, 0xc6, 0x54, 0x4c, 0x2f, 0x62, 0xc8, 0x37, 0x75, 0x4a, 0x5e, 0xc0, 0x91, 0x61, 0x64, 0xa5,
-	0x54, 0xac, 0xe4, 0x86, 0xd5, 0x35, 0x2c, 0x52, 0xaf, 0x46, 0x03, 0xcd, 0x9a, 0xda, 0xd1, 0xf4,
-	0x22, 0x1e, 0xe4, 0xbb, 0x7d, 0x4a, 0x9e, 0x41, 0x5f, 0x5b, 0x49, 0xaf, 0x17, 0x74, 0x27, 0x87,
-	0xe7, 0x5e, 0x5b, 0x50, 0x6d, 0x64, 0x63, 0x36, 0x60, 0xf2, 0x0a, 0x1e, 0x70, 0x2c, 0x55, 0xc5,
-	0xb8, 0x92, 0x5e, 0xdf, 0x30, 0x4f, 0xda, 0x99, 0x0d, 0xc8, 0xb2, 0xff, 0x92, 0xc6, 0x14, 0xfa,
-	0x1f, 0x30, 0x15, 0x39, 0x39, 0x82, 0xee, 0x17, 0xb1, 0x34, 0x77, 0x72, 0x63, 0x5d, 0x92, 0x63,
-	0xe8, 0xdf, 0xb0, 0xfc, 0x5a, 0x98, 0xdd, 0xdd, 0xb8, 0x69, 0xc6, 0x33, 0xe8, 0xe9, 0x1c, 0xe4,
-	0xa5, 0xb6, 0xd6, 0xb7, 0x29, 0x67, 0x68, 0xaf, 0x7b, 0xf2, 0xbf, 0xd0, 0xd3, 0x72, 0x86, 0xd6,
-	0xfa, 0x80, 0xdb, 0x9e, 0x9c, 0x02, 0x18, 0x81, 0x64, 0xa9, 0x84, 0xb4, 0x1e, 0x46, 0x32, 0xd2,
-	0x0f, 0xe3, 0x1f, 0x0e, 0x1c, 0x6c, 0x62, 0x93, 0x77, 0xf0, 0x70, 0x13, 0x79, 0xd7, 0x30, 0xb8,
-	0x6f, 0xd7, 0x1d, 0x53, 0x97, 0xef, 0xbc, 0x91, 0x37, 0x30, 0xd8, 0x8a, 0x49, 0x85, 0x95, 0x5e,
-	0x50, 0x5f, 0xee, 0x51, 0x9b, 0x9a, 0x39, 0x8e, 0x95, 0xd9, 0x66, 0xb8, 0xd4, 0xac, 0x28, 0xba,
-	0xad, 0x7d, 0xe7, 0xae, 0xf6, 0x9d, 0xdf, 0xb5, 0xef, 0x7c, 0x5f, 0xfb, 0x9d, 0xbb, 0xb5, 0xdf,
-	0xf9, 0xb5, 0xf6, 0x3b, 0x9f, 0x26, 0xf3, 0x4c, 0x7d, 0xbe, 0x4e, 0x42, 0x8e, 0x05, 0x35, 0x9a,
-	0x8f, 0x0b, 0x2c, 0xc5, 0x92, 0x72, 0xac, 0x04, 0xfd, 0xd6, 0x7c, 0x6f, 0xb5, 0x5c, 0x08, 0x99,
-	0xec, 0x9b, 0x8f, 0xfb, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xd2, 0xf3, 0x3a, 0x45,
-	0x03, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0xfe, 0xfb, 0xf2, 0x2f, 0x43, 0x29, 0x75, 0x82, 0xb8, 0x90, 0xb0, 0x25, 0x4d, 0x8c,
+	0x5c, 0xec, 0x0a, 0xc6, 0x8b, 0xe2, 0xc1, 0x82, 0x81, 0x1a, 0x4d, 0xc8, 0x92, 0x78, 0xf0, 0xd2,
+	0xcc, 0xce, 0x0e, 0x65, 0xb4, 0x9d, 0x29, 0xfb, 0x0c, 0x60, 0xe3, 0x97, 0xf0, 0x63, 0x71, 0xe4,
+	0xe8, 0xa9, 0x31, 0xdb, 0x4f, 0xa0, 0x9f, 0xc0, 0xcc, 0x4b, 0x4b, 0xa1, 0x70, 0x9b, 0x67, 0x7e,
+	0xcf, 0xef, 0x65, 0x9e, 0x67, 0x5b, 0xb4, 0xc1, 0xcf, 0xc2, 0x4b, 0x02, 0xfd, 0xf0, 0x62, 0x3b,
+	0x66, 0x8a, 0x6c, 0x87, 0x5d, 0x26, 0x18, 0x70, 0x68, 0x0e, 0x52, 0xa9, 0x24, 0x5e, 0xe6, 0x67,
+	0x4d, 0x0d, 0x37, 0x1d, 0xbc, 0xbe, 0xd2, 0x95, 0x5d, 0x69, 0xb0, 0x50, 0x9f, 0x6c, 0xdb, 0xfa,
+	0xfa, 0x5d, 0x15, 0xc3, 0xb1, 0x58, 0x40, 0x25, 0xf4, 0x25, 0x84, 0x31, 0x01, 0x36, 0xc5, 0xa9,
+	0xe4, 0xc2, 0xe2, 0x8d, 0xbf, 0x79, 0x54, 0x39, 0xb0, 0xa6, 0xc7, 0x8a, 0x28, 0x86, 0x5f, 0xa1,
+	0xd2, 0x80, 0xa4, 0xa4, 0x0f, 0xbe, 0xb7, 0xe9, 0x6d, 0x2d, 0xee, 0x3c, 0x69, 0xde, 0x09, 0xd1,
+	0x3c, 0x32, 0x70, 0xab, 0x70, 0x35, 0xaa, 0xe7, 0x22, 0xd7, 0x8c, 0x5f, 0xa0, 0x4a, 0x8f, 0x80,
+	0xea, 0x50, 0x99, 0xb0, 0x0e, 0x4f, 0xfc, 0xff, 0x36, 0xbd, 0xad, 0x42, 0xab, 0x9a, 0x8d, 0xea,
+	0xe8, 0x23, 0x01, 0xb5, 0x27, 0x13, 0xd6, 0xde, 0x8f, 0x50, 0x6f, 0x72, 0x4e, 0xf0, 0x2e, 0xaa,
+	0x19, 0x06, 0x17, 0xa0, 0x88, 0xa0, 0x86, 0x95, 0x37, 0x2c, 0x9c, 0x8d, 0xea, 0x55, 0xcd, 0x6a,
+	0x3b, 0xa8, 0xbd, 0x1f, 0x55, 0x7b, 0xb3, 0x75, 0x82, 0xb7, 0x51, 0x51, 0x5b, 0x81, 0x5f, 0xd8,
+	0xcc, 0x6f, 0x2d, 0xee, 0x3c, 0x9e, 0x4b, 0xa9, 0x5d, 0x5c, 0x46, 0xdb, 0x89, 0xdf, 0xa2, 0x05,
+	0x2a, 0x85, 0x4a, 0x09, 0x55, 0xe0, 0x17, 0x0d, 0x6d, 0xed, 0x1e, 0x9a, 0xed, 0x70, 0xd4, 0x1b,
+	0x06, 0x7e, 0xed, 0xf2, 0xd2, 0x54, 0x8a, 0xce, 0x57, 0x19, 0xeb, 0xbc, 0x25, 0x93, 0xf7, 0x51,
+	0x36, 0xaa, 0x2f, 0x99, 0x57, 0xa6, 0x52, 0x7c, 0x90, 0x71, 0x7b, 0x3f, 0x5a, 0xea, 0xcd, 0x94,
+	0x09, 0x7e, 0x83, 0x16, 0x26, 0x34, 0xf0, 0xff, 0x37, 0xd6, 0xfe, 0xbc, 0xb5, 0x6d, 0x77, 0xce,
+	0x65, 0x6a, 0x4b, 0xc0, 0x47, 0xa8, 0xd6, 0x25, 0xd0, 0x81, 0x81, 0x14, 0x20, 0x53, 0x38, 0xe5,
+	0x03, 0xf0, 0xcb, 0x46, 0xa3, 0x3e, 0xa7, 0x71, 0x40, 0xe0, 0xf8, 0xa6, 0xcf, 0x49, 0x2d, 0x77,
+	0x6f, 0xdd, 0x42, 0x23, 0x44, 0xc5, 0x4f, 0x32, 0x61, 0x3d, 0x5c, 0x43, 0xf9, 0x6f, 0x6c, 0x68,
+	0x36, 0x5d, 0x89, 0xf4, 0x11, 0xaf, 0xa0, 0xe2, 0x05, 0xe9, 0x9d, 0x33, 0xb3, 0xc0, 0x4a, 0x64,
+	0x8b, 0xc6, 0x0f, 0x54, 0xd0, 0xf3, 0xc4, 0xbb, 0x68, 0xc1, 0x2e, 0x58, 0x9c, 0x48, 0xf7, 0x7d,
+	0xac, 0xdd, 0x3b, 0xf9, 0xb6, 0x38, 0x91, 0xd3, 0x87, 0xb8, 0x1a, 0x6f, 0x20, 0x64, 0xd8, 0xf1,
+	0x50, 0x31, 0x70, 0x06, 0x46, 0xaf, 0xa5, 0x2f, 0xf0, 0x2a, 0x2a, 0x0d, 0xb8, 0x10, 0xcc, 0x7e,
+	0x06, 0xe5, 0xc8, 0x55, 0x8d, 0x3f, 0x1e, 0x2a, 0x4f, 0xd6, 0x82, 0x0f, 0xd1, 0xd2, 0x64, 0x25,
+	0xb3, 0x29, 0x36, 0x1e, 0x5c, 0xe4, 0x4c, 0x92, 0x0a, 0x9d, 0xb9, 0xc3, 0x7b, 0xa8, 0x3a, 0x55,
+	0x02, 0x25, 0x53, 0xfd, 0x64, 0x3d, 0xd4, 0xd5, 0x39, 0x29, 0x33, 0x2b, 0xa7, 0x31, 0x75, 0x3f,
+	0xd6, 0x14, 0xfc, 0x19, 0xd5, 0xa6, 0x22, 0xa7, 0x5c, 0xcb, 0x0c, 0xfd, 0xbc, 0x91, 0x79, 0xfa,
+	0x60, 0xa2, 0x43, 0xdb, 0xf7, 0x5e, 0xa8, 0x74, 0x38, 0xd9, 0x10, 0xbd, 0x8d, 0xb5, 0xde, 0x5d,
+	0x65, 0x81, 0x77, 0x9d, 0x05, 0xde, 0xef, 0x2c, 0xf0, 0x7e, 0x8e, 0x83, 0xdc, 0xf5, 0x38, 0xc8,
+	0xfd, 0x1a, 0x07, 0xb9, 0x2f, 0xcf, 0xba, 0x5c, 0x9d, 0x9e, 0xc7, 0x4d, 0x2a, 0xfb, 0x61, 0xcc,
+	0xd5, 0x25, 0x8b, 0x21, 0xe4, 0x67, 0xcf, 0xa9, 0x4c, 0x59, 0xf8, 0xdd, 0xfe, 0x0d, 0xa8, 0xe1,
+	0x80, 0x41, 0x5c, 0x32, 0x3f, 0xf0, 0x97, 0xff, 0x06, 0x00, 0xbd, 0x12, 0x70, 0x81, 0x64, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -323,6 +373,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasSponsorships) > 0 {
+		for iNdEx := len(m.GasSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CronJobs) > 0 {
+		for iNdEx := len(m.CronJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastCronJobID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastCronJobID))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -431,6 +514,16 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -471,6 +564,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractHistory) > 0 {
+		for iNdEx := len(m.ContractHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ContractStore) > 0 {
 		for iNdEx := len(m.ContractStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -535,6 +642,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastCronJobID != 0 {
+		n += 1 + sovGenesis(uint64(m.LastCronJobID))
+	}
+	if len(m.CronJobs) > 0 {
+		for _, e := range m.CronJobs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GasSponsorships) > 0 {
+		for _, e := range m.GasSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -567,6 +689,9 @@ func (m *Code) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Pinned {
+		n += 2
+	}
 	return n
 }
 
@@ -584,6 +709,12 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractHistory) > 0 {
+		for _, e := range m.ContractHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -761,6 +892,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCronJobID", wireType)
+			}
+			m.LastCronJobID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCronJobID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronJobs = append(m.CronJobs, CronJob{})
+			if err := m.CronJobs[len(m.CronJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSponsorships = append(m.GasSponsorships, GasSponsorship{})
+			if err := m.GasSponsorships[len(m.GasSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -996,6 +1214,26 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1113,6 +1351,40 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractHistory = append(m.ContractHistory, ContractHistoryEntry{})
+			if err := m.ContractHistory[len(m.ContractHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
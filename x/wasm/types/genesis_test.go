@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesisValidation(t *testing.T) {
@@ -29,11 +31,63 @@ func TestGenesisValidation(t *testing.T) {
 	genState.LastCodeID = 1
 	require.Error(t, ValidateGenesis(genState))
 
+	genState = DefaultGenesisState()
+	genState.Codes = []Code{{CodeInfo: CodeInfo{InstantiateConfig: AllowNobody}}}
+	genState.LastCodeID = 1
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Codes[0].CodeInfo.InstantiateConfig = AccessConfig{Permission: AccessTypeOnlyAddresses}
+	require.Error(t, ValidateGenesis(genState))
+
 	genState = DefaultGenesisState()
 	genState.Contracts = []Contract{{}, {}}
 	genState.LastInstanceID = 2
 	require.NoError(t, ValidateGenesis(genState))
 
+	// contracts instantiated with a salt do not consume an instance id
+	genState.LastInstanceID = 1
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.LastInstanceID = 3
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
+	genState.Contracts = []Contract{{ContractHistory: []ContractHistoryEntry{{Operation: ContractHistoryOperationTypeInit}}}}
 	genState.LastInstanceID = 1
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Contracts[0].ContractHistory[0].Operation = ContractHistoryOperationTypeUnspecified
+	require.Error(t, ValidateGenesis(genState))
+
+	contract := sdk.AccAddress([]byte("contract____________"))
+	escrow := sdk.NewInt64Coin("ubiq", 1000)
+	genState = DefaultGenesisState()
+	genState.CronJobs = []CronJob{
+		NewCronJob(1, contract, []byte("{}"), 10, 0, 100, 100, escrow),
+		NewCronJob(2, contract, []byte("{}"), 10, 5, 100, 1000, escrow),
+	}
+	genState.LastCronJobID = 2
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.LastCronJobID = 1
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.LastCronJobID = 2
+	genState.CronJobs[1].ID = 1
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.CronJobs[1].ID = 2
+	genState.CronJobs[1].Msg = []byte("invalid")
+	require.Error(t, ValidateGenesis(genState))
+
+	limit := sdk.NewCoins(escrow)
+	genState = DefaultGenesisState()
+	genState.GasSponsorships = []GasSponsorship{NewGasSponsorship(contract, limit, 10, limit)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.GasSponsorships = append(genState.GasSponsorships, NewGasSponsorship(contract, limit, 0, limit))
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.GasSponsorships = []GasSponsorship{NewGasSponsorship(contract, limit, 10, sdk.Coins{})}
 	require.Error(t, ValidateGenesis(genState))
 }
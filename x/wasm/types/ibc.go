@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// PortIDPrefix is the prefix of the IBC ports bound to the contracts
+const PortIDPrefix = "wasm."
+
+// PortIDForContract returns the IBC port id of the contract
+func PortIDForContract(contractAddr sdk.AccAddress) string {
+	return PortIDPrefix + contractAddr.String()
+}
+
+// ContractFromPortID returns the address of the contract bound to the IBC port id
+func ContractFromPortID(portID string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(portID, PortIDPrefix) {
+		return nil, sdkerrors.Wrapf(ErrInvalidIBCPort, "port %s without prefix %s", portID, PortIDPrefix)
+	}
+
+	return sdk.AccAddressFromBech32(portID[len(PortIDPrefix):])
+}
+
+// NewIBCChannel converts the channel end to the wasmvm IBC channel
+func NewIBCChannel(portID, channelID string, channel channeltypes.Channel) wasmvmtypes.IBCChannel {
+	var connectionID string
+	if len(channel.ConnectionHops) != 0 {
+		connectionID = channel.ConnectionHops[0]
+	}
+
+	return wasmvmtypes.IBCChannel{
+		Endpoint: wasmvmtypes.IBCEndpoint{PortID: portID, ChannelID: channelID},
+		CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{
+			PortID:    channel.Counterparty.PortId,
+			ChannelID: channel.Counterparty.ChannelId,
+		},
+		Order:        channel.Ordering.String(),
+		Version:      channel.Version,
+		ConnectionID: connectionID,
+	}
+}
+
+// NewIBCPacket converts the channel packet to the wasmvm IBC packet
+func NewIBCPacket(packet channeltypes.Packet) wasmvmtypes.IBCPacket {
+	var blockTimeout *wasmvmtypes.IBCTimeoutBlock
+	if !packet.TimeoutHeight.IsZero() {
+		blockTimeout = &wasmvmtypes.IBCTimeoutBlock{
+			Revision: packet.TimeoutHeight.RevisionNumber,
+			Height:   packet.TimeoutHeight.RevisionHeight,
+		}
+	}
+
+	return wasmvmtypes.IBCPacket{
+		Data:     packet.Data,
+		Src:      wasmvmtypes.IBCEndpoint{PortID: packet.SourcePort, ChannelID: packet.SourceChannel},
+		Dest:     wasmvmtypes.IBCEndpoint{PortID: packet.DestinationPort, ChannelID: packet.DestinationChannel},
+		Sequence: packet.Sequence,
+		Timeout: wasmvmtypes.IBCTimeout{
+			Block:     blockTimeout,
+			Timestamp: packet.TimeoutTimestamp,
+		},
+	}
+}
+
+// ParseToTimeoutHeight converts the wasmvm block timeout to the IBC client height;
+// an empty timeout is the zero height
+func ParseToTimeoutHeight(blockTimeout *wasmvmtypes.IBCTimeoutBlock) clienttypes.Height {
+	if blockTimeout == nil {
+		return clienttypes.ZeroHeight()
+	}
+
+	return clienttypes.NewHeight(blockTimeout.Revision, blockTimeout.Height)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+func TestPortIDForContract(t *testing.T) {
+	contractAddr := sdk.AccAddress([]byte("contract_address____"))
+
+	portID := PortIDForContract(contractAddr)
+	require.Equal(t, PortIDPrefix+contractAddr.String(), portID)
+
+	addr, err := ContractFromPortID(portID)
+	require.NoError(t, err)
+	require.Equal(t, contractAddr, addr)
+
+	_, err = ContractFromPortID("transfer")
+	require.True(t, ErrInvalidIBCPort.Is(err))
+
+	_, err = ContractFromPortID(PortIDPrefix + "invalid")
+	require.Error(t, err)
+}
+
+func TestNewIBCPacket(t *testing.T) {
+	packet := channeltypes.NewPacket([]byte("data"), 1, "src-port", "channel-0", "dst-port", "channel-1", clienttypes.NewHeight(1, 100), 1000)
+	require.Equal(t, wasmvmtypes.IBCPacket{
+		Data:     []byte("data"),
+		Src:      wasmvmtypes.IBCEndpoint{PortID: "src-port", ChannelID: "channel-0"},
+		Dest:     wasmvmtypes.IBCEndpoint{PortID: "dst-port", ChannelID: "channel-1"},
+		Sequence: 1,
+		Timeout: wasmvmtypes.IBCTimeout{
+			Block:     &wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 100},
+			Timestamp: 1000,
+		},
+	}, NewIBCPacket(packet))
+
+	// zero timeout height is omitted
+	packet.TimeoutHeight = clienttypes.ZeroHeight()
+	require.Nil(t, NewIBCPacket(packet).Timeout.Block)
+	require.Equal(t, clienttypes.ZeroHeight(), ParseToTimeoutHeight(nil))
+}
@@ -23,6 +23,9 @@ const (
 
 	// WasmVMQueryDepthContextKey context key to keep query depth
 	WasmVMQueryDepthContextKey = "wasmvm-query-depth"
+
+	// WasmVMSubMsgDepthContextKey context key to keep submessage depth
+	WasmVMSubMsgDepthContextKey = "wasmvm-submsg-depth"
 )
 
 // Keys for wasm store
@@ -37,12 +40,51 @@ const (
 // - 0x04<accAddress_Bytes>: ContractInfo
 //
 // - 0x05<accAddress_Bytes>: KVStore for contract
+//
+// - 0x06<uint64><accAddress_Bytes>: []byte{}
+//
+// - 0x07<creatorAddress_Bytes><accAddress_Bytes>: []byte{}
+//
+// - 0x08<adminAddress_Bytes><accAddress_Bytes>: []byte{}
+//
+// - 0x09<accAddress_Bytes><uint64>: ContractHistoryEntry
+//
+// - 0x0A<uint64>: []byte{}
+//
+// - 0x0B: uint64
+//
+// - 0x0C<uint64>: CronJob
+//
+// - 0x0D<uint64_height><uint64>: []byte{}
+//
+// - 0x0E<accAddress_Bytes><uint64>: []byte{}
+//
+// - 0x0F<accAddress_Bytes>: GasSponsorship
+//
+// - 0x10<accAddress_Bytes><userAddress_Bytes>: SponsoredSpending
+//
+// - 0x11<accAddress_Bytes>: SponsoredSpending
+//
+// - 0x12: []byte (the key of the next contract info charged the storage rent)
 var (
-	LastCodeIDKey     = []byte{0x01}
-	LastInstanceIDKey = []byte{0x02}
-	CodeKey           = []byte{0x03}
-	ContractInfoKey   = []byte{0x04}
-	ContractStoreKey  = []byte{0x05}
+	LastCodeIDKey         = []byte{0x01}
+	LastInstanceIDKey     = []byte{0x02}
+	CodeKey               = []byte{0x03}
+	ContractInfoKey       = []byte{0x04}
+	ContractStoreKey      = []byte{0x05}
+	ContractsByCodeKey    = []byte{0x06}
+	ContractsByCreatorKey = []byte{0x07}
+	ContractsByAdminKey   = []byte{0x08}
+	ContractHistoryKey    = []byte{0x09}
+	PinnedCodeIndexKey    = []byte{0x0A}
+	LastCronJobIDKey      = []byte{0x0B}
+	CronJobKey            = []byte{0x0C}
+	CronQueueKey          = []byte{0x0D}
+	CronJobsByContractKey = []byte{0x0E}
+	GasSponsorshipKey     = []byte{0x0F}
+	UserSpendingKey       = []byte{0x10}
+	BlockSpendingKey      = []byte{0x11}
+	StorageRentCursorKey  = []byte{0x12}
 )
 
 // GetCodeInfoKey constructs the key of the WASM code info for the ID
@@ -51,6 +93,11 @@ func GetCodeInfoKey(codeID uint64) []byte {
 	return append(CodeKey, contractIDBz...)
 }
 
+// GetPinnedCodeIndexKey returns the key of the pinned code index
+func GetPinnedCodeIndexKey(codeID uint64) []byte {
+	return append(PinnedCodeIndexKey, sdk.Uint64ToBigEndian(codeID)...)
+}
+
 // GetContractInfoKey returns the key of the WASM contract info for the contract address
 func GetContractInfoKey(addr sdk.AccAddress) []byte {
 	return append(ContractInfoKey, address.MustLengthPrefix(addr)...)
@@ -60,3 +107,88 @@ func GetContractInfoKey(addr sdk.AccAddress) []byte {
 func GetContractStoreKey(addr sdk.AccAddress) []byte {
 	return append(ContractStoreKey, address.MustLengthPrefix(addr)...)
 }
+
+// GetContractsByCodePrefix returns the index prefix of the contracts instantiated from the code
+func GetContractsByCodePrefix(codeID uint64) []byte {
+	return append(ContractsByCodeKey, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractByCodeKey returns the index key of the contract instantiated from the code
+func GetContractByCodeKey(codeID uint64, addr sdk.AccAddress) []byte {
+	return append(GetContractsByCodePrefix(codeID), address.MustLengthPrefix(addr)...)
+}
+
+// GetContractsByCreatorPrefix returns the index prefix of the contracts instantiated by the creator
+func GetContractsByCreatorPrefix(creator sdk.AccAddress) []byte {
+	return append(ContractsByCreatorKey, address.MustLengthPrefix(creator)...)
+}
+
+// GetContractByCreatorKey returns the index key of the contract instantiated by the creator
+func GetContractByCreatorKey(creator, addr sdk.AccAddress) []byte {
+	return append(GetContractsByCreatorPrefix(creator), address.MustLengthPrefix(addr)...)
+}
+
+// GetContractsByAdminPrefix returns the index prefix of the contracts administrated by the admin
+func GetContractsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(ContractsByAdminKey, address.MustLengthPrefix(admin)...)
+}
+
+// GetContractByAdminKey returns the index key of the contract administrated by the admin
+func GetContractByAdminKey(admin, addr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminPrefix(admin), address.MustLengthPrefix(addr)...)
+}
+
+// GetContractHistoryPrefix returns the store prefix of the contract history
+func GetContractHistoryPrefix(addr sdk.AccAddress) []byte {
+	return append(ContractHistoryKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetContractHistoryKey returns the key of the contract history entry at the position
+func GetContractHistoryKey(addr sdk.AccAddress, pos uint64) []byte {
+	return append(GetContractHistoryPrefix(addr), sdk.Uint64ToBigEndian(pos)...)
+}
+
+// GetCronJobKey returns the key of the cron job for the ID
+func GetCronJobKey(jobID uint64) []byte {
+	return append(CronJobKey, sdk.Uint64ToBigEndian(jobID)...)
+}
+
+// GetCronQueuePrefix returns the prefix of the cron queue entries executed at the height
+func GetCronQueuePrefix(height int64) []byte {
+	return append(CronQueueKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCronQueueKey returns the cron queue key of the job executed at the height
+func GetCronQueueKey(height int64, jobID uint64) []byte {
+	return append(GetCronQueuePrefix(height), sdk.Uint64ToBigEndian(jobID)...)
+}
+
+// GetCronJobsByContractPrefix returns the index prefix of the cron jobs of the contract
+func GetCronJobsByContractPrefix(addr sdk.AccAddress) []byte {
+	return append(CronJobsByContractKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetCronJobByContractKey returns the index key of the cron job of the contract
+func GetCronJobByContractKey(addr sdk.AccAddress, jobID uint64) []byte {
+	return append(GetCronJobsByContractPrefix(addr), sdk.Uint64ToBigEndian(jobID)...)
+}
+
+// GetGasSponsorshipKey returns the key of the gas sponsorship of the contract
+func GetGasSponsorshipKey(addr sdk.AccAddress) []byte {
+	return append(GasSponsorshipKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetUserSpendingsPrefix returns the prefix of the fees sponsored by the contract for each user
+func GetUserSpendingsPrefix(addr sdk.AccAddress) []byte {
+	return append(UserSpendingKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetUserSpendingKey returns the key of the fee sponsored by the contract for the user
+func GetUserSpendingKey(addr, user sdk.AccAddress) []byte {
+	return append(GetUserSpendingsPrefix(addr), address.MustLengthPrefix(user)...)
+}
+
+// GetBlockSpendingKey returns the key of the fee sponsored by the contract within the last block
+func GetBlockSpendingKey(addr sdk.AccAddress) []byte {
+	return append(BlockSpendingKey, address.MustLengthPrefix(addr)...)
+}
@@ -16,6 +16,8 @@ const (
 	WasmMsgParserRouteDistribution = "distribution"
 	WasmMsgParserRouteGov          = "gov"
 	WasmMsgParserRouteMarket       = "market"
+	WasmMsgParserRouteOracle       = "oracle"
+	WasmMsgParserRouteTokenFactory = "tokenfactory"
 	WasmMsgParserRouteWasm         = "wasm"
 )
 
@@ -30,6 +32,16 @@ type StargateWasmMsgParserInterface interface {
 	Parse(msg wasmvmtypes.CosmosMsg) (sdk.Msg, error)
 }
 
+// IBCWasmMsgParserInterface - ibc msg parser, which requires the context
+// to resolve the ports of the sending contract
+type IBCWasmMsgParserInterface interface {
+	Parse(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.CosmosMsg) (sdk.Msg, error)
+}
+
+// MsgFilter - checks the msg dispatched by the contract before it is executed;
+// the filters run in the consensus, so they must only depend on the state
+type MsgFilter func(ctx sdk.Context, contractAddr sdk.AccAddress, msg sdk.Msg) error
+
 // WasmCustomMsg - wasm custom msg parser
 type WasmCustomMsg struct {
 	Route   string          `json:"route"`
@@ -40,6 +52,7 @@ type WasmCustomMsg struct {
 type MsgParser struct {
 	Parsers        map[string]WasmMsgParserInterface
 	StargateParser StargateWasmMsgParserInterface
+	IBCParser      IBCWasmMsgParserInterface
 }
 
 // NewWasmMsgParser returns wasm msg parser
@@ -105,7 +118,11 @@ func (p MsgParser) Parse(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmv
 
 		return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "stargate")
 	case msg.IBC != nil:
-		return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "IBC not supported")
+		if p.IBCParser != nil {
+			return p.IBCParser.Parse(ctx, contractAddr, msg)
+		}
+
+		return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "ibc")
 	}
 
 	return nil, sdkerrors.Wrap(ErrInvalidMsg, "failed to parse empty msg")
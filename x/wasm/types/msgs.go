@@ -12,21 +12,31 @@ var (
 	_ sdk.Msg = &MsgStoreCode{}
 	_ sdk.Msg = &MsgMigrateCode{}
 	_ sdk.Msg = &MsgInstantiateContract{}
+	_ sdk.Msg = &MsgInstantiateContract2{}
 	_ sdk.Msg = &MsgExecuteContract{}
 	_ sdk.Msg = &MsgMigrateContract{}
 	_ sdk.Msg = &MsgUpdateContractAdmin{}
 	_ sdk.Msg = &MsgClearContractAdmin{}
+	_ sdk.Msg = &MsgRegisterCronJob{}
+	_ sdk.Msg = &MsgCancelCronJob{}
+	_ sdk.Msg = &MsgSetGasSponsorship{}
+	_ sdk.Msg = &MsgRemoveGasSponsorship{}
 )
 
 // wasm message types
 const (
-	TypeMsgStoreCode           = "store_code"
-	TypeMsgMigrateCode         = "migrate_code"
-	TypeMsgInstantiateContract = "instantiate_contract"
-	TypeMsgExecuteContract     = "execute_contract"
-	TypeMsgMigrateContract     = "migrate_contract"
-	TypeMsgUpdateContractAdmin = "update_contract_admin"
-	TypeMsgClearContractAdmin  = "clear_contract_admin"
+	TypeMsgStoreCode            = "store_code"
+	TypeMsgMigrateCode          = "migrate_code"
+	TypeMsgInstantiateContract  = "instantiate_contract"
+	TypeMsgInstantiateContract2 = "instantiate_contract2"
+	TypeMsgExecuteContract      = "execute_contract"
+	TypeMsgMigrateContract      = "migrate_contract"
+	TypeMsgUpdateContractAdmin  = "update_contract_admin"
+	TypeMsgClearContractAdmin   = "clear_contract_admin"
+	TypeMsgRegisterCronJob      = "register_cron_job"
+	TypeMsgCancelCronJob        = "cancel_cron_job"
+	TypeMsgSetGasSponsorship    = "set_gas_sponsorship"
+	TypeMsgRemoveGasSponsorship = "remove_gas_sponsorship"
 )
 
 // NewMsgStoreCode creates a MsgStoreCode instance
@@ -73,6 +83,12 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm code too large")
 	}
 
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
 	return nil
 }
 
@@ -126,7 +142,7 @@ func (msg MsgMigrateCode) ValidateBasic() error {
 }
 
 // NewMsgInstantiateContract creates a MsgInstantiateContract instance
-func NewMsgInstantiateContract(sender, admin sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins) *MsgInstantiateContract {
+func NewMsgInstantiateContract(sender, admin sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins, label string) *MsgInstantiateContract {
 	var adminAddr string
 	if !admin.Empty() {
 		adminAddr = admin.String()
@@ -138,6 +154,7 @@ func NewMsgInstantiateContract(sender, admin sdk.AccAddress, codeID uint64, init
 		CodeID:    codeID,
 		InitMsg:   initMsg,
 		InitCoins: initCoins,
+		Label:     label,
 	}
 }
 
@@ -177,7 +194,7 @@ func (msg MsgInstantiateContract) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte format is invalid json")
 	}
 
-	return nil
+	return ValidateLabel(msg.Label)
 }
 
 // GetSignBytes implements sdk.Msg
@@ -195,6 +212,92 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// NewMsgInstantiateContract2 creates a MsgInstantiateContract2 instance
+func NewMsgInstantiateContract2(sender, admin sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins, salt []byte, label string) *MsgInstantiateContract2 {
+	var adminAddr string
+	if !admin.Empty() {
+		adminAddr = admin.String()
+	}
+
+	return &MsgInstantiateContract2{
+		Sender:    sender.String(),
+		Admin:     adminAddr,
+		CodeID:    codeID,
+		InitMsg:   initMsg,
+		InitCoins: initCoins,
+		Salt:      salt,
+		Label:     label,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgInstantiateContract2) Type() string {
+	return TypeMsgInstantiateContract2
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if err := ValidateSalt(msg.Salt); err != nil {
+		return err
+	}
+
+	return NewMsgInstantiateContractFrom2(msg).ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgInstantiateContractFrom2 returns the MsgInstantiateContract
+// sharing all the fields of the MsgInstantiateContract2 but the salt
+func NewMsgInstantiateContractFrom2(msg MsgInstantiateContract2) *MsgInstantiateContract {
+	return &MsgInstantiateContract{
+		Sender:    msg.Sender,
+		Admin:     msg.Admin,
+		CodeID:    msg.CodeID,
+		InitMsg:   msg.InitMsg,
+		InitCoins: msg.InitCoins,
+		Label:     msg.Label,
+	}
+}
+
+// ValidateSalt checks the salt used to derive a contract address
+func ValidateSalt(salt []byte) error {
+	switch n := len(salt); {
+	case n == 0:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "salt cannot be empty")
+	case n > MaxSaltSize:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt cannot be longer than %d bytes", MaxSaltSize)
+	}
+
+	return nil
+}
+
+// ValidateLabel checks the optional contract label
+func ValidateLabel(label string) error {
+	if len(label) > MaxLabelSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "label cannot be longer than %d bytes", MaxLabelSize)
+	}
+
+	return nil
+}
+
 // NewMsgExecuteContract creates a NewMsgExecuteContract instance
 func NewMsgExecuteContract(sender sdk.AccAddress, contract sdk.AccAddress, execMsg []byte, coins sdk.Coins) *MsgExecuteContract {
 	return &MsgExecuteContract{
@@ -418,3 +521,200 @@ func (msg MsgClearContractAdmin) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{owner}
 }
+
+// NewMsgRegisterCronJob creates a MsgRegisterCronJob instance
+func NewMsgRegisterCronJob(contract sdk.AccAddress, msg []byte, height int64, interval, gasLimit, gasBudget uint64) *MsgRegisterCronJob {
+	return &MsgRegisterCronJob{
+		Contract:  contract.String(),
+		Msg:       msg,
+		Height:    height,
+		Interval:  interval,
+		GasLimit:  gasLimit,
+		GasBudget: gasBudget,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRegisterCronJob) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgRegisterCronJob) Type() string {
+	return TypeMsgRegisterCronJob
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterCronJob) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if uint64(len(msg.Msg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	if !json.Valid(msg.Msg) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte format is invalid json")
+	}
+
+	if msg.Height <= 0 {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "height must be positive")
+	}
+
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "gas limit cannot be zero")
+	}
+
+	if msg.GasBudget < msg.GasLimit {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "gas budget cannot be smaller than the gas limit")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterCronJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterCronJob) GetSigners() []sdk.AccAddress {
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{contract}
+}
+
+// NewMsgCancelCronJob creates a MsgCancelCronJob instance
+func NewMsgCancelCronJob(contract sdk.AccAddress, jobID uint64) *MsgCancelCronJob {
+	return &MsgCancelCronJob{
+		Contract: contract.String(),
+		JobID:    jobID,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCancelCronJob) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgCancelCronJob) Type() string {
+	return TypeMsgCancelCronJob
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCancelCronJob) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if msg.JobID == 0 {
+		return sdkerrors.Wrap(ErrInvalidCronJob, "job id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCancelCronJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCancelCronJob) GetSigners() []sdk.AccAddress {
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{contract}
+}
+
+// NewMsgSetGasSponsorship creates a MsgSetGasSponsorship instance
+func NewMsgSetGasSponsorship(contract sdk.AccAddress, userLimit sdk.Coins, userPeriod uint64, blockLimit sdk.Coins) *MsgSetGasSponsorship {
+	return &MsgSetGasSponsorship{
+		Contract:   contract.String(),
+		UserLimit:  userLimit,
+		UserPeriod: userPeriod,
+		BlockLimit: blockLimit,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSetGasSponsorship) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgSetGasSponsorship) Type() string {
+	return TypeMsgSetGasSponsorship
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetGasSponsorship) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return validateGasSponsorshipLimits(msg.UserLimit, msg.BlockLimit)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSetGasSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetGasSponsorship) GetSigners() []sdk.AccAddress {
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{contract}
+}
+
+// NewMsgRemoveGasSponsorship creates a MsgRemoveGasSponsorship instance
+func NewMsgRemoveGasSponsorship(contract sdk.AccAddress) *MsgRemoveGasSponsorship {
+	return &MsgRemoveGasSponsorship{
+		Contract: contract.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRemoveGasSponsorship) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgRemoveGasSponsorship) Type() string {
+	return TypeMsgRemoveGasSponsorship
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveGasSponsorship) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRemoveGasSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveGasSponsorship) GetSigners() []sdk.AccAddress {
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{contract}
+}
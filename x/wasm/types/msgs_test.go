@@ -33,6 +33,13 @@ func TestMsgStoreCode(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgStoreCode(addrs[0], []byte{1, 2, 3})
+	msg.InstantiatePermission = &AccessConfig{Permission: AccessTypeOnlyAddresses, Addresses: []string{addrs[0].String()}}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.InstantiatePermission = &AccessConfig{Permission: AccessTypeOnlyAddresses}
+	require.Error(t, msg.ValidateBasic())
 }
 
 func TestMsgMigrateCode(t *testing.T) {
@@ -83,7 +90,43 @@ func TestMsgInstantiateCode(t *testing.T) {
 	}
 
 	for i, tc := range tests {
-		msg := NewMsgInstantiateContract(tc.creator, tc.admin, tc.codeID, tc.initMsg, tc.initCoins)
+		msg := NewMsgInstantiateContract(tc.creator, tc.admin, tc.codeID, tc.initMsg, tc.initCoins, "")
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	// label is optional, but bounded
+	require.Nil(t, NewMsgInstantiateContract(addrs[0], sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, "label").ValidateBasic())
+	require.NotNil(t, NewMsgInstantiateContract(addrs[0], sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, string(make([]byte, MaxLabelSize+1))).ValidateBasic())
+}
+
+func TestMsgInstantiateCode2(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	tests := []struct {
+		creator    sdk.AccAddress
+		admin      sdk.AccAddress
+		codeID     uint64
+		initMsg    []byte
+		initCoins  sdk.Coins
+		salt       []byte
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, []byte("salt"), false},
+		{addrs[0], sdk.AccAddress{}, 0, []byte("{invalid json}"), sdk.Coins{}, []byte("salt"), false},
+		{addrs[0], sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, nil, false},
+		{addrs[0], sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, make([]byte, MaxSaltSize+1), false},
+		{addrs[0], sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, make([]byte, MaxSaltSize), true},
+		{addrs[0], sdk.AccAddress{}, 0, []byte("{}"), sdk.Coins{}, []byte("salt"), true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgInstantiateContract2(tc.creator, tc.admin, tc.codeID, tc.initMsg, tc.initCoins, tc.salt, "")
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -209,3 +252,87 @@ func TestMsgClearContractMigratable(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRegisterCronJob(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	tests := []struct {
+		contract   sdk.AccAddress
+		msg        []byte
+		height     int64
+		gasLimit   uint64
+		gasBudget  uint64
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, []byte("{}"), 10, 100, 100, false},
+		{contract, []byte("invalid"), 10, 100, 100, false},
+		{contract, []byte("{}"), 0, 100, 100, false},
+		{contract, []byte("{}"), 10, 0, 100, false},
+		{contract, []byte("{}"), 10, 100, 99, false},
+		{contract, []byte("{}"), 10, 100, 100, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRegisterCronJob(tc.contract, tc.msg, tc.height, 0, tc.gasLimit, tc.gasBudget)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCancelCronJob(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	tests := []struct {
+		contract   sdk.AccAddress
+		jobID      uint64
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, 1, false},
+		{contract, 0, false},
+		{contract, 1, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelCronJob(tc.contract, tc.jobID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSetGasSponsorship(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	limit := sdk.NewCoins(sdk.NewInt64Coin("ubiq", 1000))
+
+	tests := []struct {
+		contract   sdk.AccAddress
+		userLimit  sdk.Coins
+		blockLimit sdk.Coins
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, limit, limit, false},
+		{contract, sdk.Coins{}, limit, false},
+		{contract, limit, sdk.Coins{}, false},
+		{contract, sdk.Coins{sdk.Coin{Denom: "ubiq", Amount: sdk.NewInt(-1)}}, limit, false},
+		{contract, limit, limit, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetGasSponsorship(tc.contract, tc.userLimit, 10, tc.blockLimit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRemoveGasSponsorship(t *testing.T) {
+	require.NotNil(t, NewMsgRemoveGasSponsorship(sdk.AccAddress{}).ValidateBasic())
+	require.Nil(t, NewMsgRemoveGasSponsorship(sdk.AccAddress([]byte("contract____________"))).ValidateBasic())
+}
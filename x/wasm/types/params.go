@@ -5,7 +5,10 @@ import (
 
 	"gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	core "github.com/bitwebs/iq-core/types"
 )
 
 // Max params for static check
@@ -20,6 +23,17 @@ var (
 	KeyMaxContractSize    = []byte("MaxContractSize")
 	KeyMaxContractGas     = []byte("MaxContractGas")
 	KeyMaxContractMsgSize = []byte("MaxContractMsgSize")
+	KeyCodeUploadAccess   = []byte("CodeUploadAccess")
+	KeyCronGasPrice       = []byte("CronGasPrice")
+	KeyMaxCronGasPerBlock = []byte("MaxCronGasPerBlock")
+	KeyStorageRentPrice   = []byte("StorageRentPrice")
+	KeyStorageRentPeriod  = []byte("StorageRentPeriod")
+)
+
+// Default parameter values
+var (
+	DefaultCronGasPrice     = sdk.NewDecCoinFromDec(core.MicroBiqDenom, sdk.NewDecWithPrec(15, 2)) // 0.15ubiq
+	DefaultStorageRentPrice = sdk.NewDecCoinFromDec(core.MicroBiqDenom, sdk.NewDecWithPrec(1, 3))  // 0.001ubiq
 )
 
 // Default parameter values
@@ -27,6 +41,8 @@ const (
 	DefaultMaxContractSize    = uint64(600 * 1024) // 600 KB
 	DefaultMaxContractGas     = uint64(20_000_000) // 20,000,000
 	DefaultMaxContractMsgSize = uint64(4 * 1024)   // 4KB
+	DefaultMaxCronGasPerBlock = uint64(20_000_000) // 20,000,000
+	DefaultStorageRentPeriod  = uint64(0)          // disabled
 
 	// ContractMemoryLimit is the memory limit of each contract execution (in MiB)
 	// constant value so all nodes run with the same limit.
@@ -41,6 +57,11 @@ func DefaultParams() Params {
 		MaxContractSize:    DefaultMaxContractSize,
 		MaxContractGas:     DefaultMaxContractGas,
 		MaxContractMsgSize: DefaultMaxContractMsgSize,
+		CodeUploadAccess:   AllowEverybody,
+		CronGasPrice:       DefaultCronGasPrice,
+		MaxCronGasPerBlock: DefaultMaxCronGasPerBlock,
+		StorageRentPrice:   DefaultStorageRentPrice,
+		StorageRentPeriod:  DefaultStorageRentPeriod,
 	}
 }
 
@@ -52,6 +73,11 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMaxContractSize, &p.MaxContractSize, validateMaxContractSize),
 		paramstypes.NewParamSetPair(KeyMaxContractGas, &p.MaxContractGas, validateMaxContractGas),
 		paramstypes.NewParamSetPair(KeyMaxContractMsgSize, &p.MaxContractMsgSize, validateMaxContractMsgSize),
+		paramstypes.NewParamSetPair(KeyCodeUploadAccess, &p.CodeUploadAccess, validateCodeUploadAccess),
+		paramstypes.NewParamSetPair(KeyCronGasPrice, &p.CronGasPrice, validateCronGasPrice),
+		paramstypes.NewParamSetPair(KeyMaxCronGasPerBlock, &p.MaxCronGasPerBlock, validateMaxCronGasPerBlock),
+		paramstypes.NewParamSetPair(KeyStorageRentPrice, &p.StorageRentPrice, validateStorageRentPrice),
+		paramstypes.NewParamSetPair(KeyStorageRentPeriod, &p.StorageRentPeriod, validateStorageRentPeriod),
 	}
 }
 
@@ -80,6 +106,22 @@ func (p Params) Validate() error {
 		return fmt.Errorf("max contract msg byte size %d must be equal or smaller than %d", p.MaxContractMsgSize, EnforcedMaxContractMsgSize)
 	}
 
+	if err := p.CodeUploadAccess.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid code upload access: %s", err)
+	}
+
+	if err := validateCronGasPrice(p.CronGasPrice); err != nil {
+		return err
+	}
+
+	if p.MaxCronGasPerBlock > EnforcedMaxContractGas {
+		return fmt.Errorf("max cron gas per block %d must be equal or smaller than %d", p.MaxCronGasPerBlock, EnforcedMaxContractGas)
+	}
+
+	if err := p.StorageRentPrice.Validate(); err != nil {
+		return fmt.Errorf("invalid storage rent price: %s", err)
+	}
+
 	return nil
 }
 
@@ -121,3 +163,69 @@ func validateMaxContractMsgSize(i interface{}) error {
 
 	return nil
 }
+
+func validateCodeUploadAccess(i interface{}) error {
+	v, ok := i.(AccessConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid code upload access: %s", err)
+	}
+
+	return nil
+}
+
+func validateCronGasPrice(i interface{}) error {
+	v, ok := i.(sdk.DecCoin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid cron gas price: %s", err)
+	}
+
+	// a zero price would make the cron jobs free to register and keep
+	if !v.IsPositive() {
+		return fmt.Errorf("cron gas price must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxCronGasPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > EnforcedMaxContractGas {
+		return fmt.Errorf("max cron gas per block %d must be equal or smaller than %d", v, EnforcedMaxContractGas)
+	}
+
+	return nil
+}
+
+func validateStorageRentPrice(i interface{}) error {
+	v, ok := i.(sdk.DecCoin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid storage rent price: %s", err)
+	}
+
+	return nil
+}
+
+func validateStorageRentPeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
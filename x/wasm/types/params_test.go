@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParams(t *testing.T) {
@@ -21,4 +23,61 @@ func TestParams(t *testing.T) {
 	params = DefaultParams()
 	params.MaxContractSize = EnforcedMaxContractSize + 1
 	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxCronGasPerBlock = EnforcedMaxContractGas + 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.CronGasPrice = sdk.DecCoin{Denom: "ubiq", Amount: sdk.NewDec(-1)}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.CronGasPrice = sdk.NewDecCoin("ubiq", sdk.ZeroInt())
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StorageRentPrice = sdk.DecCoin{Denom: "ubiq", Amount: sdk.NewDec(-1)}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StorageRentPeriod = 100
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsCodeUploadAccess(t *testing.T) {
+	params := DefaultParams()
+	params.CodeUploadAccess = AllowNobody
+	require.NoError(t, params.Validate())
+
+	params.CodeUploadAccess = AccessConfig{}
+	require.Error(t, params.Validate())
+
+	params.CodeUploadAccess = AccessConfig{Permission: AccessTypeOnlyAddresses}
+	require.Error(t, params.Validate())
+}
+
+func TestAccessConfig(t *testing.T) {
+	_, _, addr1 := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	require.True(t, AllowEverybody.Allowed(addr1))
+	require.False(t, AllowNobody.Allowed(addr1))
+
+	onlyAddr1 := NewOnlyAddressesAccessConfig(addr1)
+	require.NoError(t, onlyAddr1.ValidateBasic())
+	require.True(t, onlyAddr1.Allowed(addr1))
+	require.False(t, onlyAddr1.Allowed(addr2))
+
+	// duplicate addresses
+	require.Error(t, NewOnlyAddressesAccessConfig(addr1, addr1).ValidateBasic())
+
+	// invalid address
+	require.Error(t, AccessConfig{Permission: AccessTypeOnlyAddresses, Addresses: []string{"invalid"}}.ValidateBasic())
+
+	// addresses given with everybody
+	require.Error(t, AccessConfig{Permission: AccessTypeEverybody, Addresses: []string{addr1.String()}}.ValidateBasic())
+
+	// unspecified
+	require.Error(t, AccessConfig{}.ValidateBasic())
 }
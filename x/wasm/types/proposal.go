@@ -0,0 +1,437 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// wasm proposal types
+const (
+	ProposalTypeStoreCode           = "StoreCode"
+	ProposalTypeInstantiateContract = "InstantiateContract"
+	ProposalTypeMigrateContract     = "MigrateContract"
+	ProposalTypeUpdateAdmin         = "UpdateAdmin"
+	ProposalTypePinCodes            = "PinCodes"
+	ProposalTypeUnpinCodes          = "UnpinCodes"
+	ProposalTypeSudoContract        = "SudoContract"
+	ProposalTypeFreezeContract      = "FreezeContract"
+	ProposalTypeUnfreezeContract    = "UnfreezeContract"
+)
+
+// ensure Content interface compliance at compile time
+var (
+	_ govtypes.Content = &StoreCodeProposal{}
+	_ govtypes.Content = &InstantiateContractProposal{}
+	_ govtypes.Content = &MigrateContractProposal{}
+	_ govtypes.Content = &UpdateAdminProposal{}
+	_ govtypes.Content = &PinCodesProposal{}
+	_ govtypes.Content = &UnpinCodesProposal{}
+	_ govtypes.Content = &SudoContractProposal{}
+	_ govtypes.Content = &FreezeContractProposal{}
+	_ govtypes.Content = &UnfreezeContractProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeStoreCode)
+	govtypes.RegisterProposalType(ProposalTypeInstantiateContract)
+	govtypes.RegisterProposalType(ProposalTypeMigrateContract)
+	govtypes.RegisterProposalType(ProposalTypeUpdateAdmin)
+	govtypes.RegisterProposalType(ProposalTypePinCodes)
+	govtypes.RegisterProposalType(ProposalTypeUnpinCodes)
+	govtypes.RegisterProposalType(ProposalTypeSudoContract)
+	govtypes.RegisterProposalType(ProposalTypeFreezeContract)
+	govtypes.RegisterProposalType(ProposalTypeUnfreezeContract)
+}
+
+// GetTitle implements govtypes.Content
+func (p StoreCodeProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p StoreCodeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p StoreCodeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p StoreCodeProposal) ProposalType() string { return ProposalTypeStoreCode }
+
+// ValidateBasic implements govtypes.Content
+func (p StoreCodeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.RunAs); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid run as address (%s)", err)
+	}
+
+	if len(p.WASMByteCode) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty wasm code")
+	}
+
+	if uint64(len(p.WASMByteCode)) > EnforcedMaxContractSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm code too large")
+	}
+
+	if p.InstantiatePermission != nil {
+		if err := p.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p StoreCodeProposal) String() string {
+	return fmt.Sprintf(`Store Code Proposal:
+  Title:       %s
+  Description: %s
+  Run as:      %s
+  WasmCode:    %X
+`, p.Title, p.Description, p.RunAs, p.WASMByteCode)
+}
+
+// GetTitle implements govtypes.Content
+func (p InstantiateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p InstantiateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p InstantiateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p InstantiateContractProposal) ProposalType() string {
+	return ProposalTypeInstantiateContract
+}
+
+// ValidateBasic implements govtypes.Content
+func (p InstantiateContractProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.RunAs); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid run as address (%s)", err)
+	}
+
+	if len(p.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", err)
+		}
+	}
+
+	if !p.InitCoins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, p.InitCoins.String())
+	}
+
+	if uint64(len(p.InitMsg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	if !json.Valid(p.InitMsg) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg must be a json")
+	}
+
+	return ValidateLabel(p.Label)
+}
+
+// String implements fmt.Stringer
+func (p InstantiateContractProposal) String() string {
+	return fmt.Sprintf(`Instantiate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Run as:      %s
+  Admin:       %s
+  Code id:     %d
+  Label:       %s
+  Init Msg:    %q
+  Init Coins:  %s
+`, p.Title, p.Description, p.RunAs, p.Admin, p.CodeID, p.Label, p.InitMsg, p.InitCoins)
+}
+
+// GetTitle implements govtypes.Content
+func (p MigrateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p MigrateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p MigrateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p MigrateContractProposal) ProposalType() string { return ProposalTypeMigrateContract }
+
+// ValidateBasic implements govtypes.Content
+func (p MigrateContractProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if p.NewCodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing new_code_id")
+	}
+
+	if uint64(len(p.MigrateMsg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	if !json.Valid(p.MigrateMsg) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg must be a json")
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p MigrateContractProposal) String() string {
+	return fmt.Sprintf(`Migrate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Code id:     %d
+  Migrate Msg: %q
+`, p.Title, p.Description, p.Contract, p.NewCodeID, p.MigrateMsg)
+}
+
+// GetTitle implements govtypes.Content
+func (p UpdateAdminProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p UpdateAdminProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p UpdateAdminProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p UpdateAdminProposal) ProposalType() string { return ProposalTypeUpdateAdmin }
+
+// ValidateBasic implements govtypes.Content
+func (p UpdateAdminProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	// an empty new admin clears the admin of the contract
+	if len(p.NewAdmin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.NewAdmin); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new admin address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p UpdateAdminProposal) String() string {
+	return fmt.Sprintf(`Update Contract Admin Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  New Admin:   %s
+`, p.Title, p.Description, p.Contract, p.NewAdmin)
+}
+
+// GetTitle implements govtypes.Content
+func (p PinCodesProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p PinCodesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p PinCodesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p PinCodesProposal) ProposalType() string { return ProposalTypePinCodes }
+
+// ValidateBasic implements govtypes.Content
+func (p PinCodesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validateCodeIDs(p.CodeIDs)
+}
+
+// String implements fmt.Stringer
+func (p PinCodesProposal) String() string {
+	return fmt.Sprintf(`Pin Codes Proposal:
+  Title:       %s
+  Description: %s
+  Codes:       %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+// GetTitle implements govtypes.Content
+func (p UnpinCodesProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p UnpinCodesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p UnpinCodesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p UnpinCodesProposal) ProposalType() string { return ProposalTypeUnpinCodes }
+
+// ValidateBasic implements govtypes.Content
+func (p UnpinCodesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validateCodeIDs(p.CodeIDs)
+}
+
+// String implements fmt.Stringer
+func (p UnpinCodesProposal) String() string {
+	return fmt.Sprintf(`Unpin Codes Proposal:
+  Title:       %s
+  Description: %s
+  Codes:       %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+// GetTitle implements govtypes.Content
+func (p SudoContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p SudoContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p SudoContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p SudoContractProposal) ProposalType() string { return ProposalTypeSudoContract }
+
+// ValidateBasic implements govtypes.Content
+func (p SudoContractProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if uint64(len(p.Msg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	if !json.Valid(p.Msg) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg must be a json")
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p SudoContractProposal) String() string {
+	return fmt.Sprintf(`Sudo Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Msg:         %q
+`, p.Title, p.Description, p.Contract, p.Msg)
+}
+
+// GetTitle implements govtypes.Content
+func (p FreezeContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p FreezeContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p FreezeContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p FreezeContractProposal) ProposalType() string { return ProposalTypeFreezeContract }
+
+// ValidateBasic implements govtypes.Content
+func (p FreezeContractProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p FreezeContractProposal) String() string {
+	return fmt.Sprintf(`Freeze Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+// GetTitle implements govtypes.Content
+func (p UnfreezeContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p UnfreezeContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p UnfreezeContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p UnfreezeContractProposal) ProposalType() string { return ProposalTypeUnfreezeContract }
+
+// ValidateBasic implements govtypes.Content
+func (p UnfreezeContractProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p UnfreezeContractProposal) String() string {
+	return fmt.Sprintf(`Unfreeze Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+func validateCodeIDs(codeIDs []uint64) error {
+	if len(codeIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code ids cannot be empty")
+	}
+
+	seen := make(map[uint64]bool, len(codeIDs))
+	for _, codeID := range codeIDs {
+		if codeID == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id cannot be zero")
+		}
+
+		if seen[codeID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate code id %d", codeID)
+		}
+		seen[codeID] = true
+	}
+
+	return nil
+}
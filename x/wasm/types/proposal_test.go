@@ -0,0 +1,121 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStoreCodeProposal(t *testing.T) {
+	runAs := sdk.AccAddress([]byte("addr1_______________"))
+
+	p := StoreCodeProposal{Title: "title", Description: "desc", RunAs: runAs.String(), WASMByteCode: []byte{1, 2, 3}}
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, RouterKey, p.ProposalRoute())
+	require.Equal(t, ProposalTypeStoreCode, p.ProposalType())
+
+	p.InstantiatePermission = &AllowNobody
+	require.NoError(t, p.ValidateBasic())
+
+	p.InstantiatePermission = &AccessConfig{}
+	require.Error(t, p.ValidateBasic())
+
+	p = StoreCodeProposal{Title: "title", Description: "desc", RunAs: runAs.String()}
+	require.Error(t, p.ValidateBasic())
+
+	p = StoreCodeProposal{Title: "", Description: "desc", RunAs: runAs.String(), WASMByteCode: []byte{1, 2, 3}}
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestInstantiateContractProposal(t *testing.T) {
+	runAs := sdk.AccAddress([]byte("addr1_______________"))
+
+	p := InstantiateContractProposal{
+		Title:       "title",
+		Description: "desc",
+		RunAs:       runAs.String(),
+		CodeID:      1,
+		InitMsg:     []byte("{}"),
+		InitCoins:   sdk.NewCoins(sdk.NewInt64Coin("ubiq", 1)),
+		Label:       "label",
+	}
+	require.NoError(t, p.ValidateBasic())
+
+	p.Admin = "invalid"
+	require.Error(t, p.ValidateBasic())
+
+	p.Admin = runAs.String()
+	p.InitMsg = []byte("invalid")
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestMigrateContractProposal(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	p := MigrateContractProposal{Title: "title", Description: "desc", Contract: contract.String(), NewCodeID: 1, MigrateMsg: []byte("{}")}
+	require.NoError(t, p.ValidateBasic())
+
+	p.NewCodeID = 0
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestUpdateAdminProposal(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	admin := sdk.AccAddress([]byte("admin_______________"))
+
+	p := UpdateAdminProposal{Title: "title", Description: "desc", Contract: contract.String(), NewAdmin: admin.String()}
+	require.NoError(t, p.ValidateBasic())
+
+	// clear admin
+	p.NewAdmin = ""
+	require.NoError(t, p.ValidateBasic())
+
+	p.Contract = ""
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestPinCodesProposal(t *testing.T) {
+	p := PinCodesProposal{Title: "title", Description: "desc", CodeIDs: []uint64{1, 2}}
+	require.NoError(t, p.ValidateBasic())
+
+	p.CodeIDs = nil
+	require.Error(t, p.ValidateBasic())
+
+	p.CodeIDs = []uint64{1, 1}
+	require.Error(t, p.ValidateBasic())
+
+	u := UnpinCodesProposal{Title: "title", Description: "desc", CodeIDs: []uint64{0}}
+	require.Error(t, u.ValidateBasic())
+}
+
+func TestSudoContractProposal(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	p := SudoContractProposal{Title: "title", Description: "desc", Contract: contract.String(), Msg: []byte(`{"foo":{}}`)}
+	require.NoError(t, p.ValidateBasic())
+
+	p.Msg = []byte("invalid")
+	require.Error(t, p.ValidateBasic())
+
+	p.Msg = []byte("{}")
+	p.Contract = ""
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestFreezeContractProposal(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	p := FreezeContractProposal{Title: "title", Description: "desc", Contract: contract.String()}
+	require.NoError(t, p.ValidateBasic())
+
+	p.Contract = ""
+	require.Error(t, p.ValidateBasic())
+
+	u := UnfreezeContractProposal{Title: "title", Description: "desc", Contract: contract.String()}
+	require.NoError(t, u.ValidateBasic())
+
+	u.Title = ""
+	require.Error(t, u.ValidateBasic())
+}
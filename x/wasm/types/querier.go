@@ -58,3 +58,22 @@ type QueryContractParams struct {
 func NewQueryContractParams(contractAddress sdk.AccAddress, msg []byte) QueryContractParams {
 	return QueryContractParams{contractAddress, msg}
 }
+
+// NewBalanceChange returns the BalanceChange of the account,
+// splitting the balance difference into the received and the spent coins
+func NewBalanceChange(address sdk.AccAddress, before, after sdk.Coins) BalanceChange {
+	change := BalanceChange{Address: address.String()}
+	for _, coin := range after {
+		if diff := coin.Amount.Sub(before.AmountOf(coin.Denom)); diff.IsPositive() {
+			change.Received = append(change.Received, sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	for _, coin := range before {
+		if diff := coin.Amount.Sub(after.AmountOf(coin.Denom)); diff.IsPositive() {
+			change.Spent = append(change.Spent, sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return change
+}
@@ -20,12 +20,19 @@ type StargateWasmQuerierInterface interface {
 	Query(ctx sdk.Context, request wasmvmtypes.QueryRequest) ([]byte, error)
 }
 
+// IBCWasmQuerierInterface - ibc query interface, which requires
+// the querying contract to resolve its port
+type IBCWasmQuerierInterface interface {
+	Query(ctx sdk.Context, contractAddr sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error)
+}
+
 // Querier - wasm query handler
 type Querier struct {
 	Ctx             sdk.Context
 	ContractAddr    sdk.AccAddress
 	Queriers        map[string]WasmQuerierInterface
 	StargateQuerier StargateWasmQuerierInterface
+	IBCQuerier      IBCWasmQuerierInterface
 }
 
 // NewWasmQuerier return wasm querier
@@ -45,12 +52,14 @@ var _ wasmvmtypes.Querier = Querier{}
 
 // Routes of pre-determined wasm querier
 const (
-	WasmQueryRouteBank     = "bank"
-	WasmQueryRouteStaking  = "staking"
-	WasmQueryRouteMarket   = "market"
-	WasmQueryRouteOracle   = "oracle"
-	WasmQueryRouteTreasury = "treasury"
-	WasmQueryRouteWasm     = "wasm"
+	WasmQueryRouteBank         = "bank"
+	WasmQueryRouteStaking      = "staking"
+	WasmQueryRouteMarket       = "market"
+	WasmQueryRouteOracle       = "oracle"
+	WasmQueryRouteTreasury     = "treasury"
+	WasmQueryRouteGov          = "gov"
+	WasmQueryRouteTokenFactory = "tokenfactory"
+	WasmQueryRouteWasm         = "wasm"
 )
 
 // WithCtx returns new querier with context
@@ -121,7 +130,11 @@ func (q Querier) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) ([]byt
 
 		return nil, sdkerrors.Wrap(ErrNoRegisteredQuerier, "stargate")
 	case request.IBC != nil:
-		return nil, sdkerrors.Wrap(ErrNoRegisteredQuerier, "IBC not supported")
+		if q.IBCQuerier != nil {
+			return q.IBCQuerier.Query(ctx, q.ContractAddr, request)
+		}
+
+		return nil, sdkerrors.Wrap(ErrNoRegisteredQuerier, "ibc")
 	}
 
 	return nil, wasmvmtypes.Unknown{}
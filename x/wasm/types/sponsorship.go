@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGasSponsorship creates a new GasSponsorship instance
+func NewGasSponsorship(contract sdk.AccAddress, userLimit sdk.Coins, userPeriod uint64, blockLimit sdk.Coins) GasSponsorship {
+	return GasSponsorship{
+		Contract:   contract.String(),
+		UserLimit:  userLimit,
+		UserPeriod: userPeriod,
+		BlockLimit: blockLimit,
+	}
+}
+
+// ContractAddress returns the address of the sponsoring contract;
+// it panics on an invalid address, which is rejected on the registration
+func (sponsorship GasSponsorship) ContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(sponsorship.Contract)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// ValidateBasic checks the gas sponsorship
+func (sponsorship GasSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(sponsorship.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return validateGasSponsorshipLimits(sponsorship.UserLimit, sponsorship.BlockLimit)
+}
+
+func validateGasSponsorshipLimits(userLimit, blockLimit sdk.Coins) error {
+	if !userLimit.IsValid() || userLimit.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidGasSponsorship, "user limit must be positive coins (%s)", userLimit)
+	}
+
+	if !blockLimit.IsValid() || blockLimit.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidGasSponsorship, "block limit must be positive coins (%s)", blockLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StargateQueryEncoding is the encoding of the stargate query response returned to the contract
+type StargateQueryEncoding int
+
+// Encodings of the stargate query response
+const (
+	// StargateQueryEncodingProto returns the response as protobuf bytes
+	StargateQueryEncodingProto StargateQueryEncoding = iota
+	// StargateQueryEncodingJSON returns the response as proto3 JSON bytes
+	StargateQueryEncodingJSON
+)
+
+// DefaultStargateQueryGasCost is the sdk gas charged for a stargate query on top of the store gas
+const DefaultStargateQueryGasCost = uint64(1_000)
+
+// StargateQuery is the config of a stargate query path allowed from the contracts;
+// the response is decoded to the response type and re-encoded with the encoding,
+// so the bytes returned to the contract don't depend on the encoding of the query server
+type StargateQuery struct {
+	// NewResponse returns the empty response message of the query
+	NewResponse func() codec.ProtoMarshaler
+	// GasCost is the sdk gas charged for the query
+	GasCost sdk.Gas
+	// Encoding is the encoding of the response returned to the contract
+	Encoding StargateQueryEncoding
+}
+
+// NewStargateQuery returns the stargate query with the default gas cost and the protobuf encoding
+func NewStargateQuery(newResponse func() codec.ProtoMarshaler) StargateQuery {
+	return StargateQuery{
+		NewResponse: newResponse,
+		GasCost:     DefaultStargateQueryGasCost,
+		Encoding:    StargateQueryEncodingProto,
+	}
+}
+
+// WithGasCost returns the stargate query with the gas cost
+func (q StargateQuery) WithGasCost(gasCost sdk.Gas) StargateQuery {
+	q.GasCost = gasCost
+	return q
+}
+
+// WithJSONEncoding returns the stargate query returning the response as JSON
+func (q StargateQuery) WithJSONEncoding() StargateQuery {
+	q.Encoding = StargateQueryEncodingJSON
+	return q
+}
+
+// StargateQueryAllowlist maps the stargate query paths allowed from the contracts to their configs;
+// the queries must be deterministic and bounded, as they are executed in the consensus
+type StargateQueryAllowlist map[string]StargateQuery
+
+// Add adds the query path to the allowlist, replacing the existing config of the path
+func (l StargateQueryAllowlist) Add(path string, query StargateQuery) StargateQueryAllowlist {
+	l[path] = query
+	return l
+}
+
+// Remove removes the query path from the allowlist
+func (l StargateQueryAllowlist) Remove(path string) StargateQueryAllowlist {
+	delete(l, path)
+	return l
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Contract call names recorded in the contract traces
+const (
+	TraceCallInstantiate = "instantiate"
+	TraceCallExecute     = "execute"
+	TraceCallReply       = "reply"
+	TraceCallSudo        = "sudo"
+	TraceCallQuery       = "query"
+)
+
+// ContractTrace is the record of a contract call written in the contract tracing mode
+type ContractTrace struct {
+	Height   int64  `json:"height"`
+	TxHash   string `json:"tx_hash,omitempty"`
+	Call     string `json:"call"`
+	Contract string `json:"contract"`
+
+	// Depth is the depth of the call in the submessage tree,
+	// the call of the tx msg is at depth 0
+	Depth uint32 `json:"depth"`
+
+	// WasmVMGas is the gas used by the wasmvm, converted to sdk gas
+	WasmVMGas uint64 `json:"wasmvm_gas"`
+
+	// StoreGas is the sdk gas consumed by the contract store accesses
+	StoreGas    uint64 `json:"store_gas"`
+	StoreReads  uint64 `json:"store_reads"`
+	StoreWrites uint64 `json:"store_writes"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// GetSubMsgDepth returns the depth of the submessage tree the ctx is running at
+func GetSubMsgDepth(ctx sdk.Context) uint32 {
+	if depth := ctx.Context().Value(WasmVMSubMsgDepthContextKey); depth != nil {
+		return depth.(uint32)
+	}
+
+	return 0
+}
+
+// WithIncreasedSubMsgDepth returns the ctx to dispatch the submessages with
+func WithIncreasedSubMsgDepth(ctx sdk.Context) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), WasmVMSubMsgDepthContextKey, GetSubMsgDepth(ctx)+1))
+}
+
+var _ types.KVStore = &TracingStore{}
+
+// TracingStore counts the reads and the writes of the parent store
+// and the gas consumed by them to the trace
+type TracingStore struct {
+	types.KVStore
+
+	gasMeter types.GasMeter
+	trace    *ContractTrace
+}
+
+// NewTracingStore returns the store recording the accesses to the parent store to the trace
+func NewTracingStore(parent types.KVStore, gasMeter types.GasMeter, trace *ContractTrace) *TracingStore {
+	return &TracingStore{
+		KVStore:  parent,
+		gasMeter: gasMeter,
+		trace:    trace,
+	}
+}
+
+// recordGas adds the gas consumed since the given amount to the trace
+func (ts *TracingStore) recordGas(consumedBefore types.Gas) {
+	ts.trace.StoreGas += ts.gasMeter.GasConsumed() - consumedBefore
+}
+
+// Get implements KVStore.
+func (ts *TracingStore) Get(key []byte) []byte {
+	defer ts.recordGas(ts.gasMeter.GasConsumed())
+	ts.trace.StoreReads++
+	return ts.KVStore.Get(key)
+}
+
+// Has implements KVStore.
+func (ts *TracingStore) Has(key []byte) bool {
+	defer ts.recordGas(ts.gasMeter.GasConsumed())
+	ts.trace.StoreReads++
+	return ts.KVStore.Has(key)
+}
+
+// Set implements KVStore.
+func (ts *TracingStore) Set(key, value []byte) {
+	defer ts.recordGas(ts.gasMeter.GasConsumed())
+	ts.trace.StoreWrites++
+	ts.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (ts *TracingStore) Delete(key []byte) {
+	defer ts.recordGas(ts.gasMeter.GasConsumed())
+	ts.trace.StoreWrites++
+	ts.KVStore.Delete(key)
+}
+
+// Iterator implements KVStore. Each iteration step is counted as a read.
+func (ts *TracingStore) Iterator(start, end []byte) types.Iterator {
+	defer ts.recordGas(ts.gasMeter.GasConsumed())
+	ts.trace.StoreReads++
+	return &tracingIterator{Iterator: ts.KVStore.Iterator(start, end), store: ts}
+}
+
+// ReverseIterator implements KVStore. Each iteration step is counted as a read.
+func (ts *TracingStore) ReverseIterator(start, end []byte) types.Iterator {
+	defer ts.recordGas(ts.gasMeter.GasConsumed())
+	ts.trace.StoreReads++
+	return &tracingIterator{Iterator: ts.KVStore.ReverseIterator(start, end), store: ts}
+}
+
+type tracingIterator struct {
+	types.Iterator
+
+	store *TracingStore
+}
+
+// Next implements Iterator.
+func (ti *tracingIterator) Next() {
+	defer ti.store.recordGas(ti.store.gasMeter.GasConsumed())
+	ti.store.trace.StoreReads++
+	ti.Iterator.Next()
+}
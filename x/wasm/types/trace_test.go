@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTracingStore(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(100000)
+	trace := &ContractTrace{}
+	st := NewTracingStore(NewStore(mem, meter, types.KVGasConfig()), meter, trace)
+
+	st.Set(keyFmt(1), valFmt(1))
+	st.Set(keyFmt(2), valFmt(2))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	require.True(t, st.Has(keyFmt(2)))
+	st.Delete(keyFmt(2))
+
+	iterator := st.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+	}
+	require.NoError(t, iterator.Close())
+
+	// 3 writes, 2 reads and the iterator seek and step
+	require.Equal(t, uint64(3), trace.StoreWrites)
+	require.Equal(t, uint64(4), trace.StoreReads)
+	require.Equal(t, meter.GasConsumed(), trace.StoreGas)
+}
+
+func TestSubMsgDepth(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	require.Equal(t, uint32(0), GetSubMsgDepth(ctx))
+
+	ctx = WithIncreasedSubMsgDepth(WithIncreasedSubMsgDepth(ctx))
+	require.Equal(t, uint32(2), GetSubMsgDepth(ctx))
+}
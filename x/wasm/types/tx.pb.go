@@ -38,6 +38,9 @@ type MsgStoreCode struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	// WASMByteCode can be raw or gzip compressed
 	WASMByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty" yaml:"wasm_byte_code"`
+	// InstantiatePermission is the optional permission to instantiate contracts
+	// of the code; everybody is allowed when it is not given
+	InstantiatePermission *AccessConfig `protobuf:"bytes,3,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
 }
 
 func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
@@ -214,6 +217,8 @@ type MsgInstantiateContract struct {
 	InitMsg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty" yaml:"init_msg"`
 	// InitCoins that are transferred to the contract on execution
 	InitCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=init_coins,json=initCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"init_coins" yaml:"init_coins"`
+	// Label is optional metadata to be stored with the contract instance
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty" yaml:"label"`
 }
 
 func (m *MsgInstantiateContract) Reset()         { *m = MsgInstantiateContract{} }
@@ -304,6 +309,114 @@ func (m *MsgInstantiateContractResponse) GetData() []byte {
 	return nil
 }
 
+// MsgInstantiateContract2 represents a message to create
+// a new smart contract instance for the given code id
+// at an address derived from the code hash, the sender and the salt.
+type MsgInstantiateContract2 struct {
+	// Sender is an sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Admin is an optional admin address who can migrate the contract
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// InitMsg json encoded message to be passed to the contract on instantiation
+	InitMsg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty" yaml:"init_msg"`
+	// InitCoins that are transferred to the contract on execution
+	InitCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=init_coins,json=initCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"init_coins" yaml:"init_coins"`
+	// Salt is an arbitrary value provided by the sender to derive the contract address
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	// Label is optional metadata to be stored with the contract instance
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty" yaml:"label"`
+}
+
+func (m *MsgInstantiateContract2) Reset()         { *m = MsgInstantiateContract2{} }
+func (m *MsgInstantiateContract2) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract2) ProtoMessage()    {}
+func (*MsgInstantiateContract2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{6}
+}
+func (m *MsgInstantiateContract2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContract2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContract2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateContract2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract2.Merge(m, src)
+}
+func (m *MsgInstantiateContract2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContract2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateContract2 proto.InternalMessageInfo
+
+// MsgInstantiateContract2Response defines the Msg/InstantiateContract2 response type.
+type MsgInstantiateContract2Response struct {
+	// ContractAddress is the bech32 address of the new contract instance.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// Data contains base64-encoded bytes to returned from the contract
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+}
+
+func (m *MsgInstantiateContract2Response) Reset()         { *m = MsgInstantiateContract2Response{} }
+func (m *MsgInstantiateContract2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract2Response) ProtoMessage()    {}
+func (*MsgInstantiateContract2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{7}
+}
+func (m *MsgInstantiateContract2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContract2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContract2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateContract2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract2Response.Merge(m, src)
+}
+func (m *MsgInstantiateContract2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContract2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateContract2Response proto.InternalMessageInfo
+
+func (m *MsgInstantiateContract2Response) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgInstantiateContract2Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // MsgExecuteContract represents a message to
 // submits the given message data to a smart contract.
 type MsgExecuteContract struct {
@@ -321,7 +434,7 @@ func (m *MsgExecuteContract) Reset()         { *m = MsgExecuteContract{} }
 func (m *MsgExecuteContract) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteContract) ProtoMessage()    {}
 func (*MsgExecuteContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{6}
+	return fileDescriptor_5834e4e1a84cce82, []int{8}
 }
 func (m *MsgExecuteContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +473,7 @@ func (m *MsgExecuteContractResponse) Reset()         { *m = MsgExecuteContractRe
 func (m *MsgExecuteContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteContractResponse) ProtoMessage()    {}
 func (*MsgExecuteContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{7}
+	return fileDescriptor_5834e4e1a84cce82, []int{9}
 }
 func (m *MsgExecuteContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +526,7 @@ func (m *MsgMigrateContract) Reset()         { *m = MsgMigrateContract{} }
 func (m *MsgMigrateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateContract) ProtoMessage()    {}
 func (*MsgMigrateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{8}
+	return fileDescriptor_5834e4e1a84cce82, []int{10}
 }
 func (m *MsgMigrateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +565,7 @@ func (m *MsgMigrateContractResponse) Reset()         { *m = MsgMigrateContractRe
 func (m *MsgMigrateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateContractResponse) ProtoMessage()    {}
 func (*MsgMigrateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{9}
+	return fileDescriptor_5834e4e1a84cce82, []int{11}
 }
 func (m *MsgMigrateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +616,7 @@ func (m *MsgUpdateContractAdmin) Reset()         { *m = MsgUpdateContractAdmin{}
 func (m *MsgUpdateContractAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateContractAdmin) ProtoMessage()    {}
 func (*MsgUpdateContractAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{10}
+	return fileDescriptor_5834e4e1a84cce82, []int{12}
 }
 func (m *MsgUpdateContractAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +653,7 @@ func (m *MsgUpdateContractAdminResponse) Reset()         { *m = MsgUpdateContrac
 func (m *MsgUpdateContractAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateContractAdminResponse) ProtoMessage()    {}
 func (*MsgUpdateContractAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{11}
+	return fileDescriptor_5834e4e1a84cce82, []int{13}
 }
 func (m *MsgUpdateContractAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +695,7 @@ func (m *MsgClearContractAdmin) Reset()         { *m = MsgClearContractAdmin{} }
 func (m *MsgClearContractAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgClearContractAdmin) ProtoMessage()    {}
 func (*MsgClearContractAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{12}
+	return fileDescriptor_5834e4e1a84cce82, []int{14}
 }
 func (m *MsgClearContractAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +732,7 @@ func (m *MsgClearContractAdminResponse) Reset()         { *m = MsgClearContractA
 func (m *MsgClearContractAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClearContractAdminResponse) ProtoMessage()    {}
 func (*MsgClearContractAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{13}
+	return fileDescriptor_5834e4e1a84cce82, []int{15}
 }
 func (m *MsgClearContractAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,6 +761,342 @@ func (m *MsgClearContractAdminResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClearContractAdminResponse proto.InternalMessageInfo
 
+// MsgRegisterCronJob represents a message to schedule
+// a callback of the contract, which must be the signer
+type MsgRegisterCronJob struct {
+	// Contract is the address of the contract registering the job
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Msg is the json encoded message passed to the sudo entry point of the contract
+	Msg encoding_json.RawMessage `protobuf:"bytes,2,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty" yaml:"msg"`
+	// Height is the future block height of the first execution
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// Interval is the number of blocks between the executions, zero for a one-time job
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// GasLimit is the maximum gas of each execution
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// GasBudget is the gas prepaid for all the executions, escrowed from the contract
+	GasBudget uint64 `protobuf:"varint,6,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty" yaml:"gas_budget"`
+}
+
+func (m *MsgRegisterCronJob) Reset()         { *m = MsgRegisterCronJob{} }
+func (m *MsgRegisterCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCronJob) ProtoMessage()    {}
+func (*MsgRegisterCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{16}
+}
+func (m *MsgRegisterCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCronJob.Merge(m, src)
+}
+func (m *MsgRegisterCronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCronJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCronJob proto.InternalMessageInfo
+
+// MsgRegisterCronJobResponse defines the Msg/RegisterCronJob response type.
+type MsgRegisterCronJobResponse struct {
+	// JobID is the id of the registered job
+	JobID uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty" yaml:"job_id"`
+}
+
+func (m *MsgRegisterCronJobResponse) Reset()         { *m = MsgRegisterCronJobResponse{} }
+func (m *MsgRegisterCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCronJobResponse) ProtoMessage()    {}
+func (*MsgRegisterCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{17}
+}
+func (m *MsgRegisterCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCronJobResponse.Merge(m, src)
+}
+func (m *MsgRegisterCronJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCronJobResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterCronJobResponse) GetJobID() uint64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+// MsgCancelCronJob represents a message to remove
+// a cron job of the contract, which must be the signer
+type MsgCancelCronJob struct {
+	// Contract is the address of the contract owning the job
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// JobID is the id of the job to cancel
+	JobID uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty" yaml:"job_id"`
+}
+
+func (m *MsgCancelCronJob) Reset()         { *m = MsgCancelCronJob{} }
+func (m *MsgCancelCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelCronJob) ProtoMessage()    {}
+func (*MsgCancelCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{18}
+}
+func (m *MsgCancelCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelCronJob.Merge(m, src)
+}
+func (m *MsgCancelCronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelCronJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelCronJob proto.InternalMessageInfo
+
+// MsgCancelCronJobResponse defines the Msg/CancelCronJob response type.
+type MsgCancelCronJobResponse struct {
+}
+
+func (m *MsgCancelCronJobResponse) Reset()         { *m = MsgCancelCronJobResponse{} }
+func (m *MsgCancelCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelCronJobResponse) ProtoMessage()    {}
+func (*MsgCancelCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{19}
+}
+func (m *MsgCancelCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelCronJobResponse.Merge(m, src)
+}
+func (m *MsgCancelCronJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelCronJobResponse proto.InternalMessageInfo
+
+// MsgSetGasSponsorship represents a message to make the contract,
+// which must be the signer, pay the fees of the txs executing it
+type MsgSetGasSponsorship struct {
+	// Contract is the address of the contract paying the fees
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// UserLimit is the maximum fee sponsored for a user within a user period
+	UserLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=user_limit,json=userLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_limit" yaml:"user_limit"`
+	// UserPeriod is the number of blocks after which the fee spent on a user is reset,
+	// zero to never reset it
+	UserPeriod uint64 `protobuf:"varint,3,opt,name=user_period,json=userPeriod,proto3" json:"user_period,omitempty" yaml:"user_period"`
+	// BlockLimit is the maximum fee sponsored by the contract within a block
+	BlockLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=block_limit,json=blockLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_limit" yaml:"block_limit"`
+}
+
+func (m *MsgSetGasSponsorship) Reset()         { *m = MsgSetGasSponsorship{} }
+func (m *MsgSetGasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasSponsorship) ProtoMessage()    {}
+func (*MsgSetGasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{20}
+}
+func (m *MsgSetGasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasSponsorship.Merge(m, src)
+}
+func (m *MsgSetGasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasSponsorship proto.InternalMessageInfo
+
+// MsgSetGasSponsorshipResponse defines the Msg/SetGasSponsorship response type.
+type MsgSetGasSponsorshipResponse struct {
+}
+
+func (m *MsgSetGasSponsorshipResponse) Reset()         { *m = MsgSetGasSponsorshipResponse{} }
+func (m *MsgSetGasSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetGasSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{21}
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasSponsorshipResponse proto.InternalMessageInfo
+
+// MsgRemoveGasSponsorship represents a message to stop the contract,
+// which must be the signer, paying the fees of the txs executing it
+type MsgRemoveGasSponsorship struct {
+	// Contract is the address of the contract paying the fees
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+}
+
+func (m *MsgRemoveGasSponsorship) Reset()         { *m = MsgRemoveGasSponsorship{} }
+func (m *MsgRemoveGasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGasSponsorship) ProtoMessage()    {}
+func (*MsgRemoveGasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{22}
+}
+func (m *MsgRemoveGasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGasSponsorship.Merge(m, src)
+}
+func (m *MsgRemoveGasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGasSponsorship proto.InternalMessageInfo
+
+// MsgRemoveGasSponsorshipResponse defines the Msg/RemoveGasSponsorship response type.
+type MsgRemoveGasSponsorshipResponse struct {
+}
+
+func (m *MsgRemoveGasSponsorshipResponse) Reset()         { *m = MsgRemoveGasSponsorshipResponse{} }
+func (m *MsgRemoveGasSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGasSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRemoveGasSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{23}
+}
+func (m *MsgRemoveGasSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGasSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGasSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGasSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGasSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgRemoveGasSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGasSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGasSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGasSponsorshipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "iq.wasm.v1beta1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "iq.wasm.v1beta1.MsgStoreCodeResponse")
@@ -655,6 +1104,8 @@ func init() {
 	proto.RegisterType((*MsgMigrateCodeResponse)(nil), "iq.wasm.v1beta1.MsgMigrateCodeResponse")
 	proto.RegisterType((*MsgInstantiateContract)(nil), "iq.wasm.v1beta1.MsgInstantiateContract")
 	proto.RegisterType((*MsgInstantiateContractResponse)(nil), "iq.wasm.v1beta1.MsgInstantiateContractResponse")
+	proto.RegisterType((*MsgInstantiateContract2)(nil), "iq.wasm.v1beta1.MsgInstantiateContract2")
+	proto.RegisterType((*MsgInstantiateContract2Response)(nil), "iq.wasm.v1beta1.MsgInstantiateContract2Response")
 	proto.RegisterType((*MsgExecuteContract)(nil), "iq.wasm.v1beta1.MsgExecuteContract")
 	proto.RegisterType((*MsgExecuteContractResponse)(nil), "iq.wasm.v1beta1.MsgExecuteContractResponse")
 	proto.RegisterType((*MsgMigrateContract)(nil), "iq.wasm.v1beta1.MsgMigrateContract")
@@ -663,71 +1114,111 @@ func init() {
 	proto.RegisterType((*MsgUpdateContractAdminResponse)(nil), "iq.wasm.v1beta1.MsgUpdateContractAdminResponse")
 	proto.RegisterType((*MsgClearContractAdmin)(nil), "iq.wasm.v1beta1.MsgClearContractAdmin")
 	proto.RegisterType((*MsgClearContractAdminResponse)(nil), "iq.wasm.v1beta1.MsgClearContractAdminResponse")
+	proto.RegisterType((*MsgRegisterCronJob)(nil), "iq.wasm.v1beta1.MsgRegisterCronJob")
+	proto.RegisterType((*MsgRegisterCronJobResponse)(nil), "iq.wasm.v1beta1.MsgRegisterCronJobResponse")
+	proto.RegisterType((*MsgCancelCronJob)(nil), "iq.wasm.v1beta1.MsgCancelCronJob")
+	proto.RegisterType((*MsgCancelCronJobResponse)(nil), "iq.wasm.v1beta1.MsgCancelCronJobResponse")
+	proto.RegisterType((*MsgSetGasSponsorship)(nil), "iq.wasm.v1beta1.MsgSetGasSponsorship")
+	proto.RegisterType((*MsgSetGasSponsorshipResponse)(nil), "iq.wasm.v1beta1.MsgSetGasSponsorshipResponse")
+	proto.RegisterType((*MsgRemoveGasSponsorship)(nil), "iq.wasm.v1beta1.MsgRemoveGasSponsorship")
+	proto.RegisterType((*MsgRemoveGasSponsorshipResponse)(nil), "iq.wasm.v1beta1.MsgRemoveGasSponsorshipResponse")
 }
 
 func init() { proto.RegisterFile("iq/wasm/v1beta1/tx.proto", fileDescriptor_5834e4e1a84cce82) }
 
 var fileDescriptor_5834e4e1a84cce82 = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x9b, 0xb6, 0xdb, 0xbc, 0x84, 0xb6, 0xeb, 0x76, 0x21, 0x64, 0x21, 0x13, 0xcd, 0x4a,
-	0xab, 0x14, 0x69, 0x6d, 0xb5, 0x88, 0xcb, 0x9e, 0x48, 0xca, 0x22, 0x15, 0xc9, 0x20, 0xb9, 0x42,
-	0x2b, 0x21, 0xa1, 0xc8, 0xb1, 0x47, 0xc6, 0xb0, 0xf1, 0x14, 0x8f, 0x4b, 0x1a, 0x2e, 0x5c, 0xb9,
-	0x20, 0x81, 0xc4, 0x1f, 0xb0, 0x67, 0x0e, 0xfc, 0x11, 0x9c, 0xf6, 0x82, 0xb4, 0x47, 0x4e, 0x06,
-	0xa5, 0x17, 0xce, 0x96, 0x38, 0xc0, 0x09, 0x79, 0x66, 0xec, 0x4e, 0x9b, 0xdf, 0xd5, 0x9e, 0x6c,
-	0xcd, 0xfb, 0xde, 0x8f, 0xf9, 0xbe, 0xf7, 0x66, 0x06, 0xee, 0xc7, 0x24, 0x8a, 0x1c, 0x73, 0xe8,
-	0xb0, 0x81, 0xf9, 0xcd, 0x61, 0x9f, 0xc4, 0xce, 0xa1, 0x19, 0x5f, 0x18, 0x67, 0x11, 0x8d, 0xa9,
-	0xae, 0x73, 0xa3, 0x91, 0x19, 0x0d, 0x69, 0x6c, 0xec, 0xfb, 0xd4, 0xa7, 0xdc, 0x6c, 0x66, 0x7f,
-	0x02, 0xd9, 0x68, 0xba, 0x94, 0x0d, 0x28, 0x33, 0xfb, 0x0e, 0x23, 0x45, 0x1c, 0x97, 0x06, 0xa1,
-	0xb0, 0xe3, 0x9f, 0x35, 0xa8, 0x59, 0xcc, 0x3f, 0x8d, 0x69, 0x44, 0x8e, 0xa9, 0x47, 0xf4, 0x03,
-	0xd8, 0x64, 0x24, 0xf4, 0x48, 0x54, 0xd7, 0x5a, 0x5a, 0xbb, 0xd2, 0xbd, 0x9b, 0x26, 0xe8, 0xb5,
-	0x91, 0x33, 0x78, 0xf6, 0x18, 0x8b, 0x75, 0x6c, 0x4b, 0x80, 0xfe, 0x09, 0x6c, 0x67, 0x15, 0xf4,
-	0xfa, 0xa3, 0x98, 0xf4, 0x5c, 0xea, 0x91, 0xfa, 0x5a, 0x4b, 0x6b, 0xd7, 0xba, 0x07, 0xe3, 0x04,
-	0xd5, 0x9e, 0x76, 0x4e, 0xad, 0xee, 0x28, 0xe6, 0x41, 0xd3, 0x04, 0xdd, 0x13, 0x21, 0xae, 0xe3,
-	0xb1, 0x5d, 0xcb, 0x16, 0x72, 0xd8, 0xe3, 0xad, 0xef, 0x9f, 0xa3, 0xd2, 0xdf, 0xcf, 0x51, 0x09,
-	0x5b, 0xb0, 0xaf, 0x56, 0x65, 0x13, 0x76, 0x46, 0x43, 0x46, 0xf4, 0xf7, 0xe0, 0x4e, 0xe6, 0xd8,
-	0x0b, 0x3c, 0x5e, 0xde, 0x7a, 0xf7, 0xad, 0x71, 0x82, 0x36, 0x33, 0xc8, 0xc9, 0x07, 0x69, 0x82,
-	0xb6, 0x45, 0x16, 0x09, 0xc1, 0xf6, 0x66, 0xf6, 0x77, 0xe2, 0xe1, 0xdf, 0x35, 0xd8, 0xb6, 0x98,
-	0x6f, 0x05, 0x7e, 0xe4, 0x88, 0x5c, 0xb7, 0x8c, 0xa4, 0xd0, 0xb3, 0xb6, 0x3a, 0x3d, 0xe5, 0x57,
-	0x45, 0x4f, 0x1d, 0x5e, 0xbf, 0xbe, 0x9d, 0x9c, 0x20, 0xfc, 0xef, 0x1a, 0x37, 0x9d, 0x84, 0x2c,
-	0x76, 0xc2, 0x38, 0xe0, 0xe6, 0x30, 0x8e, 0x1c, 0x37, 0x5e, 0x45, 0xd9, 0x87, 0xb0, 0xe1, 0x78,
-	0x83, 0x20, 0x94, 0x9b, 0xdc, 0x4d, 0x13, 0x54, 0x13, 0x48, 0xbe, 0x8c, 0x6d, 0x61, 0x56, 0x49,
-	0x2c, 0xaf, 0x40, 0xe2, 0x47, 0xb0, 0x15, 0x84, 0x41, 0xdc, 0x1b, 0x30, 0xbf, 0xbe, 0xce, 0x39,
-	0x31, 0xd3, 0x04, 0xed, 0x08, 0x74, 0x6e, 0xc1, 0xff, 0x25, 0xa8, 0x4e, 0x42, 0x97, 0x7a, 0x41,
-	0xe8, 0x9b, 0x5f, 0x32, 0x1a, 0x1a, 0xb6, 0x33, 0xb4, 0x08, 0x63, 0x8e, 0x4f, 0xec, 0x3b, 0x19,
-	0xcc, 0x62, 0xbe, 0xfe, 0x1d, 0x00, 0xf7, 0xc8, 0x7a, 0x9a, 0xd5, 0x37, 0x5a, 0xe5, 0x76, 0xf5,
-	0xe8, 0x4d, 0x43, 0x74, 0xbd, 0x91, 0x75, 0x7d, 0x3e, 0x20, 0xc6, 0x31, 0x0d, 0xc2, 0xee, 0x93,
-	0x17, 0x09, 0x2a, 0xa5, 0x09, 0xba, 0xab, 0x24, 0xe3, 0xae, 0xf8, 0x97, 0x3f, 0x51, 0xdb, 0x0f,
-	0xe2, 0x2f, 0xce, 0xfb, 0x86, 0x4b, 0x07, 0xa6, 0x9c, 0x1b, 0xf1, 0x79, 0xc4, 0xbc, 0xaf, 0xcc,
-	0x78, 0x74, 0x46, 0x18, 0x8f, 0xc2, 0xec, 0x4a, 0xe6, 0xc8, 0x7f, 0x15, 0x55, 0x7e, 0xd0, 0xa0,
-	0x39, 0x9d, 0xfb, 0xa2, 0x7f, 0x3f, 0x84, 0x5d, 0x57, 0xae, 0xf5, 0x1c, 0xcf, 0x8b, 0x08, 0x63,
-	0x52, 0x8d, 0xfb, 0x69, 0x82, 0xde, 0xc8, 0xf9, 0xba, 0x8e, 0xc0, 0xf6, 0x4e, 0xbe, 0xd4, 0x11,
-	0x2b, 0xfa, 0x03, 0x58, 0xf7, 0x9c, 0xd8, 0x91, 0x03, 0xb7, 0x93, 0x26, 0xa8, 0x2a, 0x7c, 0xb3,
-	0x55, 0x6c, 0x73, 0x23, 0xfe, 0x6d, 0x0d, 0x74, 0x8b, 0xf9, 0x4f, 0x2e, 0x88, 0x7b, 0x7e, 0xbb,
-	0x3e, 0x30, 0x61, 0x2b, 0xcf, 0x2c, 0x5b, 0x61, 0xef, 0x4a, 0xa8, 0xdc, 0x82, 0xed, 0x02, 0xa4,
-	0x9f, 0x42, 0x95, 0x88, 0x74, 0x5c, 0x5c, 0xd1, 0xf0, 0x47, 0x69, 0x82, 0x74, 0xe1, 0xa3, 0x18,
-	0xe7, 0xeb, 0x0b, 0x12, 0x99, 0x49, 0xfc, 0x35, 0x6c, 0x2c, 0xa9, 0xee, 0xfb, 0x52, 0xdd, 0x5a,
-	0x5e, 0xe1, 0xca, 0xc2, 0x8a, 0x4c, 0x8a, 0xa8, 0x1d, 0x68, 0x4c, 0x72, 0x58, 0xe8, 0x99, 0xeb,
-	0xa0, 0xcd, 0xd3, 0xe1, 0x27, 0xa1, 0x43, 0x31, 0xae, 0x92, 0xab, 0x62, 0xc8, 0xb4, 0xf9, 0x43,
-	0xb6, 0xb2, 0x08, 0xc7, 0x50, 0x0d, 0xc9, 0xb0, 0x77, 0x7d, 0x32, 0x1f, 0x8c, 0x13, 0x54, 0xf9,
-	0x98, 0x0c, 0x8b, 0xe1, 0x94, 0x8a, 0x28, 0x48, 0x6c, 0x57, 0x42, 0x09, 0xf0, 0x32, 0x25, 0x07,
-	0xa2, 0x60, 0x65, 0x4c, 0x15, 0x25, 0x15, 0xe3, 0x02, 0x25, 0x25, 0xd2, 0x62, 0xfe, 0x04, 0xad,
-	0x37, 0x28, 0x59, 0x8d, 0xd6, 0x5f, 0x35, 0x7e, 0xd4, 0x7d, 0x7a, 0xe6, 0x29, 0x21, 0x3a, 0x9c,
-	0xb2, 0x65, 0xa9, 0x3d, 0x84, 0x6c, 0xc7, 0x3d, 0xf5, 0xac, 0xdb, 0x4f, 0x13, 0xb4, 0x7b, 0x45,
-	0x8d, 0xc4, 0x6f, 0x85, 0x64, 0xd8, 0x99, 0x50, 0xa3, 0xbc, 0x84, 0x1a, 0xca, 0x9e, 0x5b, 0xfc,
-	0x78, 0x98, 0x52, 0x6f, 0x71, 0x7a, 0x7f, 0x0b, 0xf7, 0x2c, 0xe6, 0x1f, 0x3f, 0x23, 0x4e, 0x74,
-	0xbb, 0x0d, 0xad, 0xda, 0x2b, 0x4a, 0x75, 0x08, 0xde, 0x9e, 0x9a, 0x3b, 0x2f, 0xee, 0xe8, 0x9f,
-	0x0d, 0x28, 0x67, 0xe3, 0xf8, 0x14, 0x2a, 0x57, 0xcf, 0x85, 0x96, 0x31, 0xf9, 0x14, 0x31, 0xd4,
-	0xab, 0xbb, 0xd1, 0x5e, 0x84, 0x28, 0x54, 0xff, 0x1c, 0xaa, 0xea, 0x0d, 0x8d, 0x67, 0x38, 0x2a,
-	0x98, 0xc6, 0x3b, 0x8b, 0x31, 0x45, 0xf8, 0x73, 0xd8, 0x9b, 0x76, 0x2d, 0xce, 0x0a, 0x31, 0x05,
-	0xdb, 0x38, 0x5a, 0x1e, 0x5b, 0xa4, 0x0d, 0x60, 0xe7, 0xe6, 0x09, 0xfc, 0x70, 0x46, 0x98, 0x1b,
-	0xb8, 0x86, 0xb1, 0x1c, 0x4e, 0x4d, 0x35, 0x71, 0xc8, 0x2c, 0x22, 0x68, 0x41, 0xaa, 0x59, 0x13,
-	0x7a, 0x0e, 0x7b, 0xd3, 0x06, 0x6f, 0x16, 0x99, 0x53, 0xb0, 0x33, 0xc9, 0x9c, 0x33, 0x20, 0x7a,
-	0x04, 0xfa, 0x94, 0xe9, 0x38, 0x98, 0x11, 0x69, 0x12, 0xda, 0x38, 0x5c, 0x1a, 0x9a, 0xe7, 0xec,
-	0x76, 0x5f, 0x8c, 0x9b, 0xda, 0xcb, 0x71, 0x53, 0xfb, 0x6b, 0xdc, 0xd4, 0x7e, 0xbc, 0x6c, 0x96,
-	0x5e, 0x5e, 0x36, 0x4b, 0x7f, 0x5c, 0x36, 0x4b, 0x9f, 0xa9, 0xd7, 0x0a, 0x0f, 0xfb, 0x68, 0x40,
-	0x43, 0x32, 0x32, 0x5d, 0x1a, 0x11, 0xf3, 0x42, 0xbc, 0xdd, 0xf9, 0xe5, 0xd2, 0xdf, 0xe4, 0xaf,
-	0xed, 0x77, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xdf, 0x2a, 0xed, 0xd6, 0x0b, 0x00, 0x00,
+	// 1448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xc6, 0x8e, 0x9b, 0x3c, 0x4e, 0x9b, 0xd4, 0x4d, 0x5a, 0xbf, 0xfb, 0xb6, 0xde, 0x74,
+	0xa3, 0xf7, 0xad, 0x4b, 0x55, 0x6f, 0x13, 0x40, 0x48, 0x15, 0x07, 0x6c, 0xb7, 0x45, 0xa9, 0x30,
+	0x2d, 0x1b, 0x50, 0x25, 0x24, 0x64, 0xed, 0xc7, 0xb0, 0xd9, 0xd6, 0xde, 0x71, 0x76, 0x36, 0x75,
+	0xc3, 0xa5, 0x88, 0x13, 0x97, 0xa2, 0xf2, 0x1f, 0x94, 0x2b, 0x07, 0xfe, 0x08, 0x2e, 0xf4, 0x82,
+	0x54, 0x71, 0xe2, 0xb4, 0x20, 0xf7, 0xc2, 0xd9, 0x47, 0x4e, 0x68, 0x66, 0x76, 0xd7, 0x13, 0x7b,
+	0x9b, 0xac, 0x53, 0x21, 0x2e, 0x9c, 0xb2, 0x99, 0xe7, 0xf7, 0x7c, 0xcc, 0xf3, 0xfb, 0xcd, 0x97,
+	0xa1, 0xec, 0xee, 0x6a, 0x7d, 0x83, 0x74, 0xb5, 0x87, 0x1b, 0x26, 0x0a, 0x8c, 0x0d, 0x2d, 0x78,
+	0x54, 0xeb, 0xf9, 0x38, 0xc0, 0xa5, 0x25, 0x77, 0xb7, 0x46, 0x2d, 0xb5, 0xc8, 0x22, 0xaf, 0x38,
+	0xd8, 0xc1, 0xcc, 0xa6, 0xd1, 0x2f, 0x0e, 0x93, 0xe5, 0xf1, 0x00, 0xcc, 0x87, 0xdb, 0x2a, 0x16,
+	0x26, 0x5d, 0x4c, 0x34, 0xd3, 0x20, 0x28, 0xb1, 0x5b, 0xd8, 0xf5, 0xb8, 0x5d, 0x7d, 0x32, 0x0b,
+	0x8b, 0x2d, 0xe2, 0x6c, 0x07, 0xd8, 0x47, 0x4d, 0x6c, 0xa3, 0xd2, 0x65, 0x28, 0x10, 0xe4, 0xd9,
+	0xc8, 0x2f, 0x4b, 0x6b, 0x52, 0x75, 0xa1, 0x71, 0x7a, 0x18, 0x2a, 0x27, 0xf7, 0x8d, 0x6e, 0xe7,
+	0xba, 0xca, 0xc7, 0x55, 0x3d, 0x02, 0x94, 0xee, 0xc0, 0x29, 0x9a, 0xa9, 0x6d, 0xee, 0x07, 0xa8,
+	0x6d, 0x61, 0x1b, 0x95, 0x67, 0xd7, 0xa4, 0xea, 0x62, 0xe3, 0xf2, 0x20, 0x54, 0x16, 0xef, 0xd5,
+	0xb7, 0x5b, 0x8d, 0xfd, 0x80, 0x05, 0x1d, 0x86, 0xca, 0x2a, 0x0f, 0x71, 0x10, 0xaf, 0xea, 0x8b,
+	0x74, 0x20, 0x86, 0x95, 0xfa, 0x70, 0xd6, 0xf5, 0x48, 0x60, 0x78, 0x81, 0x6b, 0x04, 0xa8, 0xdd,
+	0x43, 0x7e, 0xd7, 0x25, 0xc4, 0xc5, 0x5e, 0x39, 0xb7, 0x26, 0x55, 0x8b, 0x9b, 0x17, 0x6a, 0x63,
+	0x0d, 0xa9, 0xd5, 0x2d, 0x0b, 0x11, 0xd2, 0xc4, 0xde, 0xe7, 0xae, 0xd3, 0xb8, 0x38, 0x0c, 0x95,
+	0x0b, 0x3c, 0x4f, 0x7a, 0x18, 0x55, 0x5f, 0x15, 0x0c, 0x77, 0x93, 0xf1, 0xeb, 0xf3, 0x5f, 0x3f,
+	0x53, 0x66, 0xfe, 0x78, 0xa6, 0xcc, 0xa8, 0x2d, 0x58, 0x11, 0xdb, 0xa1, 0x23, 0xd2, 0xc3, 0x1e,
+	0x41, 0xa5, 0xb7, 0xe1, 0x04, 0xad, 0xb8, 0xed, 0xda, 0xac, 0x2f, 0xf9, 0xc6, 0xf9, 0x41, 0xa8,
+	0x14, 0x28, 0x64, 0xeb, 0xc6, 0x30, 0x54, 0x4e, 0xf1, 0xb4, 0x11, 0x44, 0xd5, 0x0b, 0xf4, 0x6b,
+	0xcb, 0x56, 0x7f, 0x96, 0xe0, 0x54, 0x8b, 0x38, 0x2d, 0xd7, 0xf1, 0x8d, 0x68, 0x92, 0xc7, 0x8b,
+	0x24, 0xf0, 0x32, 0x3b, 0x3d, 0x2f, 0xb9, 0xd7, 0xe2, 0x45, 0x68, 0x4f, 0x19, 0xce, 0x1e, 0x9c,
+	0x4e, 0xdc, 0x20, 0xf5, 0xbb, 0x1c, 0x33, 0x6d, 0x8d, 0xfa, 0xdb, 0xc4, 0x5e, 0xe0, 0x1b, 0x56,
+	0x30, 0x8d, 0xa4, 0xfe, 0x0f, 0x73, 0x86, 0xdd, 0x75, 0xbd, 0x68, 0x92, 0xcb, 0xc3, 0x50, 0x59,
+	0xe4, 0x48, 0x36, 0xac, 0xea, 0xdc, 0x2c, 0x36, 0x31, 0x37, 0x45, 0x13, 0x6f, 0xc3, 0xbc, 0xeb,
+	0xb9, 0x41, 0xbb, 0x4b, 0x9c, 0x72, 0x9e, 0xf5, 0x44, 0x1b, 0x86, 0xca, 0x52, 0xac, 0x19, 0x6e,
+	0x51, 0xff, 0x0c, 0x95, 0x32, 0xf2, 0x2c, 0x6c, 0xbb, 0x9e, 0xa3, 0xdd, 0x27, 0xd8, 0xab, 0xe9,
+	0x46, 0xbf, 0x85, 0x08, 0x31, 0x1c, 0xa4, 0x9f, 0xa0, 0xb0, 0x16, 0x71, 0x4a, 0x8f, 0x01, 0x98,
+	0x07, 0x5d, 0x4c, 0xa4, 0x3c, 0xb7, 0x96, 0xab, 0x16, 0x37, 0xff, 0x53, 0xe3, 0xcb, 0xad, 0x46,
+	0x97, 0x5b, 0x22, 0xd2, 0x26, 0x76, 0xbd, 0xc6, 0xcd, 0xe7, 0xa1, 0x32, 0x33, 0x0c, 0x95, 0xd3,
+	0x42, 0x32, 0xe6, 0xaa, 0x7e, 0xff, 0x9b, 0x52, 0x75, 0xdc, 0x60, 0x67, 0xcf, 0xac, 0x59, 0xb8,
+	0xab, 0x45, 0x0b, 0x96, 0xff, 0xb9, 0x4a, 0xec, 0x07, 0x5a, 0xb0, 0xdf, 0x43, 0x84, 0x45, 0x21,
+	0xfa, 0x02, 0x75, 0x64, 0x9f, 0xb4, 0x57, 0x1d, 0xc3, 0x44, 0x9d, 0x72, 0x61, 0xbc, 0x57, 0x6c,
+	0x58, 0xd5, 0xb9, 0x59, 0x60, 0xef, 0x89, 0x04, 0x95, 0x74, 0x8e, 0x12, 0x9d, 0xdf, 0x82, 0x65,
+	0x2b, 0x1a, 0x6b, 0x1b, 0xb6, 0xed, 0x23, 0x42, 0x22, 0xd6, 0xfe, 0x3b, 0x0c, 0x95, 0x73, 0x71,
+	0x5f, 0x0f, 0x22, 0x54, 0x7d, 0x29, 0x1e, 0xaa, 0xf3, 0x91, 0xd2, 0x3a, 0xe4, 0x6d, 0x23, 0x30,
+	0xa2, 0x1d, 0x61, 0x69, 0x18, 0x2a, 0x45, 0xee, 0x4b, 0x47, 0x55, 0x9d, 0x19, 0xd5, 0x9f, 0x72,
+	0x70, 0x2e, 0xbd, 0x9e, 0xcd, 0x7f, 0x45, 0xf3, 0xf7, 0x88, 0x66, 0x1d, 0xf2, 0xc4, 0xe8, 0x04,
+	0xe5, 0xc2, 0x38, 0x2f, 0x74, 0x54, 0xd5, 0x99, 0x71, 0xa4, 0xac, 0x13, 0x59, 0x95, 0xf5, 0x8d,
+	0x04, 0xca, 0x2b, 0x98, 0xfc, 0x67, 0xa4, 0xf5, 0xe3, 0x2c, 0x94, 0x5a, 0xc4, 0xb9, 0xf9, 0x08,
+	0x59, 0x7b, 0xc7, 0xdb, 0x8a, 0x34, 0x98, 0x8f, 0x33, 0x47, 0xc2, 0x3a, 0x33, 0xa2, 0x3d, 0xb6,
+	0xa8, 0x7a, 0x02, 0x2a, 0x6d, 0x43, 0x11, 0xf1, 0x74, 0x4c, 0x2a, 0x7c, 0xcf, 0xdd, 0x1c, 0x86,
+	0x4a, 0x89, 0xfb, 0x08, 0xc6, 0xc3, 0xd5, 0x02, 0x11, 0x92, 0x0a, 0x66, 0x17, 0xe6, 0x32, 0x6a,
+	0xe5, 0xbd, 0x48, 0x2b, 0x8b, 0x71, 0x85, 0x53, 0xcb, 0x84, 0x67, 0x12, 0x58, 0xad, 0x83, 0x3c,
+	0xd9, 0xc3, 0x84, 0xcf, 0x98, 0x07, 0xe9, 0x30, 0x1e, 0xbe, 0xe5, 0x3c, 0x24, 0x27, 0x46, 0xd4,
+	0xab, 0x64, 0xc9, 0x4a, 0x87, 0x2f, 0xd9, 0xa9, 0x49, 0x68, 0x42, 0xd1, 0x43, 0xfd, 0xf6, 0xc1,
+	0x75, 0xbe, 0x3e, 0x08, 0x95, 0x85, 0x0f, 0x51, 0x3f, 0x59, 0xea, 0x11, 0x23, 0x02, 0x52, 0xd5,
+	0x17, 0xbc, 0x08, 0x60, 0x53, 0x26, 0xbb, 0xbc, 0x60, 0x61, 0xd1, 0x0b, 0x4c, 0x0a, 0xc6, 0x23,
+	0x98, 0x8c, 0x90, 0x2d, 0xe2, 0x4c, 0xb4, 0x75, 0xac, 0x25, 0xd3, 0xb5, 0xf5, 0x07, 0x89, 0x9d,
+	0xb6, 0x9f, 0xf4, 0x6c, 0x21, 0x44, 0x9d, 0xb5, 0x2c, 0x6b, 0x6b, 0x37, 0x80, 0xce, 0xb8, 0x2d,
+	0xee, 0x9c, 0x2b, 0xc3, 0x50, 0x59, 0x1e, 0xb5, 0x26, 0xc2, 0xcf, 0x7b, 0xa8, 0x5f, 0x9f, 0x60,
+	0x23, 0x97, 0x81, 0x0d, 0x61, 0xce, 0x6b, 0x50, 0x49, 0xaf, 0x37, 0xb9, 0x40, 0x7c, 0x01, 0xab,
+	0x2d, 0xe2, 0x34, 0x3b, 0xc8, 0xf0, 0x8f, 0x37, 0xa1, 0x69, 0xb5, 0x22, 0x54, 0xa7, 0xc0, 0x85,
+	0xd4, 0xdc, 0x49, 0x71, 0xbf, 0x70, 0x19, 0xeb, 0xc8, 0x71, 0x49, 0x80, 0xfc, 0xa6, 0x8f, 0xbd,
+	0xdb, 0xd8, 0x3c, 0x90, 0x52, 0xca, 0x22, 0xcf, 0x77, 0x21, 0x47, 0x15, 0xc5, 0xb7, 0xae, 0x37,
+	0x86, 0xa1, 0x02, 0x91, 0xa2, 0x8e, 0x52, 0x12, 0x75, 0xa3, 0xbb, 0xd7, 0x0e, 0x72, 0x9d, 0x1d,
+	0xde, 0xfd, 0x9c, 0xb8, 0x7b, 0xf1, 0x71, 0x55, 0x8f, 0x00, 0xb4, 0x32, 0xd7, 0x0b, 0x90, 0xff,
+	0xd0, 0xe8, 0x30, 0xfd, 0xe6, 0xc5, 0xca, 0x62, 0x8b, 0xaa, 0x27, 0x20, 0x2a, 0x07, 0xc7, 0x20,
+	0xed, 0x8e, 0xdb, 0x75, 0x83, 0xf2, 0x1c, 0xf3, 0x10, 0xe4, 0x90, 0x98, 0x54, 0x7d, 0xde, 0x31,
+	0xc8, 0x07, 0xf4, 0xb3, 0xf4, 0x16, 0x00, 0x1d, 0x37, 0xf7, 0x6c, 0x07, 0xf1, 0x13, 0x25, 0xdf,
+	0x58, 0x1d, 0x9d, 0x56, 0x23, 0x9b, 0xaa, 0xd3, 0xd8, 0x0d, 0xf6, 0x2d, 0x74, 0xfd, 0x0e, 0xc8,
+	0x93, 0x3d, 0x4d, 0xd6, 0xc1, 0x06, 0x14, 0xee, 0x63, 0x73, 0x74, 0x4d, 0x96, 0x07, 0xa1, 0x32,
+	0x77, 0x1b, 0x9b, 0x5b, 0x37, 0x46, 0xb3, 0xe6, 0x00, 0x55, 0x9f, 0xbb, 0x8f, 0xcd, 0x2d, 0x5b,
+	0xfd, 0x52, 0x82, 0x65, 0xca, 0xa3, 0xe1, 0x59, 0xa8, 0x73, 0x6c, 0x8e, 0x46, 0x89, 0x67, 0x33,
+	0x26, 0x16, 0xe6, 0x24, 0x43, 0x79, 0xbc, 0x82, 0x44, 0x44, 0x4f, 0x73, 0xfc, 0x71, 0x81, 0x82,
+	0xf7, 0x0d, 0xb2, 0x4d, 0xc7, 0xb0, 0x4f, 0x76, 0xdc, 0xde, 0xf4, 0x25, 0x3e, 0x06, 0xd8, 0x23,
+	0xc8, 0x8f, 0xd8, 0x9a, 0x9d, 0xf2, 0x1a, 0x31, 0x72, 0x9d, 0xf2, 0x1a, 0x41, 0x1d, 0x39, 0xf5,
+	0xef, 0x40, 0x91, 0x45, 0xe9, 0x21, 0xdf, 0xc5, 0xf1, 0x36, 0x7b, 0x76, 0xb4, 0x43, 0x0a, 0x46,
+	0x55, 0x67, 0xb5, 0xde, 0x65, 0xff, 0x94, 0xbe, 0x92, 0xa0, 0x68, 0x76, 0xb0, 0xf5, 0x20, 0xaa,
+	0x3d, 0x7f, 0x54, 0xed, 0xb7, 0xa2, 0xda, 0xa3, 0xc0, 0x82, 0xef, 0x74, 0xc5, 0x03, 0xf3, 0x64,
+	0xd5, 0x0b, 0x74, 0x55, 0xe0, 0x7c, 0x1a, 0x23, 0x09, 0x65, 0x1f, 0xb3, 0x0b, 0xaa, 0x8e, 0xba,
+	0xf8, 0x21, 0x7a, 0x4d, 0xd2, 0x84, 0xac, 0x17, 0x41, 0x79, 0x45, 0xd4, 0x38, 0xf1, 0xe6, 0x60,
+	0x01, 0x72, 0xf4, 0xfc, 0xff, 0x08, 0x16, 0x46, 0x6f, 0xf3, 0xc9, 0xf7, 0xaf, 0xf8, 0x56, 0x95,
+	0xff, 0x77, 0xa8, 0x39, 0x59, 0x58, 0xf7, 0xa0, 0x28, 0xbe, 0x47, 0x95, 0x34, 0x2f, 0x01, 0x20,
+	0x5f, 0x3a, 0x02, 0x90, 0x04, 0xc6, 0x70, 0x26, 0xed, 0xf9, 0x97, 0xea, 0x9f, 0x02, 0x94, 0xb5,
+	0x8c, 0xc0, 0x24, 0xa1, 0x0f, 0x2b, 0xa9, 0x6f, 0x87, 0x6a, 0xc6, 0x40, 0x9b, 0xf2, 0xb5, 0xac,
+	0xc8, 0x24, 0xa7, 0x05, 0x4b, 0xe3, 0x97, 0xca, 0xf5, 0xb4, 0x20, 0x63, 0x20, 0xf9, 0x4a, 0x06,
+	0x90, 0x98, 0x64, 0xfc, 0xc6, 0xb4, 0x7e, 0x28, 0x0b, 0x87, 0x25, 0x79, 0xd5, 0x45, 0x03, 0xc3,
+	0x99, 0xb4, 0xfb, 0x43, 0x2a, 0x5d, 0x29, 0x40, 0x59, 0xcb, 0x08, 0x4c, 0x12, 0x76, 0xa0, 0x94,
+	0x76, 0xbc, 0xa7, 0x85, 0x99, 0xc4, 0xc9, 0xb5, 0x6c, 0x38, 0xb1, 0x87, 0xe3, 0xc7, 0x75, 0x6a,
+	0x0f, 0xc7, 0x40, 0xf2, 0x95, 0x0c, 0xa0, 0x24, 0xc9, 0x67, 0x70, 0xf2, 0xe0, 0x69, 0x73, 0x31,
+	0xb5, 0x4a, 0x11, 0x22, 0x5f, 0x3e, 0x12, 0x92, 0x84, 0x77, 0xe1, 0xf4, 0xe4, 0x69, 0x91, 0xbe,
+	0xcc, 0xc7, 0x61, 0xf2, 0xd5, 0x4c, 0x30, 0x71, 0x2d, 0xa5, 0x6e, 0x73, 0xd5, 0xf4, 0x76, 0x4c,
+	0x22, 0xe5, 0x6b, 0x59, 0x91, 0x71, 0xce, 0x46, 0xfd, 0xf9, 0xa0, 0x22, 0xbd, 0x18, 0x54, 0xa4,
+	0xdf, 0x07, 0x15, 0xe9, 0xe9, 0xcb, 0xca, 0xcc, 0x8b, 0x97, 0x95, 0x99, 0x5f, 0x5f, 0x56, 0x66,
+	0x3e, 0xbd, 0x24, 0xec, 0xeb, 0xa6, 0x1b, 0xf4, 0x91, 0x49, 0x34, 0x77, 0xf7, 0xaa, 0x85, 0x7d,
+	0xa4, 0x3d, 0xe2, 0x3f, 0x76, 0xb2, 0xcd, 0xdd, 0x2c, 0xb0, 0x9f, 0x31, 0xdf, 0xfc, 0x6b, 0x00,
+	0xf9, 0x1a, 0x27, 0x1b, 0x45, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -748,6 +1239,9 @@ type MsgClient interface {
 	MigrateCode(ctx context.Context, in *MsgMigrateCode, opts ...grpc.CallOption) (*MsgMigrateCodeResponse, error)
 	//  Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error)
+	//  Instantiate2 creates a new smart contract instance for the given code id
+	//  at an address predictable from the code hash, the sender and a salt.
+	InstantiateContract2(ctx context.Context, in *MsgInstantiateContract2, opts ...grpc.CallOption) (*MsgInstantiateContract2Response, error)
 	// Execute submits the given message data to a smart contract
 	ExecuteContract(ctx context.Context, in *MsgExecuteContract, opts ...grpc.CallOption) (*MsgExecuteContractResponse, error)
 	// Migrate runs a code upgrade/ downgrade for a smart contract
@@ -756,6 +1250,14 @@ type MsgClient interface {
 	UpdateContractAdmin(ctx context.Context, in *MsgUpdateContractAdmin, opts ...grpc.CallOption) (*MsgUpdateContractAdminResponse, error)
 	// ClearContractAdmin remove admin flag from a smart contract
 	ClearContractAdmin(ctx context.Context, in *MsgClearContractAdmin, opts ...grpc.CallOption) (*MsgClearContractAdminResponse, error)
+	// RegisterCronJob schedules a callback of the contract executed by the end blocker
+	RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error)
+	// CancelCronJob removes a cron job of the contract and refunds its escrow
+	CancelCronJob(ctx context.Context, in *MsgCancelCronJob, opts ...grpc.CallOption) (*MsgCancelCronJobResponse, error)
+	// SetGasSponsorship makes the contract pay the fees of the txs executing it
+	SetGasSponsorship(ctx context.Context, in *MsgSetGasSponsorship, opts ...grpc.CallOption) (*MsgSetGasSponsorshipResponse, error)
+	// RemoveGasSponsorship stops the contract paying the fees of the txs executing it
+	RemoveGasSponsorship(ctx context.Context, in *MsgRemoveGasSponsorship, opts ...grpc.CallOption) (*MsgRemoveGasSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -793,8 +1295,17 @@ func (c *msgClient) InstantiateContract(ctx context.Context, in *MsgInstantiateC
 	return out, nil
 }
 
-func (c *msgClient) ExecuteContract(ctx context.Context, in *MsgExecuteContract, opts ...grpc.CallOption) (*MsgExecuteContractResponse, error) {
-	out := new(MsgExecuteContractResponse)
+func (c *msgClient) InstantiateContract2(ctx context.Context, in *MsgInstantiateContract2, opts ...grpc.CallOption) (*MsgInstantiateContract2Response, error) {
+	out := new(MsgInstantiateContract2Response)
+	err := c.cc.Invoke(ctx, "/iq.wasm.v1beta1.Msg/InstantiateContract2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteContract(ctx context.Context, in *MsgExecuteContract, opts ...grpc.CallOption) (*MsgExecuteContractResponse, error) {
+	out := new(MsgExecuteContractResponse)
 	err := c.cc.Invoke(ctx, "/iq.wasm.v1beta1.Msg/ExecuteContract", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -829,6 +1340,42 @@ func (c *msgClient) ClearContractAdmin(ctx context.Context, in *MsgClearContract
 	return out, nil
 }
 
+func (c *msgClient) RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error) {
+	out := new(MsgRegisterCronJobResponse)
+	err := c.cc.Invoke(ctx, "/iq.wasm.v1beta1.Msg/RegisterCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelCronJob(ctx context.Context, in *MsgCancelCronJob, opts ...grpc.CallOption) (*MsgCancelCronJobResponse, error) {
+	out := new(MsgCancelCronJobResponse)
+	err := c.cc.Invoke(ctx, "/iq.wasm.v1beta1.Msg/CancelCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGasSponsorship(ctx context.Context, in *MsgSetGasSponsorship, opts ...grpc.CallOption) (*MsgSetGasSponsorshipResponse, error) {
+	out := new(MsgSetGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/iq.wasm.v1beta1.Msg/SetGasSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveGasSponsorship(ctx context.Context, in *MsgRemoveGasSponsorship, opts ...grpc.CallOption) (*MsgRemoveGasSponsorshipResponse, error) {
+	out := new(MsgRemoveGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/iq.wasm.v1beta1.Msg/RemoveGasSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
@@ -837,6 +1384,9 @@ type MsgServer interface {
 	MigrateCode(context.Context, *MsgMigrateCode) (*MsgMigrateCodeResponse, error)
 	//  Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(context.Context, *MsgInstantiateContract) (*MsgInstantiateContractResponse, error)
+	//  Instantiate2 creates a new smart contract instance for the given code id
+	//  at an address predictable from the code hash, the sender and a salt.
+	InstantiateContract2(context.Context, *MsgInstantiateContract2) (*MsgInstantiateContract2Response, error)
 	// Execute submits the given message data to a smart contract
 	ExecuteContract(context.Context, *MsgExecuteContract) (*MsgExecuteContractResponse, error)
 	// Migrate runs a code upgrade/ downgrade for a smart contract
@@ -845,6 +1395,14 @@ type MsgServer interface {
 	UpdateContractAdmin(context.Context, *MsgUpdateContractAdmin) (*MsgUpdateContractAdminResponse, error)
 	// ClearContractAdmin remove admin flag from a smart contract
 	ClearContractAdmin(context.Context, *MsgClearContractAdmin) (*MsgClearContractAdminResponse, error)
+	// RegisterCronJob schedules a callback of the contract executed by the end blocker
+	RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error)
+	// CancelCronJob removes a cron job of the contract and refunds its escrow
+	CancelCronJob(context.Context, *MsgCancelCronJob) (*MsgCancelCronJobResponse, error)
+	// SetGasSponsorship makes the contract pay the fees of the txs executing it
+	SetGasSponsorship(context.Context, *MsgSetGasSponsorship) (*MsgSetGasSponsorshipResponse, error)
+	// RemoveGasSponsorship stops the contract paying the fees of the txs executing it
+	RemoveGasSponsorship(context.Context, *MsgRemoveGasSponsorship) (*MsgRemoveGasSponsorshipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -860,6 +1418,9 @@ func (*UnimplementedMsgServer) MigrateCode(ctx context.Context, req *MsgMigrateC
 func (*UnimplementedMsgServer) InstantiateContract(ctx context.Context, req *MsgInstantiateContract) (*MsgInstantiateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract not implemented")
 }
+func (*UnimplementedMsgServer) InstantiateContract2(ctx context.Context, req *MsgInstantiateContract2) (*MsgInstantiateContract2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract2 not implemented")
+}
 func (*UnimplementedMsgServer) ExecuteContract(ctx context.Context, req *MsgExecuteContract) (*MsgExecuteContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteContract not implemented")
 }
@@ -872,6 +1433,18 @@ func (*UnimplementedMsgServer) UpdateContractAdmin(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ClearContractAdmin(ctx context.Context, req *MsgClearContractAdmin) (*MsgClearContractAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearContractAdmin not implemented")
 }
+func (*UnimplementedMsgServer) RegisterCronJob(ctx context.Context, req *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCronJob not implemented")
+}
+func (*UnimplementedMsgServer) CancelCronJob(ctx context.Context, req *MsgCancelCronJob) (*MsgCancelCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCronJob not implemented")
+}
+func (*UnimplementedMsgServer) SetGasSponsorship(ctx context.Context, req *MsgSetGasSponsorship) (*MsgSetGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGasSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RemoveGasSponsorship(ctx context.Context, req *MsgRemoveGasSponsorship) (*MsgRemoveGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGasSponsorship not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -931,6 +1504,24 @@ func _Msg_InstantiateContract_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantiateContract2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateContract2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateContract2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.wasm.v1beta1.Msg/InstantiateContract2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateContract2(ctx, req.(*MsgInstantiateContract2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteContract)
 	if err := dec(in); err != nil {
@@ -1003,6 +1594,78 @@ func _Msg_ClearContractAdmin_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.wasm.v1beta1.Msg/RegisterCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCronJob(ctx, req.(*MsgRegisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.wasm.v1beta1.Msg/CancelCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCronJob(ctx, req.(*MsgCancelCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.wasm.v1beta1.Msg/SetGasSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGasSponsorship(ctx, req.(*MsgSetGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iq.wasm.v1beta1.Msg/RemoveGasSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveGasSponsorship(ctx, req.(*MsgRemoveGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "iq.wasm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1019,6 +1682,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InstantiateContract",
 			Handler:    _Msg_InstantiateContract_Handler,
 		},
+		{
+			MethodName: "InstantiateContract2",
+			Handler:    _Msg_InstantiateContract2_Handler,
+		},
 		{
 			MethodName: "ExecuteContract",
 			Handler:    _Msg_ExecuteContract_Handler,
@@ -1035,6 +1702,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearContractAdmin",
 			Handler:    _Msg_ClearContractAdmin_Handler,
 		},
+		{
+			MethodName: "RegisterCronJob",
+			Handler:    _Msg_RegisterCronJob_Handler,
+		},
+		{
+			MethodName: "CancelCronJob",
+			Handler:    _Msg_CancelCronJob_Handler,
+		},
+		{
+			MethodName: "SetGasSponsorship",
+			Handler:    _Msg_SetGasSponsorship_Handler,
+		},
+		{
+			MethodName: "RemoveGasSponsorship",
+			Handler:    _Msg_RemoveGasSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iq/wasm/v1beta1/tx.proto",
@@ -1060,6 +1743,18 @@ func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.WASMByteCode) > 0 {
 		i -= len(m.WASMByteCode)
 		copy(dAtA[i:], m.WASMByteCode)
@@ -1190,6 +1885,13 @@ func (m *MsgInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.InitCoins) > 0 {
 		for iNdEx := len(m.InitCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1270,6 +1972,120 @@ func (m *MsgInstantiateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInstantiateContract2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateContract2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateContract2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InitCoins) > 0 {
+		for iNdEx := len(m.InitCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InitMsg) > 0 {
+		i -= len(m.InitMsg)
+		copy(dAtA[i:], m.InitMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitMsg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateContract2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateContract2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateContract2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExecuteContract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1564,34 +2380,320 @@ func (m *MsgClearContractAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgStoreCode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasBudget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasBudget))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JobID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JobID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.JobID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockLimit) > 0 {
+		for iNdEx := len(m.BlockLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UserPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UserLimit) > 0 {
+		for iNdEx := len(m.UserLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGasSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGasSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGasSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGasSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGasSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStoreCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgStoreCodeResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1660,6 +2762,10 @@ func (m *MsgInstantiateContract) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1680,7 +2786,7 @@ func (m *MsgInstantiateContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExecuteContract) Size() (n int) {
+func (m *MsgInstantiateContract2) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1690,24 +2796,79 @@ func (m *MsgExecuteContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Contract)
+	l = len(m.Admin)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ExecuteMsg)
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.InitMsg)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
+	if len(m.InitCoins) > 0 {
+		for _, e := range m.InitCoins {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MsgExecuteContractResponse) Size() (n int) {
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateContract2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecuteMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteContractResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1775,51 +2936,1580 @@ func (m *MsgUpdateContractAdmin) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateContractAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearContractAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearContractAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.GasBudget != 0 {
+		n += 1 + sovTx(uint64(m.GasBudget))
+	}
+	return n
+}
+
+func (m *MsgRegisterCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobID != 0 {
+		n += 1 + sovTx(uint64(m.JobID))
+	}
+	return n
+}
+
+func (m *MsgCancelCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.JobID != 0 {
+		n += 1 + sovTx(uint64(m.JobID))
+	}
+	return n
+}
+
+func (m *MsgCancelCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetGasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UserLimit) > 0 {
+		for _, e := range m.UserLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UserPeriod != 0 {
+		n += 1 + sovTx(uint64(m.UserPeriod))
+	}
+	if len(m.BlockLimit) > 0 {
+		for _, e := range m.BlockLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetGasSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveGasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveGasSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCoins = append(m.InitCoins, types.Coin{})
+			if err := m.InitCoins[len(m.InitCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCoins = append(m.InitCoins, types.Coin{})
+			if err := m.InitCoins[len(m.InitCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecuteMsg = append(m.ExecuteMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecuteMsg == nil {
+				m.ExecuteMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateContractAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgClearContractAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgClearContractAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1842,15 +4532,15 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1878,11 +4568,62 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeID", wireType)
+			}
+			m.NewCodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1909,9 +4650,9 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WASMByteCode == nil {
-				m.WASMByteCode = []byte{}
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1935,7 +4676,7 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1958,17 +4699,17 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.CodeID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1978,11 +4719,26 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2004,7 +4760,7 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2027,17 +4783,17 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2047,14 +4803,27 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2082,13 +4851,13 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2098,25 +4867,23 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WASMByteCode == nil {
-				m.WASMByteCode = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2139,7 +4906,7 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,10 +4929,10 @@ func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2189,7 +4956,7 @@ func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2212,15 +4979,15 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearContractAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2248,11 +5015,11 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2280,94 +5047,7 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.InitMsg == nil {
-				m.InitMsg = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitCoins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitCoins = append(m.InitCoins, types.Coin{})
-			if err := m.InitCoins[len(m.InitCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2390,7 +5070,7 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClearContractAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2413,78 +5093,12 @@ func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearContractAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2506,7 +5120,7 @@ func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2529,15 +5143,15 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2565,13 +5179,13 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2581,29 +5195,31 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2613,31 +5229,35 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.ExecuteMsg = append(m.ExecuteMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExecuteMsg == nil {
-				m.ExecuteMsg = []byte{}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var msglen int
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2647,26 +5267,30 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBudget", wireType)
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2688,7 +5312,7 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCronJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2711,17 +5335,17 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCronJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			var byteLen int
+			m.JobID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2731,26 +5355,11 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.JobID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2772,7 +5381,7 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2795,45 +5404,13 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
@@ -2865,30 +5442,11 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeID", wireType)
-			}
-			m.NewCodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewCodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			var byteLen int
+			m.JobID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2898,26 +5456,11 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.JobID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.MigrateMsg == nil {
-				m.MigrateMsg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2939,7 +5482,7 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCronJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2962,46 +5505,12 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCronJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3023,7 +5532,7 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3046,15 +5555,15 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3082,13 +5591,13 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3098,29 +5607,50 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.UserLimit = append(m.UserLimit, types.Coin{})
+			if err := m.UserLimit[len(m.UserLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPeriod", wireType)
+			}
+			m.UserPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3130,23 +5660,25 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.BlockLimit = append(m.BlockLimit, types.Coin{})
+			if err := m.BlockLimit[len(m.BlockLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3169,7 +5701,7 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3192,10 +5724,10 @@ func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3219,7 +5751,7 @@ func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveGasSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3242,45 +5774,13 @@ func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearContractAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveGasSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveGasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
@@ -3333,7 +5833,7 @@ func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearContractAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveGasSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3356,10 +5856,10 @@ func (m *MsgClearContractAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearContractAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveGasSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveGasSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
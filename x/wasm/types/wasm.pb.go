@@ -7,7 +7,8 @@ import (
 	bytes "bytes"
 	encoding_json "encoding/json"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -26,11 +27,102 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccessType is the type of an access permission
+type AccessType int32
+
+const (
+	// AccessTypeUnspecified is the default, invalid value
+	AccessTypeUnspecified AccessType = 0
+	// AccessTypeNobody forbids the access to everybody except the governance
+	AccessTypeNobody AccessType = 1
+	// AccessTypeEverybody allows the access to everybody
+	AccessTypeEverybody AccessType = 2
+	// AccessTypeOnlyAddresses allows the access to the listed addresses only
+	AccessTypeOnlyAddresses AccessType = 3
+)
+
+var AccessType_name = map[int32]string{
+	0: "ACCESS_TYPE_UNSPECIFIED",
+	1: "ACCESS_TYPE_NOBODY",
+	2: "ACCESS_TYPE_EVERYBODY",
+	3: "ACCESS_TYPE_ONLY_ADDRESSES",
+}
+
+var AccessType_value = map[string]int32{
+	"ACCESS_TYPE_UNSPECIFIED":    0,
+	"ACCESS_TYPE_NOBODY":         1,
+	"ACCESS_TYPE_EVERYBODY":      2,
+	"ACCESS_TYPE_ONLY_ADDRESSES": 3,
+}
+
+func (x AccessType) String() string {
+	return proto.EnumName(AccessType_name, int32(x))
+}
+
+func (AccessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{0}
+}
+
+// ContractHistoryOperationType is the type of an operation recorded in the contract history
+type ContractHistoryOperationType int32
+
+const (
+	// ContractHistoryOperationTypeUnspecified is the default, invalid value
+	ContractHistoryOperationTypeUnspecified ContractHistoryOperationType = 0
+	// ContractHistoryOperationTypeInit records the contract instantiation
+	ContractHistoryOperationTypeInit ContractHistoryOperationType = 1
+	// ContractHistoryOperationTypeMigrate records a contract migration to a new code
+	ContractHistoryOperationTypeMigrate ContractHistoryOperationType = 2
+	// ContractHistoryOperationTypeAdminUpdate records an admin update or clear
+	ContractHistoryOperationTypeAdminUpdate ContractHistoryOperationType = 3
+	// ContractHistoryOperationTypeCodeMigrate records the replacement of the bytecode
+	// of the contract code, executed by MsgMigrateCode
+	ContractHistoryOperationTypeCodeMigrate ContractHistoryOperationType = 4
+	// ContractHistoryOperationTypeGenesis records the contract import from a genesis
+	// which has no history of the contract
+	ContractHistoryOperationTypeGenesis ContractHistoryOperationType = 5
+)
+
+var ContractHistoryOperationType_name = map[int32]string{
+	0: "CONTRACT_HISTORY_OPERATION_TYPE_UNSPECIFIED",
+	1: "CONTRACT_HISTORY_OPERATION_TYPE_INIT",
+	2: "CONTRACT_HISTORY_OPERATION_TYPE_MIGRATE",
+	3: "CONTRACT_HISTORY_OPERATION_TYPE_ADMIN_UPDATE",
+	4: "CONTRACT_HISTORY_OPERATION_TYPE_CODE_MIGRATE",
+	5: "CONTRACT_HISTORY_OPERATION_TYPE_GENESIS",
+}
+
+var ContractHistoryOperationType_value = map[string]int32{
+	"CONTRACT_HISTORY_OPERATION_TYPE_UNSPECIFIED":  0,
+	"CONTRACT_HISTORY_OPERATION_TYPE_INIT":         1,
+	"CONTRACT_HISTORY_OPERATION_TYPE_MIGRATE":      2,
+	"CONTRACT_HISTORY_OPERATION_TYPE_ADMIN_UPDATE": 3,
+	"CONTRACT_HISTORY_OPERATION_TYPE_CODE_MIGRATE": 4,
+	"CONTRACT_HISTORY_OPERATION_TYPE_GENESIS":      5,
+}
+
+func (x ContractHistoryOperationType) String() string {
+	return proto.EnumName(ContractHistoryOperationType_name, int32(x))
+}
+
+func (ContractHistoryOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{1}
+}
+
 // Params defines the parameters for the wasm module.
 type Params struct {
-	MaxContractSize    uint64 `protobuf:"varint,1,opt,name=max_contract_size,json=maxContractSize,proto3" json:"max_contract_size,omitempty" yaml:"max_contract_size"`
-	MaxContractGas     uint64 `protobuf:"varint,2,opt,name=max_contract_gas,json=maxContractGas,proto3" json:"max_contract_gas,omitempty" yaml:"max_contract_gas"`
-	MaxContractMsgSize uint64 `protobuf:"varint,3,opt,name=max_contract_msg_size,json=maxContractMsgSize,proto3" json:"max_contract_msg_size,omitempty" yaml:"max_contract_msg_size"`
+	MaxContractSize    uint64       `protobuf:"varint,1,opt,name=max_contract_size,json=maxContractSize,proto3" json:"max_contract_size,omitempty" yaml:"max_contract_size"`
+	MaxContractGas     uint64       `protobuf:"varint,2,opt,name=max_contract_gas,json=maxContractGas,proto3" json:"max_contract_gas,omitempty" yaml:"max_contract_gas"`
+	MaxContractMsgSize uint64       `protobuf:"varint,3,opt,name=max_contract_msg_size,json=maxContractMsgSize,proto3" json:"max_contract_msg_size,omitempty" yaml:"max_contract_msg_size"`
+	CodeUploadAccess   AccessConfig `protobuf:"bytes,4,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
+	// CronGasPrice is the price of the gas prepaid by the contracts for their cron jobs
+	CronGasPrice types.DecCoin `protobuf:"bytes,5,opt,name=cron_gas_price,json=cronGasPrice,proto3" json:"cron_gas_price" yaml:"cron_gas_price"`
+	// MaxCronGasPerBlock is the maximum sum of the gas limits of the cron jobs executed in a block
+	MaxCronGasPerBlock uint64 `protobuf:"varint,6,opt,name=max_cron_gas_per_block,json=maxCronGasPerBlock,proto3" json:"max_cron_gas_per_block,omitempty" yaml:"max_cron_gas_per_block"`
+	// StorageRentPrice is the rent of a stored byte of the contract store per rent period
+	StorageRentPrice types.DecCoin `protobuf:"bytes,7,opt,name=storage_rent_price,json=storageRentPrice,proto3" json:"storage_rent_price" yaml:"storage_rent_price"`
+	// StorageRentPeriod is the number of blocks between the rent charges, zero disables the rent
+	StorageRentPeriod uint64 `protobuf:"varint,8,opt,name=storage_rent_period,json=storageRentPeriod,proto3" json:"storage_rent_period,omitempty" yaml:"storage_rent_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +178,95 @@ func (m *Params) GetMaxContractMsgSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetCodeUploadAccess() AccessConfig {
+	if m != nil {
+		return m.CodeUploadAccess
+	}
+	return AccessConfig{}
+}
+
+func (m *Params) GetCronGasPrice() types.DecCoin {
+	if m != nil {
+		return m.CronGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *Params) GetMaxCronGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxCronGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetStorageRentPrice() types.DecCoin {
+	if m != nil {
+		return m.StorageRentPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *Params) GetStorageRentPeriod() uint64 {
+	if m != nil {
+		return m.StorageRentPeriod
+	}
+	return 0
+}
+
+// AccessConfig is an access permission with the allowed addresses
+type AccessConfig struct {
+	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=iq.wasm.v1beta1.AccessType" json:"permission,omitempty" yaml:"permission"`
+	// Addresses are the allowed addresses of AccessTypeOnlyAddresses
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
+func (m *AccessConfig) String() string { return proto.CompactTextString(m) }
+func (*AccessConfig) ProtoMessage()    {}
+func (*AccessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{1}
+}
+func (m *AccessConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessConfig.Merge(m, src)
+}
+func (m *AccessConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
+
+func (m *AccessConfig) GetPermission() AccessType {
+	if m != nil {
+		return m.Permission
+	}
+	return AccessTypeUnspecified
+}
+
+func (m *AccessConfig) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeID is the sequentially increasing unique identifier
@@ -94,13 +275,15 @@ type CodeInfo struct {
 	CodeHash []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
 	// Creator address who initially stored the code
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// InstantiateConfig is the permission to instantiate contracts of the code
+	InstantiateConfig AccessConfig `protobuf:"bytes,4,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config" yaml:"instantiate_config"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2bd5d0123068c880, []int{1}
+	return fileDescriptor_2bd5d0123068c880, []int{2}
 }
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,6 +333,13 @@ func (m *CodeInfo) GetCreator() string {
 	return ""
 }
 
+func (m *CodeInfo) GetInstantiateConfig() AccessConfig {
+	if m != nil {
+		return m.InstantiateConfig
+	}
+	return AccessConfig{}
+}
+
 // ContractInfo stores a WASM contract instance
 type ContractInfo struct {
 	// Address is the address of the contract
@@ -162,13 +352,23 @@ type ContractInfo struct {
 	CodeID uint64 `protobuf:"varint,4,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
 	// InitMsg is the raw message used when instantiating a contract
 	InitMsg encoding_json.RawMessage `protobuf:"bytes,5,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty" yaml:"init_msg"`
+	// Label is optional metadata given on instantiation
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty" yaml:"label"`
+	// IBCPortID is the IBC port bound to the contract, empty for non IBC-enabled contracts
+	IBCPortID string `protobuf:"bytes,7,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty" yaml:"ibc_port_id"`
+	// Frozen is set by the governance to fail the execution and the migration of the contract
+	Frozen bool `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+	// StorageSize is the number of the key and value bytes in the contract store
+	StorageSize uint64 `protobuf:"varint,9,opt,name=storage_size,json=storageSize,proto3" json:"storage_size,omitempty" yaml:"storage_size"`
+	// RentInArrears is set when the contract could not pay its last storage rent in full
+	RentInArrears bool `protobuf:"varint,10,opt,name=rent_in_arrears,json=rentInArrears,proto3" json:"rent_in_arrears,omitempty" yaml:"rent_in_arrears"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2bd5d0123068c880, []int{2}
+	return fileDescriptor_2bd5d0123068c880, []int{3}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,336 +432,2321 @@ func (m *ContractInfo) GetInitMsg() encoding_json.RawMessage {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "iq.wasm.v1beta1.Params")
-	proto.RegisterType((*CodeInfo)(nil), "iq.wasm.v1beta1.CodeInfo")
-	proto.RegisterType((*ContractInfo)(nil), "iq.wasm.v1beta1.ContractInfo")
+func (m *ContractInfo) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("iq/wasm/v1beta1/wasm.proto", fileDescriptor_2bd5d0123068c880) }
-
-var fileDescriptor_2bd5d0123068c880 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x90, 0x26, 0xe9, 0x29, 0x6a, 0xc3, 0xa9, 0x88, 0xa8, 0x14, 0x5f, 0x75, 0x03,
-	0xea, 0x50, 0x62, 0x45, 0x88, 0x25, 0x63, 0x0a, 0xa2, 0x45, 0x8a, 0x84, 0xdc, 0x8d, 0x25, 0x7a,
-	0xb1, 0x0f, 0xe7, 0x10, 0x77, 0x57, 0xf9, 0x19, 0x9a, 0xf4, 0xaf, 0x60, 0x44, 0x62, 0xa9, 0xf8,
-	0x6b, 0x18, 0x3b, 0x32, 0x59, 0x28, 0x59, 0x98, 0x3d, 0x21, 0x26, 0xe4, 0x73, 0x8c, 0x1c, 0x51,
-	0x09, 0xb1, 0x3d, 0xbf, 0xef, 0xc7, 0xdf, 0xf7, 0xe3, 0xf4, 0xc8, 0xc3, 0x44, 0xc4, 0x31, 0x78,
-	0x97, 0x80, 0xca, 0xfb, 0x30, 0x98, 0x8a, 0x04, 0x06, 0xf6, 0xa3, 0x7f, 0x11, 0x9b, 0xc4, 0x50,
-	0x6a, 0xe5, 0xbe, 0xcd, 0xac, 0xe5, 0xfd, 0xbd, 0xc8, 0x44, 0xc6, 0xca, 0x5e, 0x1e, 0x15, 0xe4,
-	0xbe, 0x1b, 0x18, 0x54, 0x06, 0xbd, 0x29, 0xa0, 0xf8, 0xe3, 0x14, 0x18, 0xa9, 0x0b, 0x9d, 0xff,
-	0x74, 0x48, 0xf3, 0x15, 0xc4, 0xa0, 0x90, 0x9e, 0x92, 0xbb, 0x0a, 0xe6, 0x93, 0xc0, 0xe8, 0x24,
-	0x86, 0x20, 0x99, 0xa0, 0xbc, 0x12, 0x3d, 0xe7, 0xd0, 0x39, 0x6a, 0x8c, 0x0e, 0xb2, 0x94, 0xf5,
-	0x16, 0xa0, 0xde, 0x0d, 0xf9, 0x5f, 0x08, 0xf7, 0x77, 0x15, 0xcc, 0x4f, 0xd6, 0xa9, 0x73, 0x79,
-	0x25, 0xe8, 0x73, 0xd2, 0xdd, 0xc0, 0x22, 0xc0, 0x5e, 0xdd, 0x1a, 0x3d, 0xc8, 0x52, 0x76, 0xff,
-	0x16, 0xa3, 0x08, 0x90, 0xfb, 0x3b, 0x15, 0x9f, 0x17, 0x80, 0xf4, 0x9c, 0xdc, 0xdb, 0x80, 0x14,
-	0x46, 0x45, 0x53, 0x77, 0xac, 0xd7, 0x61, 0x96, 0xb2, 0x83, 0x5b, 0xbc, 0x4a, 0x8c, 0xfb, 0xb4,
-	0x62, 0x38, 0xc6, 0x28, 0xef, 0x6d, 0xd8, 0xfe, 0x74, 0xcd, 0x6a, 0x3f, 0xae, 0x99, 0xc3, 0xbf,
-	0x38, 0xa4, 0x7d, 0x62, 0x42, 0x71, 0xa6, 0xdf, 0x18, 0xfa, 0x94, 0xb4, 0x02, 0x13, 0x8a, 0x89,
-	0x0c, 0xcb, 0x91, 0x97, 0x29, 0x6b, 0x5a, 0xf9, 0x59, 0x96, 0xb2, 0x9d, 0xa2, 0xce, 0x1a, 0xe1,
-	0x7e, 0x33, 0x8f, 0xce, 0x42, 0x3a, 0x20, 0xdb, 0x36, 0x37, 0x03, 0x9c, 0xd9, 0x11, 0x3b, 0xa3,
-	0xbd, 0x2c, 0x65, 0xdd, 0x0a, 0x9e, 0x4b, 0xdc, 0x6f, 0xe7, 0xf1, 0x29, 0xe0, 0x8c, 0x1e, 0x93,
-	0x56, 0x10, 0x0b, 0x48, 0x4c, 0x6c, 0xe7, 0xd8, 0x1e, 0xd1, 0x8a, 0x7f, 0x21, 0x70, 0xbf, 0x44,
-	0xf8, 0xe7, 0x3a, 0xe9, 0x94, 0x23, 0xd8, 0x46, 0x8f, 0x49, 0x0b, 0xc2, 0x30, 0x16, 0x88, 0xb6,
-	0xd1, 0x8d, 0xdf, 0xd7, 0x02, 0xf7, 0x4b, 0xa4, 0x5a, 0xac, 0xfe, 0xcf, 0x62, 0xf4, 0x11, 0xd9,
-	0x82, 0x50, 0x49, 0xbd, 0x6e, 0xac, 0x9b, 0xa5, 0xac, 0x53, 0x3a, 0x2b, 0xa9, 0xb9, 0x5f, 0xc8,
-	0xd5, 0x65, 0x35, 0xfe, 0x63, 0x59, 0x2f, 0x49, 0x5b, 0x6a, 0x69, 0x1f, 0xa8, 0xb7, 0x65, 0x77,
-	0xe5, 0x65, 0x29, 0xdb, 0x2d, 0xe8, 0x52, 0xe1, 0xbf, 0x52, 0xd6, 0x13, 0x3a, 0x30, 0xa1, 0xd4,
-	0x91, 0xf7, 0x16, 0x8d, 0xee, 0xfb, 0x70, 0x39, 0x16, 0x88, 0x10, 0x09, 0xbf, 0x95, 0x63, 0x63,
-	0x8c, 0x86, 0x8d, 0xfc, 0x09, 0x47, 0xa3, 0xaf, 0x4b, 0xd7, 0xb9, 0x59, 0xba, 0xce, 0xf7, 0xa5,
-	0xeb, 0x7c, 0x5c, 0xb9, 0xb5, 0x9b, 0x95, 0x5b, 0xfb, 0xb6, 0x72, 0x6b, 0xaf, 0x8f, 0x22, 0x99,
-	0xcc, 0xde, 0x4f, 0xfb, 0x81, 0x51, 0x9e, 0x3d, 0x96, 0xc7, 0xca, 0x68, 0xb1, 0xf0, 0x02, 0x13,
-	0x0b, 0x6f, 0x5e, 0x1c, 0x56, 0xb2, 0xb8, 0x10, 0x38, 0x6d, 0xda, 0x43, 0x78, 0xf2, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xc1, 0x86, 0x77, 0xe4, 0x73, 0x03, 0x00, 0x00,
+func (m *ContractInfo) GetIBCPortID() string {
+	if m != nil {
+		return m.IBCPortID
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ContractInfo) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
 	}
+	return false
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxContractSize != that1.MaxContractSize {
-		return false
-	}
-	if this.MaxContractGas != that1.MaxContractGas {
-		return false
-	}
-	if this.MaxContractMsgSize != that1.MaxContractMsgSize {
-		return false
+func (m *ContractInfo) GetStorageSize() uint64 {
+	if m != nil {
+		return m.StorageSize
 	}
-	return true
+	return 0
 }
-func (this *ContractInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ContractInfo) GetRentInArrears() bool {
+	if m != nil {
+		return m.RentInArrears
 	}
+	return false
+}
 
-	that1, ok := that.(*ContractInfo)
-	if !ok {
-		that2, ok := that.(ContractInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ContractHistoryEntry is an append-only record of an operation on a contract
+type ContractHistoryEntry struct {
+	// Operation is the type of the recorded operation
+	Operation ContractHistoryOperationType `protobuf:"varint,1,opt,name=operation,proto3,enum=iq.wasm.v1beta1.ContractHistoryOperationType" json:"operation,omitempty" yaml:"operation"`
+	// CodeID is the code of the contract after the operation
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// Height is the block height of the operation
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// Sender is the address which executed the operation
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Admin is the contract admin after the operation
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Msg is the raw message of the operation
+	Msg encoding_json.RawMessage `protobuf:"bytes,6,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty" yaml:"msg"`
+}
+
+func (m *ContractHistoryEntry) Reset()         { *m = ContractHistoryEntry{} }
+func (m *ContractHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ContractHistoryEntry) ProtoMessage()    {}
+func (*ContractHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{4}
+}
+func (m *ContractHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
+}
+func (m *ContractHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractHistoryEntry.Merge(m, src)
+}
+func (m *ContractHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractHistoryEntry proto.InternalMessageInfo
+
+func (m *ContractHistoryEntry) GetOperation() ContractHistoryOperationType {
+	if m != nil {
+		return m.Operation
 	}
-	if this.Creator != that1.Creator {
-		return false
+	return ContractHistoryOperationTypeUnspecified
+}
+
+func (m *ContractHistoryEntry) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
 	}
-	if this.Admin != that1.Admin {
-		return false
+	return 0
+}
+
+func (m *ContractHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if this.CodeID != that1.CodeID {
-		return false
+	return 0
+}
+
+func (m *ContractHistoryEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if !bytes.Equal(this.InitMsg, that1.InitMsg) {
-		return false
+	return ""
+}
+
+func (m *ContractHistoryEntry) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ContractHistoryEntry) GetMsg() encoding_json.RawMessage {
+	if m != nil {
+		return m.Msg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CronJob is a contract callback executed by the end blocker at a future height,
+// once or at a recurring interval, and paid from the gas prepaid by the contract
+type CronJob struct {
+	// ID is the sequentially increasing unique identifier
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// Contract is the address of the contract called back
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Msg is the json encoded message passed to the sudo entry point of the contract
+	Msg encoding_json.RawMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty" yaml:"msg"`
+	// NextHeight is the block height of the next execution
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty" yaml:"next_height"`
+	// Interval is the number of blocks between the executions, zero for a one-time job
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// GasLimit is the maximum gas of each execution
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// GasBudget is the remaining prepaid gas
+	GasBudget uint64 `protobuf:"varint,7,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty" yaml:"gas_budget"`
+	// Escrow is the remaining fee escrowed for the prepaid gas, refunded to the contract when the job ends
+	Escrow types.Coin `protobuf:"bytes,8,opt,name=escrow,proto3" json:"escrow" yaml:"escrow"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxContractMsgSize != 0 {
-		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractMsgSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxContractGas != 0 {
-		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractGas))
-		i--
-		dAtA[i] = 0x10
+func (m *CronJob) Reset()         { *m = CronJob{} }
+func (m *CronJob) String() string { return proto.CompactTextString(m) }
+func (*CronJob) ProtoMessage()    {}
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{5}
+}
+func (m *CronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxContractSize != 0 {
-		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractSize))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *CronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronJob.Merge(m, src)
+}
+func (m *CronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronJob proto.InternalMessageInfo
+
+func (m *CronJob) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CronJob) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CronJob) GetMsg() encoding_json.RawMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintWasm(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *CronJob) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintWasm(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *CronJob) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintWasm(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *CronJob) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CronJob) GetGasBudget() uint64 {
+	if m != nil {
+		return m.GasBudget
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CronJob) GetEscrow() types.Coin {
+	if m != nil {
+		return m.Escrow
+	}
+	return types.Coin{}
 }
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InitMsg) > 0 {
-		i -= len(m.InitMsg)
-		copy(dAtA[i:], m.InitMsg)
-		i = encodeVarintWasm(dAtA, i, uint64(len(m.InitMsg)))
-		i--
-		dAtA[i] = 0x2a
+// GasSponsorship is the opt-in of a contract to pay the fees of the txs executing it,
+// within the limits spent on each user and within each block
+type GasSponsorship struct {
+	// Contract is the address of the contract paying the fees
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// UserLimit is the maximum fee sponsored for a user within a user period
+	UserLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=user_limit,json=userLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_limit" yaml:"user_limit"`
+	// UserPeriod is the number of blocks after which the fee spent on a user is reset,
+	// zero to never reset it
+	UserPeriod uint64 `protobuf:"varint,3,opt,name=user_period,json=userPeriod,proto3" json:"user_period,omitempty" yaml:"user_period"`
+	// BlockLimit is the maximum fee sponsored by the contract within a block
+	BlockLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=block_limit,json=blockLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_limit" yaml:"block_limit"`
+}
+
+func (m *GasSponsorship) Reset()         { *m = GasSponsorship{} }
+func (m *GasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*GasSponsorship) ProtoMessage()    {}
+func (*GasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{6}
+}
+func (m *GasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintWasm(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *GasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSponsorship.Merge(m, src)
+}
+func (m *GasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSponsorship proto.InternalMessageInfo
+
+func (m *GasSponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintWasm(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *GasSponsorship) GetUserLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserLimit
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintWasm(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *GasSponsorship) GetUserPeriod() uint64 {
+	if m != nil {
+		return m.UserPeriod
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintWasm(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *GasSponsorship) GetBlockLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockLimit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintWasm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWasm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// SponsoredSpending is the fee sponsored by a contract since a block height
+type SponsoredSpending struct {
+	// StartHeight is the block height the spending is counted from
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// Spent is the fee sponsored since the start height
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent" yaml:"spent"`
+}
+
+func (m *SponsoredSpending) Reset()         { *m = SponsoredSpending{} }
+func (m *SponsoredSpending) String() string { return proto.CompactTextString(m) }
+func (*SponsoredSpending) ProtoMessage()    {}
+func (*SponsoredSpending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{7}
+}
+func (m *SponsoredSpending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredSpending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredSpending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SponsoredSpending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredSpending.Merge(m, src)
+}
+func (m *SponsoredSpending) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredSpending) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredSpending.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredSpending proto.InternalMessageInfo
+
+func (m *SponsoredSpending) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	var l int
-	_ = l
-	if m.MaxContractSize != 0 {
-		n += 1 + sovWasm(uint64(m.MaxContractSize))
+	return 0
+}
+
+func (m *SponsoredSpending) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
 	}
-	if m.MaxContractGas != 0 {
-		n += 1 + sovWasm(uint64(m.MaxContractGas))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("iq.wasm.v1beta1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("iq.wasm.v1beta1.ContractHistoryOperationType", ContractHistoryOperationType_name, ContractHistoryOperationType_value)
+	proto.RegisterType((*Params)(nil), "iq.wasm.v1beta1.Params")
+	proto.RegisterType((*AccessConfig)(nil), "iq.wasm.v1beta1.AccessConfig")
+	proto.RegisterType((*CodeInfo)(nil), "iq.wasm.v1beta1.CodeInfo")
+	proto.RegisterType((*ContractInfo)(nil), "iq.wasm.v1beta1.ContractInfo")
+	proto.RegisterType((*ContractHistoryEntry)(nil), "iq.wasm.v1beta1.ContractHistoryEntry")
+	proto.RegisterType((*CronJob)(nil), "iq.wasm.v1beta1.CronJob")
+	proto.RegisterType((*GasSponsorship)(nil), "iq.wasm.v1beta1.GasSponsorship")
+	proto.RegisterType((*SponsoredSpending)(nil), "iq.wasm.v1beta1.SponsoredSpending")
+}
+
+func init() { proto.RegisterFile("iq/wasm/v1beta1/wasm.proto", fileDescriptor_2bd5d0123068c880) }
+
+var fileDescriptor_2bd5d0123068c880 = []byte{
+	// 1692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0x16, 0x7f, 0x44, 0x89, 0x23, 0x9d, 0x4c, 0x8d, 0x2c, 0x8b, 0xa6, 0x6d, 0x2e, 0x6f, 0x7c,
+	0x88, 0x7d, 0x3e, 0x5b, 0x84, 0x95, 0x9f, 0x03, 0x9c, 0x14, 0xe1, 0xdf, 0x49, 0x3c, 0x9c, 0x48,
+	0x61, 0x48, 0x05, 0x50, 0x90, 0x80, 0x18, 0xee, 0x8e, 0xa8, 0xc9, 0x89, 0x3b, 0xf4, 0xce, 0xfa,
+	0x47, 0x6e, 0x02, 0xa4, 0x08, 0x02, 0x55, 0x01, 0xd2, 0xa4, 0x88, 0x80, 0x03, 0xd2, 0xa5, 0x4d,
+	0x9b, 0x22, 0xa5, 0xcb, 0x2b, 0x53, 0x6d, 0x12, 0xb9, 0x49, 0x80, 0x54, 0x2c, 0x53, 0x05, 0xf3,
+	0xb3, 0xdc, 0xd5, 0x4f, 0x44, 0xd9, 0x95, 0x76, 0xdf, 0xfb, 0xde, 0x37, 0x6f, 0xde, 0x7c, 0xef,
+	0xcd, 0x8a, 0xa0, 0xc0, 0x9e, 0x97, 0x5f, 0x11, 0x31, 0x2c, 0xbf, 0x7c, 0xda, 0xa7, 0x3e, 0x79,
+	0xaa, 0x5e, 0xd6, 0x47, 0x1e, 0xf7, 0x39, 0xbc, 0xc1, 0x9e, 0xaf, 0xab, 0x57, 0xe3, 0x2b, 0xdc,
+	0x1c, 0xf0, 0x01, 0x57, 0xbe, 0xb2, 0x7c, 0xd2, 0xb0, 0x42, 0xd1, 0xe6, 0x62, 0xc8, 0x45, 0xb9,
+	0x4f, 0x04, 0x9d, 0xd0, 0xd8, 0x9c, 0xb9, 0xda, 0x8f, 0xfe, 0x39, 0x0b, 0x32, 0x3b, 0xc4, 0x23,
+	0x43, 0x01, 0xb7, 0xc0, 0xf2, 0x90, 0xbc, 0xee, 0xd9, 0xdc, 0xf5, 0x3d, 0x62, 0xfb, 0x3d, 0xc1,
+	0xde, 0xd0, 0x7c, 0xa2, 0x94, 0x78, 0x98, 0xae, 0xde, 0x1d, 0x07, 0x56, 0xfe, 0x88, 0x0c, 0x0f,
+	0x9f, 0xa1, 0x0b, 0x10, 0x84, 0x6f, 0x0c, 0xc9, 0xeb, 0x9a, 0x31, 0x75, 0xd8, 0x1b, 0x0a, 0x1b,
+	0x20, 0x77, 0x06, 0x36, 0x20, 0x22, 0x9f, 0x54, 0x44, 0x77, 0xc6, 0x81, 0xb5, 0x76, 0x09, 0xd1,
+	0x80, 0x08, 0x84, 0x97, 0x62, 0x3c, 0x9b, 0x44, 0xc0, 0x0e, 0x58, 0x3d, 0x03, 0x1a, 0x8a, 0x81,
+	0x4e, 0x2a, 0xa5, 0xb8, 0x4a, 0xe3, 0xc0, 0xba, 0x7b, 0x09, 0x57, 0x08, 0x43, 0x18, 0xc6, 0x08,
+	0xb7, 0xc5, 0x40, 0xe5, 0xe6, 0x02, 0x68, 0x73, 0x87, 0xf6, 0x5e, 0x8c, 0x0e, 0x39, 0x71, 0x7a,
+	0xc4, 0xb6, 0xa9, 0x10, 0xf9, 0x74, 0x29, 0xf1, 0x70, 0x61, 0xe3, 0xde, 0xfa, 0xb9, 0xa2, 0xae,
+	0x57, 0x94, 0xbb, 0xc6, 0xdd, 0x7d, 0x36, 0xa8, 0x7e, 0xfc, 0x36, 0xb0, 0x66, 0xc6, 0x81, 0x75,
+	0x5b, 0x2f, 0x7a, 0x91, 0x06, 0xe1, 0x9c, 0x34, 0xee, 0x2a, 0x9b, 0x0e, 0x85, 0x04, 0x2c, 0xd9,
+	0x1e, 0x77, 0xe5, 0x0e, 0x7b, 0x23, 0x8f, 0xd9, 0x34, 0x3f, 0xab, 0xd6, 0xba, 0xbb, 0xae, 0x4f,
+	0x66, 0x5d, 0x9e, 0xcc, 0x64, 0xbd, 0x3a, 0xb5, 0x6b, 0x9c, 0xb9, 0xd5, 0x7b, 0x66, 0xa9, 0x55,
+	0xb3, 0xd4, 0x19, 0x06, 0x84, 0x17, 0xa5, 0x61, 0x93, 0x88, 0x1d, 0xf9, 0x0a, 0x77, 0xc1, 0x2d,
+	0x55, 0x80, 0x09, 0x88, 0x7a, 0xbd, 0xfe, 0x21, 0xb7, 0xbf, 0xce, 0x67, 0x54, 0xa1, 0x3e, 0x1e,
+	0x07, 0xd6, 0xbd, 0x58, 0xa1, 0x2e, 0xe0, 0x4c, 0xa5, 0x0c, 0x27, 0xf5, 0xaa, 0xd2, 0x08, 0x87,
+	0x00, 0x0a, 0x9f, 0x7b, 0x64, 0x40, 0x7b, 0x1e, 0x75, 0x7d, 0x93, 0xfd, 0xdc, 0x35, 0xb2, 0x3f,
+	0x57, 0xa8, 0x8b, 0x2c, 0x08, 0xe7, 0x8c, 0x11, 0x53, 0xd7, 0xd7, 0xbb, 0x68, 0x81, 0x95, 0xb3,
+	0x40, 0xea, 0x31, 0xee, 0xe4, 0xe7, 0xd5, 0x16, 0x8a, 0xe3, 0xc0, 0x2a, 0x5c, 0xc6, 0xa6, 0x40,
+	0x08, 0x2f, 0xc7, 0xe9, 0x94, 0xed, 0xd9, 0xfc, 0xef, 0xbf, 0xb1, 0x66, 0xfe, 0xf5, 0x8d, 0x95,
+	0x40, 0x7f, 0x48, 0x80, 0xc5, 0xf8, 0x41, 0x42, 0x0c, 0xc0, 0x88, 0x7a, 0x43, 0x26, 0x04, 0xe3,
+	0xae, 0x92, 0xf8, 0xd2, 0xc6, 0x9d, 0xff, 0x73, 0xf6, 0xdd, 0xa3, 0x11, 0xad, 0xae, 0x8e, 0x03,
+	0x6b, 0x59, 0x2f, 0x1f, 0x05, 0x22, 0x1c, 0x63, 0x81, 0x1b, 0x20, 0x4b, 0x1c, 0xc7, 0xa3, 0x42,
+	0x50, 0x29, 0xf6, 0xd4, 0xc3, 0x6c, 0xf5, 0xe6, 0x38, 0xb0, 0x72, 0x3a, 0x6a, 0xe2, 0x42, 0x38,
+	0x82, 0x3d, 0x4b, 0xab, 0xf4, 0x7e, 0x97, 0x04, 0xf3, 0x35, 0xee, 0xd0, 0xa6, 0xbb, 0xcf, 0xe1,
+	0xf7, 0xc1, 0x9c, 0xd2, 0x15, 0x73, 0xc2, 0xd6, 0x3b, 0x0d, 0xac, 0x8c, 0x72, 0xd7, 0xc7, 0x81,
+	0xb5, 0x14, 0x93, 0x1e, 0x73, 0x10, 0xce, 0xc8, 0xa7, 0xa6, 0x03, 0x9f, 0x82, 0xac, 0xb2, 0x1d,
+	0x10, 0x71, 0xa0, 0x5a, 0x6d, 0x31, 0xbe, 0xfa, 0xc4, 0x85, 0xf0, 0xbc, 0x7c, 0xde, 0x22, 0xe2,
+	0x00, 0x3e, 0x06, 0x73, 0xb6, 0x47, 0x89, 0xcf, 0x3d, 0xd5, 0x4f, 0xd9, 0x2a, 0x8c, 0xf1, 0x6b,
+	0x07, 0xc2, 0x21, 0x04, 0x72, 0x00, 0x99, 0x2b, 0x7c, 0xe2, 0xfa, 0x8c, 0xf8, 0x54, 0x36, 0xdb,
+	0x3e, 0x1b, 0x7c, 0x50, 0xdb, 0x5c, 0xa4, 0x41, 0x78, 0x39, 0x66, 0xd4, 0x51, 0xe8, 0xcf, 0x69,
+	0xb0, 0x18, 0xf6, 0xae, 0xaa, 0xcc, 0x63, 0x30, 0x67, 0x2a, 0x97, 0x4f, 0x9c, 0xcf, 0xd7, 0x38,
+	0x10, 0x0e, 0x21, 0xf1, 0xdd, 0x25, 0xa7, 0xef, 0xee, 0x3b, 0x60, 0x96, 0x38, 0x43, 0xe6, 0x9a,
+	0x4a, 0xe4, 0xc6, 0x81, 0xb5, 0x18, 0x32, 0x0f, 0x99, 0x8b, 0xb0, 0x76, 0xc7, 0x4f, 0x27, 0xfd,
+	0x1e, 0xa7, 0xf3, 0x25, 0x98, 0x67, 0x2e, 0x53, 0x93, 0x49, 0x75, 0xff, 0x62, 0xb5, 0x3c, 0x0e,
+	0xac, 0x1b, 0x61, 0x3d, 0xb4, 0x07, 0xfd, 0x37, 0xb0, 0xf2, 0xd4, 0xb5, 0xb9, 0xc3, 0xdc, 0x41,
+	0xf9, 0x17, 0x82, 0xbb, 0xeb, 0x98, 0xbc, 0xda, 0xa6, 0x42, 0x48, 0x5d, 0xcf, 0x49, 0xd8, 0xb6,
+	0x18, 0xc8, 0x54, 0x0f, 0x49, 0x9f, 0x1e, 0xe6, 0x33, 0xe7, 0x53, 0x55, 0x66, 0x84, 0xb5, 0x1b,
+	0xd6, 0xc0, 0x02, 0xeb, 0xdb, 0xbd, 0x11, 0xf7, 0x7c, 0x99, 0xee, 0x9c, 0x42, 0xdf, 0x3f, 0x0d,
+	0xac, 0x6c, 0xb3, 0x5a, 0xdb, 0xe1, 0x9e, 0xaf, 0x32, 0x86, 0x26, 0x87, 0x08, 0x89, 0x70, 0x96,
+	0xf5, 0x6d, 0x05, 0x70, 0xe0, 0xa7, 0x20, 0xb3, 0xef, 0xf1, 0x37, 0xd4, 0x55, 0x6d, 0x38, 0x5f,
+	0x5d, 0x1e, 0x07, 0xd6, 0x47, 0x3a, 0x44, 0xdb, 0x11, 0x36, 0x00, 0xf8, 0x0c, 0x2c, 0x86, 0x9d,
+	0xa9, 0x66, 0x74, 0x56, 0xd5, 0x67, 0x6d, 0x1c, 0x58, 0x2b, 0x67, 0xfb, 0x56, 0x8f, 0xe6, 0x05,
+	0xf3, 0xaa, 0x66, 0x72, 0x15, 0xdc, 0x50, 0xdd, 0xcc, 0xdc, 0x1e, 0xf1, 0x3c, 0x4a, 0x3c, 0x91,
+	0x07, 0x6a, 0xbd, 0xc2, 0x38, 0xb0, 0x6e, 0xe9, 0xf0, 0x73, 0x00, 0x84, 0x3f, 0x92, 0x96, 0xa6,
+	0x5b, 0xd1, 0xef, 0xa6, 0x97, 0xfe, 0x93, 0x04, 0x37, 0x43, 0xd5, 0x6c, 0x31, 0xb9, 0xc6, 0x51,
+	0xc3, 0xf5, 0xbd, 0x23, 0x48, 0x40, 0x96, 0x8f, 0xa8, 0x47, 0xfc, 0xa8, 0xe3, 0x9f, 0x5c, 0x90,
+	0xed, 0xb9, 0xc8, 0x76, 0x18, 0xa0, 0x66, 0x40, 0xac, 0x9f, 0x26, 0x4c, 0x08, 0x47, 0xac, 0x71,
+	0x71, 0x24, 0xdf, 0x43, 0x1c, 0x9f, 0x82, 0xcc, 0x01, 0x65, 0x83, 0x03, 0x5f, 0x89, 0x2f, 0x15,
+	0xaf, 0xb1, 0xb6, 0x23, 0x6c, 0x00, 0x12, 0x2a, 0xa8, 0xeb, 0x50, 0x4f, 0xa9, 0x2f, 0x1b, 0x87,
+	0x6a, 0x3b, 0xc2, 0x06, 0x10, 0x29, 0x7a, 0xf6, 0x6a, 0x45, 0xff, 0x08, 0xa4, 0xa4, 0x2a, 0x33,
+	0x4a, 0x95, 0x8f, 0xc6, 0x81, 0x05, 0x34, 0x6a, 0xaa, 0x20, 0x65, 0x18, 0xfa, 0x6b, 0x0a, 0xcc,
+	0xc9, 0x6b, 0xe3, 0x4b, 0xde, 0x87, 0xf7, 0x41, 0x72, 0x32, 0xb4, 0x56, 0x4e, 0x03, 0x2b, 0xa9,
+	0x76, 0x9d, 0x35, 0x02, 0x73, 0x10, 0x4e, 0x32, 0x07, 0x96, 0xc1, 0x7c, 0x78, 0x4f, 0x9b, 0xbe,
+	0x5c, 0x89, 0x3a, 0x21, 0xf4, 0xa8, 0x29, 0xa5, 0x1f, 0xc3, 0xfc, 0x52, 0x1f, 0x94, 0x1f, 0xfc,
+	0x1c, 0x2c, 0xb8, 0xf4, 0xb5, 0xdf, 0x33, 0x05, 0x4e, 0xab, 0x02, 0xdf, 0x8a, 0x74, 0x1f, 0x73,
+	0x22, 0x0c, 0xe4, 0xdb, 0x96, 0xae, 0x74, 0x59, 0x76, 0xac, 0x4f, 0xbd, 0x97, 0xe4, 0x50, 0x55,
+	0x30, 0x1d, 0xcf, 0x33, 0xf4, 0x20, 0x3c, 0x01, 0xc9, 0x01, 0x2c, 0xaf, 0xd4, 0x43, 0x36, 0x64,
+	0xbe, 0xb9, 0x76, 0x63, 0x82, 0x99, 0xb8, 0x10, 0x9e, 0x1f, 0x10, 0xf1, 0x95, 0x7c, 0x84, 0xdf,
+	0x03, 0x40, 0xda, 0xfb, 0x2f, 0x9c, 0x01, 0xf5, 0x55, 0x83, 0xa6, 0xe3, 0x17, 0x4d, 0xe4, 0x43,
+	0x58, 0x72, 0x57, 0xd5, 0x33, 0xdc, 0x02, 0x19, 0x2a, 0x6c, 0x8f, 0xbf, 0x52, 0x2d, 0xb9, 0xb0,
+	0x71, 0xfb, 0xd2, 0x9b, 0x58, 0x5d, 0xc3, 0xab, 0x66, 0xf0, 0x1a, 0x89, 0xe8, 0x30, 0x84, 0x4d,
+	0xbc, 0xe9, 0x98, 0x5f, 0xa7, 0xc0, 0xd2, 0x26, 0x11, 0x9d, 0x11, 0x77, 0x05, 0xf7, 0xc4, 0x01,
+	0x1b, 0x9d, 0x39, 0xa4, 0xc4, 0x75, 0x0e, 0xe9, 0x97, 0x00, 0xbc, 0x10, 0xd4, 0x33, 0xbb, 0x97,
+	0x97, 0xdf, 0x95, 0x79, 0x35, 0x4c, 0x5e, 0x66, 0xa3, 0x51, 0x28, 0xfa, 0xd3, 0xdf, 0xad, 0x87,
+	0x03, 0xe6, 0x1f, 0xbc, 0xe8, 0xaf, 0xdb, 0x7c, 0x58, 0x36, 0xdf, 0xae, 0xfa, 0xcf, 0x13, 0xe1,
+	0x7c, 0x5d, 0xf6, 0x8f, 0x46, 0x54, 0x28, 0x16, 0x81, 0xb3, 0x32, 0x50, 0x97, 0xf2, 0x73, 0xb0,
+	0xa0, 0x58, 0xcc, 0x37, 0x83, 0xfe, 0x3e, 0x8c, 0x9d, 0x73, 0xcc, 0x89, 0xb0, 0xca, 0x55, 0x7f,
+	0x24, 0xc0, 0x5f, 0x25, 0xc0, 0x82, 0xfa, 0x04, 0x32, 0xb9, 0xa7, 0xa7, 0xe5, 0xfe, 0x85, 0xc9,
+	0xdd, 0x10, 0xc7, 0x62, 0xdf, 0x2f, 0x79, 0xa0, 0x22, 0x55, 0xf6, 0xe6, 0x20, 0xde, 0x26, 0xc0,
+	0xb2, 0x39, 0x05, 0xea, 0x74, 0x46, 0xd4, 0x95, 0xb2, 0xd6, 0x63, 0x95, 0x78, 0x13, 0x09, 0x27,
+	0x94, 0x84, 0xcf, 0x8c, 0xd5, 0xc8, 0xab, 0xc6, 0x2a, 0xf1, 0x42, 0x11, 0x3f, 0x07, 0xb3, 0x62,
+	0x44, 0xdd, 0x6b, 0x9c, 0xc8, 0x8f, 0xcd, 0xae, 0xcc, 0x88, 0x50, 0x51, 0xef, 0xb7, 0x1f, 0xbd,
+	0x92, 0xde, 0xca, 0xa3, 0x7f, 0x27, 0x00, 0x88, 0xbe, 0x9e, 0xe0, 0x0f, 0xc0, 0x5a, 0xa5, 0x56,
+	0x6b, 0x74, 0x3a, 0xbd, 0xee, 0xde, 0x4e, 0xa3, 0xb7, 0xdb, 0xea, 0xec, 0x34, 0x6a, 0xcd, 0x2f,
+	0x9a, 0x8d, 0x7a, 0x6e, 0xa6, 0x70, 0xfb, 0xf8, 0xa4, 0xb4, 0x1a, 0x81, 0x77, 0x5d, 0x31, 0xa2,
+	0x36, 0xdb, 0x67, 0xd4, 0x81, 0x8f, 0x01, 0x8c, 0xc7, 0xb5, 0xda, 0xd5, 0x76, 0x7d, 0x2f, 0x97,
+	0x28, 0xdc, 0x3c, 0x3e, 0x29, 0xe5, 0xa2, 0x90, 0x16, 0xef, 0x73, 0xe7, 0x08, 0x6e, 0x80, 0xd5,
+	0x38, 0xba, 0xf1, 0x93, 0x06, 0xde, 0x53, 0x01, 0xc9, 0xc2, 0xda, 0xf1, 0x49, 0x69, 0x25, 0x0a,
+	0x68, 0xbc, 0xa4, 0xde, 0x91, 0x8a, 0xf9, 0x21, 0x28, 0xc4, 0x63, 0xda, 0xad, 0xaf, 0xf6, 0x7a,
+	0x95, 0x7a, 0x1d, 0x37, 0x3a, 0x9d, 0x46, 0x27, 0x97, 0x2a, 0xdc, 0x39, 0x3e, 0x29, 0xad, 0x45,
+	0x81, 0x6d, 0xf7, 0xf0, 0xa8, 0x12, 0x7e, 0xbd, 0x15, 0xd2, 0xbf, 0xf9, 0x63, 0x71, 0xe6, 0xd1,
+	0x5f, 0xd2, 0xe0, 0xee, 0x55, 0xf7, 0x06, 0xfc, 0x19, 0xf8, 0xac, 0xd6, 0x6e, 0x75, 0x71, 0xa5,
+	0xd6, 0xed, 0x6d, 0x35, 0x3b, 0xdd, 0x36, 0xde, 0xeb, 0xb5, 0x77, 0x1a, 0xb8, 0xd2, 0x6d, 0xb6,
+	0x5b, 0x97, 0x55, 0xe4, 0xb3, 0xe3, 0x93, 0xd2, 0x83, 0xab, 0x28, 0xe3, 0x35, 0x6a, 0x81, 0x4f,
+	0xa6, 0xb1, 0x37, 0x5b, 0xcd, 0x6e, 0x2e, 0x51, 0xf8, 0xe4, 0xf8, 0xa4, 0x54, 0xba, 0x8a, 0xb6,
+	0xe9, 0x32, 0x1f, 0x76, 0xc1, 0x83, 0x69, 0x7c, 0xdb, 0xcd, 0x4d, 0x5c, 0xe9, 0x36, 0x72, 0xc9,
+	0xc2, 0x83, 0xe3, 0x93, 0xd2, 0xfd, 0xab, 0x28, 0xb7, 0xd9, 0xc0, 0x23, 0x3e, 0x85, 0x3f, 0x07,
+	0x8f, 0xa7, 0xb1, 0x56, 0xea, 0xdb, 0xcd, 0x56, 0x6f, 0x77, 0xa7, 0x2e, 0xa9, 0x53, 0xd3, 0x8b,
+	0x50, 0x91, 0xd7, 0xd7, 0xee, 0xc8, 0xb9, 0x26, 0x7d, 0xad, 0x5d, 0x8f, 0x32, 0x4f, 0x4f, 0xa7,
+	0x97, 0xd7, 0x76, 0x98, 0xfd, 0x35, 0x6a, 0xb2, 0xd9, 0x68, 0x35, 0x3a, 0xcd, 0x4e, 0x6e, 0x76,
+	0x7a, 0x4d, 0x36, 0xa9, 0x4b, 0x05, 0x33, 0xf2, 0xa9, 0x56, 0xde, 0x9e, 0x16, 0x13, 0xdf, 0x9e,
+	0x16, 0x13, 0xff, 0x38, 0x2d, 0x26, 0x7e, 0xfb, 0xae, 0x38, 0xf3, 0xed, 0xbb, 0xe2, 0xcc, 0xdf,
+	0xde, 0x15, 0x67, 0x7e, 0xfa, 0x20, 0xd6, 0x7b, 0x7d, 0xe6, 0xbf, 0xa2, 0x7d, 0x51, 0x66, 0xcf,
+	0x9f, 0xd8, 0xdc, 0xa3, 0xe5, 0xd7, 0xfa, 0x67, 0x01, 0xd5, 0x80, 0xfd, 0x8c, 0xfa, 0x4f, 0xfe,
+	0xbb, 0xff, 0x1b, 0x00, 0x9d, 0xd6, 0xfe, 0xb4, 0x2e, 0x10, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.MaxContractMsgSize != 0 {
-		n += 1 + sovWasm(uint64(m.MaxContractMsgSize))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxContractSize != that1.MaxContractSize {
+		return false
+	}
+	if this.MaxContractGas != that1.MaxContractGas {
+		return false
+	}
+	if this.MaxContractMsgSize != that1.MaxContractMsgSize {
+		return false
+	}
+	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
+		return false
+	}
+	if !this.CronGasPrice.Equal(&that1.CronGasPrice) {
+		return false
+	}
+	if this.MaxCronGasPerBlock != that1.MaxCronGasPerBlock {
+		return false
+	}
+	if !this.StorageRentPrice.Equal(&that1.StorageRentPrice) {
+		return false
+	}
+	if this.StorageRentPeriod != that1.StorageRentPeriod {
+		return false
+	}
+	return true
 }
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovWasm(uint64(m.CodeID))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
+	if this.Permission != that1.Permission {
+		return false
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.InitMsg, that1.InitMsg) {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
+	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
+	if this.StorageSize != that1.StorageSize {
+		return false
+	}
+	if this.RentInArrears != that1.RentInArrears {
+		return false
+	}
+	return true
+}
+func (this *CronJob) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CronJob)
+	if !ok {
+		that2, ok := that.(CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.GasBudget != that1.GasBudget {
+		return false
+	}
+	if !this.Escrow.Equal(&that1.Escrow) {
+		return false
+	}
+	return true
+}
+func (this *GasSponsorship) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasSponsorship)
+	if !ok {
+		that2, ok := that.(GasSponsorship)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if len(this.UserLimit) != len(that1.UserLimit) {
+		return false
+	}
+	for i := range this.UserLimit {
+		if !this.UserLimit[i].Equal(&that1.UserLimit[i]) {
+			return false
+		}
+	}
+	if this.UserPeriod != that1.UserPeriod {
+		return false
+	}
+	if len(this.BlockLimit) != len(that1.BlockLimit) {
+		return false
+	}
+	for i := range this.BlockLimit {
+		if !this.BlockLimit[i].Equal(&that1.BlockLimit[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SponsoredSpending) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SponsoredSpending)
+	if !ok {
+		that2, ok := that.(SponsoredSpending)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageRentPeriod != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.StorageRentPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.StorageRentPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxCronGasPerBlock != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MaxCronGasPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.CronGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxContractMsgSize != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractMsgSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxContractGas != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxContractSize != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintWasm(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RentInArrears {
+		i--
+		if m.RentInArrears {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StorageSize != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.StorageSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InitMsg) > 0 {
+		i -= len(m.InitMsg)
+		copy(dAtA[i:], m.InitMsg)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.InitMsg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.GasBudget != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.GasBudget))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockLimit) > 0 {
+		for iNdEx := len(m.BlockLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UserPeriod != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.UserPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UserLimit) > 0 {
+		for iNdEx := len(m.UserLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsoredSpending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredSpending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredSpending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWasm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWasm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxContractSize != 0 {
+		n += 1 + sovWasm(uint64(m.MaxContractSize))
+	}
+	if m.MaxContractGas != 0 {
+		n += 1 + sovWasm(uint64(m.MaxContractGas))
+	}
+	if m.MaxContractMsgSize != 0 {
+		n += 1 + sovWasm(uint64(m.MaxContractMsgSize))
+	}
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	l = m.CronGasPrice.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	if m.MaxCronGasPerBlock != 0 {
+		n += 1 + sovWasm(uint64(m.MaxCronGasPerBlock))
+	}
+	l = m.StorageRentPrice.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	if m.StorageRentPeriod != 0 {
+		n += 1 + sovWasm(uint64(m.StorageRentPeriod))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovWasm(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovWasm(uint64(m.CodeID))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovWasm(uint64(m.CodeID))
+	}
+	l = len(m.InitMsg)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.StorageSize != 0 {
+		n += 1 + sovWasm(uint64(m.StorageSize))
+	}
+	if m.RentInArrears {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovWasm(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovWasm(uint64(m.CodeID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWasm(uint64(m.Height))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	return n
+}
+
+func (m *CronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovWasm(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovWasm(uint64(m.NextHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovWasm(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovWasm(uint64(m.GasLimit))
+	}
+	if m.GasBudget != 0 {
+		n += 1 + sovWasm(uint64(m.GasBudget))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	return n
+}
+
+func (m *GasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if len(m.UserLimit) > 0 {
+		for _, e := range m.UserLimit {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	if m.UserPeriod != 0 {
+		n += 1 + sovWasm(uint64(m.UserPeriod))
+	}
+	if len(m.BlockLimit) > 0 {
+		for _, e := range m.BlockLimit {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SponsoredSpending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovWasm(uint64(m.StartHeight))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovWasm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWasm(x uint64) (n int) {
+	return sovWasm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractSize", wireType)
+			}
+			m.MaxContractSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractGas", wireType)
+			}
+			m.MaxContractGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractMsgSize", wireType)
+			}
+			m.MaxContractMsgSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractMsgSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CronGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCronGasPerBlock", wireType)
+			}
+			m.MaxCronGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCronGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRentPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageRentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRentPeriod", wireType)
+			}
+			m.StorageRentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageRentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSize", wireType)
+			}
+			m.StorageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentInArrears", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RentInArrears = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
-	}
-	if m.CodeID != 0 {
-		n += 1 + sovWasm(uint64(m.CodeID))
-	}
-	l = len(m.InitMsg)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovWasm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWasm(x uint64) (n int) {
-	return sovWasm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ContractHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -584,17 +2769,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.MaxContractSize = 0
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -604,16 +2789,86 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractSize |= uint64(b&0x7F) << shift
+				m.Operation |= ContractHistoryOperationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxContractGas = 0
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -623,16 +2878,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractMsgSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
 			}
-			m.MaxContractMsgSize = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -642,11 +2910,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractMsgSize |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasm(dAtA[iNdEx:])
@@ -668,7 +2951,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *CronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -691,17 +2974,17 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.CodeID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -711,14 +2994,46 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -745,16 +3060,92 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBudget", wireType)
+			}
+			m.GasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -764,23 +3155,24 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWasm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWasm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -803,7 +3195,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *GasSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -826,15 +3218,15 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -862,13 +3254,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -878,29 +3270,50 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWasm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWasm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.UserLimit = append(m.UserLimit, types.Coin{})
+			if err := m.UserLimit[len(m.UserLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPeriod", wireType)
+			}
+			m.UserPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -910,29 +3323,81 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWasm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWasm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.BlockLimit = append(m.BlockLimit, types.Coin{})
+			if err := m.BlockLimit[len(m.BlockLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsoredSpending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsoredSpending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsoredSpending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.CodeID = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -942,16 +3407,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -961,24 +3426,24 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWasm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWasm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.InitMsg == nil {
-				m.InitMsg = []byte{}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
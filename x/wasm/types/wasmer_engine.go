@@ -8,6 +8,9 @@ import (
 // ContractMaxQueryDepth maximum recursive query depth allowed
 const ContractMaxQueryDepth = 20
 
+// ContractMaxQueryBatchSize maximum number of queries allowed in a contract store batch query
+const ContractMaxQueryBatchSize = 100
+
 // WasmerEngine defines the WASM contract runtime engine.
 type WasmerEngine interface {
 
@@ -98,6 +101,21 @@ type WasmerEngine interface {
 		deserializeCost wasmvmtypes.UFraction,
 	) (*wasmvmtypes.Response, uint64, error)
 
+	// Sudo allows native Go modules to make privileged (sudo) calls on the contract.
+	// The contract can expose entry points that cannot be triggered by any transaction,
+	// but only via native Go modules, and delegate the access control to the system.
+	Sudo(
+		code wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		sudoMsg []byte,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.Response, uint64, error)
+
 	// Reply is called on the original dispatching contract after running a submessage
 	Reply(
 		codeID wasmvm.Checksum,
@@ -111,6 +129,92 @@ type WasmerEngine interface {
 		deserializeCost wasmvmtypes.UFraction,
 	) (*wasmvmtypes.Response, uint64, error)
 
+	// IBCChannelOpen is available on IBC-enabled contracts and is a hook to call into
+	// during the handshake phase
+	IBCChannelOpen(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		channel wasmvmtypes.IBCChannelOpenMsg,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (uint64, error)
+
+	// IBCChannelConnect is available on IBC-enabled contracts and is a hook to call into
+	// during the handshake phase
+	IBCChannelConnect(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		channel wasmvmtypes.IBCChannelConnectMsg,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.IBCBasicResponse, uint64, error)
+
+	// IBCChannelClose is available on IBC-enabled contracts and is a hook to call into
+	// at the end of the channel lifetime
+	IBCChannelClose(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		channel wasmvmtypes.IBCChannelCloseMsg,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.IBCBasicResponse, uint64, error)
+
+	// IBCPacketReceive is available on IBC-enabled contracts and is called when an incoming
+	// packet is received on a channel belonging to this contract
+	IBCPacketReceive(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		packet wasmvmtypes.IBCPacketReceiveMsg,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.IBCReceiveResponse, uint64, error)
+
+	// IBCPacketAck is available on IBC-enabled contracts and is called when
+	// the response for an outgoing packet (previously sent by this contract)
+	// is received
+	IBCPacketAck(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		ack wasmvmtypes.IBCPacketAckMsg,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.IBCBasicResponse, uint64, error)
+
+	// IBCPacketTimeout is available on IBC-enabled contracts and is called when an
+	// outgoing packet (previously sent by this contract) will provably never be executed.
+	// Usually handled like ack returning an error
+	IBCPacketTimeout(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		packet wasmvmtypes.IBCPacketTimeoutMsg,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserializeCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.IBCBasicResponse, uint64, error)
+
 	// GetCode will load the original wasm code for the given code id.
 	// This will only succeed if that code id was previously returned from
 	// a call to Create.
@@ -120,6 +224,17 @@ type WasmerEngine interface {
 	// rust library
 	GetCode(code wasmvm.Checksum) (wasmvm.WasmCode, error)
 
+	// Pin pins a code to an in-memory cache, such that is
+	// always loaded quickly when executed.
+	// Pin is idempotent.
+	Pin(checksum wasmvm.Checksum) error
+
+	// Unpin removes the guarantee of a contract to be pinned (see Pin).
+	// After calling this, the code may or may not remain in memory depending on
+	// the implementor's choice.
+	// Unpin is idempotent.
+	Unpin(checksum wasmvm.Checksum) error
+
 	// Cleanup should be called when no longer using this to free resources on the rust-side
 	Cleanup()
 }